@@ -35,6 +35,7 @@ const (
 	TaskStatus_TASK_COMPLETED   TaskStatus = 4 // 已完成（报告已生成）
 	TaskStatus_TASK_FAILED      TaskStatus = 5 // 已失败
 	TaskStatus_TASK_CANCELLED   TaskStatus = 6 // 已取消
+	TaskStatus_TASK_PAUSED      TaskStatus = 7 // 已暂停（会话保留 token 与进度，停止下注）
 )
 
 // Enum value maps for TaskStatus.
@@ -47,6 +48,7 @@ var (
 		4: "TASK_COMPLETED",
 		5: "TASK_FAILED",
 		6: "TASK_CANCELLED",
+		7: "TASK_PAUSED",
 	}
 	TaskStatus_value = map[string]int32{
 		"TASK_UNSPECIFIED": 0,
@@ -56,6 +58,7 @@ var (
 		"TASK_COMPLETED":   4,
 		"TASK_FAILED":      5,
 		"TASK_CANCELLED":   6,
+		"TASK_PAUSED":      7,
 	}
 )
 
@@ -86,6 +89,227 @@ func (TaskStatus) EnumDescriptor() ([]byte, []int) {
 	return file_stress_v1_stress_proto_rawDescGZIP(), []int{0}
 }
 
+// 会话（成员）状态，与 task.SessionState 取值一致
+type SessionState int32
+
+const (
+	SessionState_SESSION_UNSPECIFIED  SessionState = 0
+	SessionState_SESSION_IDLE         SessionState = 1 // 未开始
+	SessionState_SESSION_LAUNCHING    SessionState = 2 // 获取 token
+	SessionState_SESSION_LOGGING_IN   SessionState = 3 // 登录
+	SessionState_SESSION_BETTING      SessionState = 4 // 下注
+	SessionState_SESSION_BONUS_SELECT SessionState = 5 // bonus 选择
+	SessionState_SESSION_COMPLETED    SessionState = 6 // 已完成
+	SessionState_SESSION_FAILED       SessionState = 7 // 已失败
+)
+
+// Enum value maps for SessionState.
+var (
+	SessionState_name = map[int32]string{
+		0: "SESSION_UNSPECIFIED",
+		1: "SESSION_IDLE",
+		2: "SESSION_LAUNCHING",
+		3: "SESSION_LOGGING_IN",
+		4: "SESSION_BETTING",
+		5: "SESSION_BONUS_SELECT",
+		6: "SESSION_COMPLETED",
+		7: "SESSION_FAILED",
+	}
+	SessionState_value = map[string]int32{
+		"SESSION_UNSPECIFIED":  0,
+		"SESSION_IDLE":         1,
+		"SESSION_LAUNCHING":    2,
+		"SESSION_LOGGING_IN":   3,
+		"SESSION_BETTING":      4,
+		"SESSION_BONUS_SELECT": 5,
+		"SESSION_COMPLETED":    6,
+		"SESSION_FAILED":       7,
+	}
+)
+
+func (x SessionState) Enum() *SessionState {
+	p := new(SessionState)
+	*p = x
+	return p
+}
+
+func (x SessionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_stress_v1_stress_proto_enumTypes[1].Descriptor()
+}
+
+func (SessionState) Type() protoreflect.EnumType {
+	return &file_stress_v1_stress_proto_enumTypes[1]
+}
+
+func (x SessionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionState.Descriptor instead.
+func (SessionState) EnumDescriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{1}
+}
+
+type WatchTaskEvent_Kind int32
+
+const (
+	WatchTaskEvent_KIND_UNSPECIFIED WatchTaskEvent_Kind = 0
+	WatchTaskEvent_STATUS           WatchTaskEvent_Kind = 1 // 状态变更（订阅开始时也推送一次）
+	WatchTaskEvent_SNAPSHOT         WatchTaskEvent_Kind = 2 // 进度快照
+	WatchTaskEvent_ERROR            WatchTaskEvent_Kind = 3 // 新增错误样本
+)
+
+// Enum value maps for WatchTaskEvent_Kind.
+var (
+	WatchTaskEvent_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "STATUS",
+		2: "SNAPSHOT",
+		3: "ERROR",
+	}
+	WatchTaskEvent_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"STATUS":           1,
+		"SNAPSHOT":         2,
+		"ERROR":            3,
+	}
+)
+
+func (x WatchTaskEvent_Kind) Enum() *WatchTaskEvent_Kind {
+	p := new(WatchTaskEvent_Kind)
+	*p = x
+	return p
+}
+
+func (x WatchTaskEvent_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchTaskEvent_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_stress_v1_stress_proto_enumTypes[2].Descriptor()
+}
+
+func (WatchTaskEvent_Kind) Type() protoreflect.EnumType {
+	return &file_stress_v1_stress_proto_enumTypes[2]
+}
+
+func (x WatchTaskEvent_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchTaskEvent_Kind.Descriptor instead.
+func (WatchTaskEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{26, 0}
+}
+
+type MoveTaskRequest_Position int32
+
+const (
+	MoveTaskRequest_POSITION_UNSPECIFIED MoveTaskRequest_Position = 0
+	MoveTaskRequest_FRONT                MoveTaskRequest_Position = 1 // 队首
+	MoveTaskRequest_BACK                 MoveTaskRequest_Position = 2 // 队尾
+)
+
+// Enum value maps for MoveTaskRequest_Position.
+var (
+	MoveTaskRequest_Position_name = map[int32]string{
+		0: "POSITION_UNSPECIFIED",
+		1: "FRONT",
+		2: "BACK",
+	}
+	MoveTaskRequest_Position_value = map[string]int32{
+		"POSITION_UNSPECIFIED": 0,
+		"FRONT":                1,
+		"BACK":                 2,
+	}
+)
+
+func (x MoveTaskRequest_Position) Enum() *MoveTaskRequest_Position {
+	p := new(MoveTaskRequest_Position)
+	*p = x
+	return p
+}
+
+func (x MoveTaskRequest_Position) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MoveTaskRequest_Position) Descriptor() protoreflect.EnumDescriptor {
+	return file_stress_v1_stress_proto_enumTypes[3].Descriptor()
+}
+
+func (MoveTaskRequest_Position) Type() protoreflect.EnumType {
+	return &file_stress_v1_stress_proto_enumTypes[3]
+}
+
+func (x MoveTaskRequest_Position) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MoveTaskRequest_Position.Descriptor instead.
+func (MoveTaskRequest_Position) EnumDescriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type ErrorRule_Action int32
+
+const (
+	ErrorRule_ACTION_UNSPECIFIED ErrorRule_Action = 0
+	ErrorRule_RETRY              ErrorRule_Action = 1 // 原状态重试
+	ErrorRule_RELOGIN            ErrorRule_Action = 2 // 重新登录
+	ErrorRule_RELAUNCH           ErrorRule_Action = 3 // 重新获取 token
+	ErrorRule_FAIL               ErrorRule_Action = 4 // 会话失败
+)
+
+// Enum value maps for ErrorRule_Action.
+var (
+	ErrorRule_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "RETRY",
+		2: "RELOGIN",
+		3: "RELAUNCH",
+		4: "FAIL",
+	}
+	ErrorRule_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"RETRY":              1,
+		"RELOGIN":            2,
+		"RELAUNCH":           3,
+		"FAIL":               4,
+	}
+)
+
+func (x ErrorRule_Action) Enum() *ErrorRule_Action {
+	p := new(ErrorRule_Action)
+	*p = x
+	return p
+}
+
+func (x ErrorRule_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorRule_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_stress_v1_stress_proto_enumTypes[4].Descriptor()
+}
+
+func (ErrorRule_Action) Type() protoreflect.EnumType {
+	return &file_stress_v1_stress_proto_enumTypes[4]
+}
+
+func (x ErrorRule_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorRule_Action.Descriptor instead.
+func (ErrorRule_Action) EnumDescriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{54, 0}
+}
+
 // The request message containing the user's name.
 type PingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -265,16 +489,118 @@ func (x *ListGamesResponse) GetTotal() int32 {
 	return 0
 }
 
+type ReloadGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadGamesRequest) Reset() {
+	*x = ReloadGamesRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadGamesRequest) ProtoMessage() {}
+
+func (x *ReloadGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadGamesRequest.ProtoReflect.Descriptor instead.
+func (*ReloadGamesRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{4}
+}
+
+type ReloadGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 失败原因
+	Loaded        int32                  `protobuf:"varint,3,opt,name=loaded,proto3" json:"loaded,omitempty"`  // 已加载的声明式游戏数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadGamesResponse) Reset() {
+	*x = ReloadGamesResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadGamesResponse) ProtoMessage() {}
+
+func (x *ReloadGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadGamesResponse.ProtoReflect.Descriptor instead.
+func (*ReloadGamesResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReloadGamesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReloadGamesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReloadGamesResponse) GetLoaded() int32 {
+	if x != nil {
+		return x.Loaded
+	}
+	return 0
+}
+
 // --- 任务列表 ---
 type ListTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        TaskStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=stress.v1.TaskStatus" json:"status,omitempty"` // 状态过滤（0=全部）
+	GameId        int64                  `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`             // 游戏过滤（0=全部）
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`     // 创建日期起（含），格式 2006-01-02
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // 创建日期止（含），格式 2006-01-02
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`                               // 页码，从 1 开始（0 视为 1）
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页条数，默认 20
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTasksRequest) Reset() {
 	*x = ListTasksRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[4]
+	mi := &file_stress_v1_stress_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +612,7 @@ func (x *ListTasksRequest) String() string {
 func (*ListTasksRequest) ProtoMessage() {}
 
 func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[4]
+	mi := &file_stress_v1_stress_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,20 +625,62 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
 func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{4}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTasksRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
 type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`  // 任务列表
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // 符合条件的总数（分页前）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTasksResponse) Reset() {
 	*x = ListTasksResponse{}
-	mi := &file_stress_v1_stress_proto_msgTypes[5]
+	mi := &file_stress_v1_stress_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -324,7 +692,7 @@ func (x *ListTasksResponse) String() string {
 func (*ListTasksResponse) ProtoMessage() {}
 
 func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[5]
+	mi := &file_stress_v1_stress_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,7 +705,7 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
 func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{5}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListTasksResponse) GetTasks() []*Task {
@@ -364,7 +732,7 @@ type CreateTaskRequest struct {
 
 func (x *CreateTaskRequest) Reset() {
 	*x = CreateTaskRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[6]
+	mi := &file_stress_v1_stress_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -376,7 +744,7 @@ func (x *CreateTaskRequest) String() string {
 func (*CreateTaskRequest) ProtoMessage() {}
 
 func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[6]
+	mi := &file_stress_v1_stress_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +757,7 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
 func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{6}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateTaskRequest) GetConfig() *TaskConfig {
@@ -410,7 +778,7 @@ type CreateTaskResponse struct {
 
 func (x *CreateTaskResponse) Reset() {
 	*x = CreateTaskResponse{}
-	mi := &file_stress_v1_stress_proto_msgTypes[7]
+	mi := &file_stress_v1_stress_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -422,7 +790,7 @@ func (x *CreateTaskResponse) String() string {
 func (*CreateTaskResponse) ProtoMessage() {}
 
 func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[7]
+	mi := &file_stress_v1_stress_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +803,7 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
 func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{7}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateTaskResponse) GetCode() int32 {
@@ -469,7 +837,7 @@ type TaskInfoRequest struct {
 
 func (x *TaskInfoRequest) Reset() {
 	*x = TaskInfoRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[8]
+	mi := &file_stress_v1_stress_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -481,7 +849,7 @@ func (x *TaskInfoRequest) String() string {
 func (*TaskInfoRequest) ProtoMessage() {}
 
 func (x *TaskInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[8]
+	mi := &file_stress_v1_stress_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,7 +862,7 @@ func (x *TaskInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskInfoRequest.ProtoReflect.Descriptor instead.
 func (*TaskInfoRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{8}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TaskInfoRequest) GetTaskId() string {
@@ -515,7 +883,7 @@ type TaskInfoResponse struct {
 
 func (x *TaskInfoResponse) Reset() {
 	*x = TaskInfoResponse{}
-	mi := &file_stress_v1_stress_proto_msgTypes[9]
+	mi := &file_stress_v1_stress_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +895,7 @@ func (x *TaskInfoResponse) String() string {
 func (*TaskInfoResponse) ProtoMessage() {}
 
 func (x *TaskInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[9]
+	mi := &file_stress_v1_stress_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +908,7 @@ func (x *TaskInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskInfoResponse.ProtoReflect.Descriptor instead.
 func (*TaskInfoResponse) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{9}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TaskInfoResponse) GetCode() int32 {
@@ -574,7 +942,7 @@ type CancelTaskRequest struct {
 
 func (x *CancelTaskRequest) Reset() {
 	*x = CancelTaskRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[10]
+	mi := &file_stress_v1_stress_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +954,7 @@ func (x *CancelTaskRequest) String() string {
 func (*CancelTaskRequest) ProtoMessage() {}
 
 func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[10]
+	mi := &file_stress_v1_stress_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +967,7 @@ func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
 func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{10}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CancelTaskRequest) GetTaskId() string {
@@ -619,7 +987,7 @@ type CancelTaskResponse struct {
 
 func (x *CancelTaskResponse) Reset() {
 	*x = CancelTaskResponse{}
-	mi := &file_stress_v1_stress_proto_msgTypes[11]
+	mi := &file_stress_v1_stress_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +999,7 @@ func (x *CancelTaskResponse) String() string {
 func (*CancelTaskResponse) ProtoMessage() {}
 
 func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[11]
+	mi := &file_stress_v1_stress_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +1012,7 @@ func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
 func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{11}
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CancelTaskResponse) GetCode() int32 {
@@ -661,29 +1029,29 @@ func (x *CancelTaskResponse) GetMessage() string {
 	return ""
 }
 
-// --- 删除任务 ---
-type DeleteTaskRequest struct {
+// --- 暂停/恢复任务 ---
+type PauseTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 任务ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[12]
+func (x *PauseTaskRequest) Reset() {
+	*x = PauseTaskRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *PauseTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*PauseTaskRequest) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[12]
+func (x *PauseTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,41 +1062,92 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PauseTaskRequest.ProtoReflect.Descriptor instead.
+func (*PauseTaskRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeleteTaskRequest) GetTaskId() string {
+func (x *PauseTaskRequest) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-// --- 获取任务结果 ---
-type RecordRequest struct {
+type PauseTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTaskResponse) Reset() {
+	*x = PauseTaskResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTaskResponse) ProtoMessage() {}
+
+func (x *PauseTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTaskResponse.ProtoReflect.Descriptor instead.
+func (*PauseTaskResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PauseTaskResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PauseTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResumeTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 任务ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordRequest) Reset() {
-	*x = RecordRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[13]
+func (x *ResumeTaskRequest) Reset() {
+	*x = ResumeTaskRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordRequest) String() string {
+func (x *ResumeTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordRequest) ProtoMessage() {}
+func (*ResumeTaskRequest) ProtoMessage() {}
 
-func (x *RecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[13]
+func (x *ResumeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,42 +1158,41 @@ func (x *RecordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordRequest.ProtoReflect.Descriptor instead.
-func (*RecordRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ResumeTaskRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RecordRequest) GetTaskId() string {
+func (x *ResumeTaskRequest) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-type RecordResponse struct {
+type ResumeTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 提示信息
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordResponse) Reset() {
-	*x = RecordResponse{}
-	mi := &file_stress_v1_stress_proto_msgTypes[14]
+func (x *ResumeTaskResponse) Reset() {
+	*x = ResumeTaskResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordResponse) String() string {
+func (x *ResumeTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordResponse) ProtoMessage() {}
+func (*ResumeTaskResponse) ProtoMessage() {}
 
-func (x *RecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[14]
+func (x *ResumeTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,57 +1203,52 @@ func (x *RecordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordResponse.ProtoReflect.Descriptor instead.
-func (*RecordResponse) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ResumeTaskResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTaskResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RecordResponse) GetCode() int32 {
+func (x *ResumeTaskResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *RecordResponse) GetMessage() string {
+func (x *ResumeTaskResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *RecordResponse) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-// --- 批量压测 ---
-type BenchRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameIds        []int64                `protobuf:"varint,1,rep,packed,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`                 // 游戏ID列表（空=全部游戏）
-	MemberCount    int32                  `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`            // 每游戏用户数
-	TimesPerMember int32                  `protobuf:"varint,3,opt,name=times_per_member,json=timesPerMember,proto3" json:"times_per_member,omitempty"` // 每用户执行次数
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// --- 会话列表 ---
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                   // 任务ID
+	State         SessionState           `protobuf:"varint,2,opt,name=state,proto3,enum=stress.v1.SessionState" json:"state,omitempty"`      // 状态过滤（0=全部）
+	MinStateSec   int64                  `protobuf:"varint,3,opt,name=min_state_sec,json=minStateSec,proto3" json:"min_state_sec,omitempty"` // 处于当前状态至少 N 秒（如卡在 BONUS_SELECT）
+	MinIdleSec    int64                  `protobuf:"varint,4,opt,name=min_idle_sec,json=minIdleSec,proto3" json:"min_idle_sec,omitempty"`    // 距上次成功请求至少 N 秒（从未成功的会话按开始时间计）
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                  // 最多返回条数，0 为默认 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BenchRequest) Reset() {
-	*x = BenchRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[15]
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BenchRequest) String() string {
+func (x *ListSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BenchRequest) ProtoMessage() {}
+func (*ListSessionsRequest) ProtoMessage() {}
 
-func (x *BenchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[15]
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,57 +1259,71 @@ func (x *BenchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BenchRequest.ProtoReflect.Descriptor instead.
-func (*BenchRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *BenchRequest) GetGameIds() []int64 {
+func (x *ListSessionsRequest) GetTaskId() string {
 	if x != nil {
-		return x.GameIds
+		return x.TaskId
 	}
-	return nil
+	return ""
 }
 
-func (x *BenchRequest) GetMemberCount() int32 {
+func (x *ListSessionsRequest) GetState() SessionState {
 	if x != nil {
-		return x.MemberCount
+		return x.State
+	}
+	return SessionState_SESSION_UNSPECIFIED
+}
+
+func (x *ListSessionsRequest) GetMinStateSec() int64 {
+	if x != nil {
+		return x.MinStateSec
 	}
 	return 0
 }
 
-func (x *BenchRequest) GetTimesPerMember() int32 {
+func (x *ListSessionsRequest) GetMinIdleSec() int64 {
 	if x != nil {
-		return x.TimesPerMember
+		return x.MinIdleSec
 	}
 	return 0
 }
 
-type BenchResponse struct {
+func (x *ListSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	TaskIds       string                 `protobuf:"bytes,3,opt,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"` // 创建的任务ID列表
-	Fails         []string               `protobuf:"bytes,4,rep,name=fails,proto3" json:"fails,omitempty"`                    // 失败信息 ["gameID:error", ...]
+	Sessions      []*SessionInfo         `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions,omitempty"` // 会话列表（按空闲时长倒序）
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`      // 符合条件的总数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BenchResponse) Reset() {
-	*x = BenchResponse{}
-	mi := &file_stress_v1_stress_proto_msgTypes[16]
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BenchResponse) String() string {
+func (x *ListSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BenchResponse) ProtoMessage() {}
+func (*ListSessionsResponse) ProtoMessage() {}
 
-func (x *BenchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[16]
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,61 +1334,69 @@ func (x *BenchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BenchResponse.ProtoReflect.Descriptor instead.
-func (*BenchResponse) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *BenchResponse) GetCode() int32 {
+func (x *ListSessionsResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *BenchResponse) GetMessage() string {
+func (x *ListSessionsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *BenchResponse) GetTaskIds() string {
+func (x *ListSessionsResponse) GetSessions() []*SessionInfo {
 	if x != nil {
-		return x.TaskIds
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-func (x *BenchResponse) GetFails() []string {
+func (x *ListSessionsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Fails
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-// --- 清理环境 ---
-type CleanupRequest struct {
+type SessionInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        string                 `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`                                      // 成员名
+	State         SessionState           `protobuf:"varint,2,opt,name=state,proto3,enum=stress.v1.SessionState" json:"state,omitempty"`           // 当前状态
+	Process       int32                  `protobuf:"varint,3,opt,name=process,proto3" json:"process,omitempty"`                                   // 已完成局数
+	TryTimes      int32                  `protobuf:"varint,4,opt,name=try_times,json=tryTimes,proto3" json:"try_times,omitempty"`                 // 当前连续重试次数
+	Retries       int64                  `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`                                   // 累计重试次数
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`               // 最近错误
+	StateSec      int64                  `protobuf:"varint,7,opt,name=state_sec,json=stateSec,proto3" json:"state_sec,omitempty"`                 // 处于当前状态的秒数
+	IdleSec       int64                  `protobuf:"varint,8,opt,name=idle_sec,json=idleSec,proto3" json:"idle_sec,omitempty"`                    // 距上次成功请求的秒数
+	LastSuccessAt string                 `protobuf:"bytes,9,opt,name=last_success_at,json=lastSuccessAt,proto3" json:"last_success_at,omitempty"` // 上次成功请求时间（从未成功为空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CleanupRequest) Reset() {
-	*x = CleanupRequest{}
-	mi := &file_stress_v1_stress_proto_msgTypes[17]
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_stress_v1_stress_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CleanupRequest) String() string {
+func (x *SessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupRequest) ProtoMessage() {}
+func (*SessionInfo) ProtoMessage() {}
 
-func (x *CleanupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[17]
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,104 +1407,103 @@ func (x *CleanupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupRequest.ProtoReflect.Descriptor instead.
-func (*CleanupRequest) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{17}
-}
-
-type CleanupResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	RedisError    string                 `protobuf:"bytes,3,opt,name=redis_error,json=redisError,proto3" json:"redis_error,omitempty"` // Redis 清理错误信息
-	MysqlError    string                 `protobuf:"bytes,4,opt,name=mysql_error,json=mysqlError,proto3" json:"mysql_error,omitempty"` // MySQL 清理错误信息
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CleanupResponse) Reset() {
-	*x = CleanupResponse{}
-	mi := &file_stress_v1_stress_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SessionInfo) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
 }
 
-func (x *CleanupResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SessionInfo) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_SESSION_UNSPECIFIED
 }
 
-func (*CleanupResponse) ProtoMessage() {}
-
-func (x *CleanupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[18]
+func (x *SessionInfo) GetProcess() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Process
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CleanupResponse.ProtoReflect.Descriptor instead.
-func (*CleanupResponse) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{18}
+func (x *SessionInfo) GetTryTimes() int32 {
+	if x != nil {
+		return x.TryTimes
+	}
+	return 0
 }
 
-func (x *CleanupResponse) GetCode() int32 {
+func (x *SessionInfo) GetRetries() int64 {
 	if x != nil {
-		return x.Code
+		return x.Retries
 	}
 	return 0
 }
 
-func (x *CleanupResponse) GetMessage() string {
+func (x *SessionInfo) GetLastError() string {
 	if x != nil {
-		return x.Message
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *CleanupResponse) GetRedisError() string {
+func (x *SessionInfo) GetStateSec() int64 {
 	if x != nil {
-		return x.RedisError
+		return x.StateSec
 	}
-	return ""
+	return 0
 }
 
-func (x *CleanupResponse) GetMysqlError() string {
+func (x *SessionInfo) GetIdleSec() int64 {
 	if x != nil {
-		return x.MysqlError
+		return x.IdleSec
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetLastSuccessAt() string {
+	if x != nil {
+		return x.LastSuccessAt
 	}
 	return ""
 }
 
-// 游戏信息
-type Game struct {
+// --- 冒烟测试 ---
+type SmokeTestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`            // 游戏ID
-	GameName      string                 `protobuf:"bytes,2,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`       // 游戏名称
-	BetSize       []float64              `protobuf:"fixed64,3,rep,packed,name=bet_size,json=betSize,proto3" json:"bet_size,omitempty"` // 下注列表
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`      // 游戏ID
+	BetOrder      *BetOrderConfig        `protobuf:"bytes,2,opt,name=bet_order,json=betOrder,proto3" json:"bet_order,omitempty"` // 下注配置（为空取游戏中间档下注额、1 倍）
+	Member        string                 `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`                     // 指定成员（为空从成员池借用一个）
+	Spins         int32                  `protobuf:"varint,4,opt,name=spins,proto3" json:"spins,omitempty"`                      // 需完成的局数，默认 1
+	Environment   string                 `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`           // 目标环境（为空使用默认环境）
+	Merchant      string                 `protobuf:"bytes,6,opt,name=merchant,proto3" json:"merchant,omitempty"`                 // 商户（为空使用环境配置的 merchant）
+	Transport     string                 `protobuf:"bytes,7,opt,name=transport,proto3" json:"transport,omitempty"`               // 传输方式（同 TaskConfig.transport）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
-	mi := &file_stress_v1_stress_proto_msgTypes[19]
+func (x *SmokeTestRequest) Reset() {
+	*x = SmokeTestRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Game) String() string {
+func (x *SmokeTestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*SmokeTestRequest) ProtoMessage() {}
 
-func (x *Game) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[19]
+func (x *SmokeTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,59 +1514,86 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SmokeTestRequest.ProtoReflect.Descriptor instead.
+func (*SmokeTestRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Game) GetGameId() int64 {
+func (x *SmokeTestRequest) GetGameId() int64 {
 	if x != nil {
 		return x.GameId
 	}
 	return 0
 }
 
-func (x *Game) GetGameName() string {
+func (x *SmokeTestRequest) GetBetOrder() *BetOrderConfig {
 	if x != nil {
-		return x.GameName
+		return x.BetOrder
+	}
+	return nil
+}
+
+func (x *SmokeTestRequest) GetMember() string {
+	if x != nil {
+		return x.Member
 	}
 	return ""
 }
 
-func (x *Game) GetBetSize() []float64 {
+func (x *SmokeTestRequest) GetSpins() int32 {
 	if x != nil {
-		return x.BetSize
+		return x.Spins
 	}
-	return nil
+	return 0
 }
 
-// 任务配置
-type TaskConfig struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameId         int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                           // 游戏ID
-	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // 任务描述
-	MemberCount    int32                  `protobuf:"varint,3,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`            // 用户数量
-	TimesPerMember int32                  `protobuf:"varint,4,opt,name=times_per_member,json=timesPerMember,proto3" json:"times_per_member,omitempty"` // 每个用户执行次数
-	BetOrder       *BetOrderConfig        `protobuf:"bytes,5,opt,name=bet_order,json=betOrder,proto3" json:"bet_order,omitempty"`                      // 下注配置
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SmokeTestRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
 }
 
-func (x *TaskConfig) Reset() {
-	*x = TaskConfig{}
-	mi := &file_stress_v1_stress_proto_msgTypes[20]
+func (x *SmokeTestRequest) GetMerchant() string {
+	if x != nil {
+		return x.Merchant
+	}
+	return ""
+}
+
+func (x *SmokeTestRequest) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+type SmokeTestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 失败原因
+	Passed        bool                   `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`  // 全流程成功
+	Member        string                 `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`   // 实际使用的成员
+	Steps         []*SmokeStep           `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`     // 每次请求的记录（按顺序）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmokeTestResponse) Reset() {
+	*x = SmokeTestResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskConfig) String() string {
+func (x *SmokeTestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskConfig) ProtoMessage() {}
+func (*SmokeTestResponse) ProtoMessage() {}
 
-func (x *TaskConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[20]
+func (x *SmokeTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,71 +1604,177 @@ func (x *TaskConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskConfig.ProtoReflect.Descriptor instead.
-func (*TaskConfig) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SmokeTestResponse.ProtoReflect.Descriptor instead.
+func (*SmokeTestResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TaskConfig) GetGameId() int64 {
+func (x *SmokeTestResponse) GetCode() int32 {
 	if x != nil {
-		return x.GameId
+		return x.Code
 	}
 	return 0
 }
 
-func (x *TaskConfig) GetDescription() string {
+func (x *SmokeTestResponse) GetMessage() string {
 	if x != nil {
-		return x.Description
+		return x.Message
 	}
 	return ""
 }
 
-func (x *TaskConfig) GetMemberCount() int32 {
+func (x *SmokeTestResponse) GetPassed() bool {
 	if x != nil {
-		return x.MemberCount
+		return x.Passed
+	}
+	return false
+}
+
+func (x *SmokeTestResponse) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *SmokeTestResponse) GetSteps() []*SmokeStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type SmokeStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`                                    // 请求类型：launch / login / betorder / betbonus
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                  // 请求地址
+	Request       string                 `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`                          // 原始请求体
+	HttpStatus    int32                  `protobuf:"varint,4,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"` // HTTP 状态码（未拿到响应为 0）
+	Response      string                 `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`                        // 原始响应体
+	Decoded       string                 `protobuf:"bytes,6,opt,name=decoded,proto3" json:"decoded,omitempty"`                          // 解码后的数据（JSON；protobuf 游戏为转换后的 map）
+	LatencyMs     int64                  `protobuf:"varint,7,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`    // 耗时
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                              // 错误信息
+	Predicates    []*Predicate           `protobuf:"bytes,9,rep,name=predicates,proto3" json:"predicates,omitempty"`                    // 本步的游戏判定结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmokeStep) Reset() {
+	*x = SmokeStep{}
+	mi := &file_stress_v1_stress_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmokeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmokeStep) ProtoMessage() {}
+
+func (x *SmokeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmokeStep.ProtoReflect.Descriptor instead.
+func (*SmokeStep) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SmokeStep) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *SmokeStep) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SmokeStep) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *SmokeStep) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
 	}
 	return 0
 }
 
-func (x *TaskConfig) GetTimesPerMember() int32 {
+func (x *SmokeStep) GetResponse() string {
 	if x != nil {
-		return x.TimesPerMember
+		return x.Response
+	}
+	return ""
+}
+
+func (x *SmokeStep) GetDecoded() string {
+	if x != nil {
+		return x.Decoded
+	}
+	return ""
+}
+
+func (x *SmokeStep) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
 	}
 	return 0
 }
 
-func (x *TaskConfig) GetBetOrder() *BetOrderConfig {
+func (x *SmokeStep) GetError() string {
 	if x != nil {
-		return x.BetOrder
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SmokeStep) GetPredicates() []*Predicate {
+	if x != nil {
+		return x.Predicates
 	}
 	return nil
 }
 
-// 下注配置
-type BetOrderConfig struct {
+type Predicate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BaseMoney     float64                `protobuf:"fixed64,1,opt,name=base_money,json=baseMoney,proto3" json:"base_money,omitempty"` // 基础金额
-	Multiple      int64                  `protobuf:"varint,2,opt,name=multiple,proto3" json:"multiple,omitempty"`                     // 倍数
-	Purchase      int64                  `protobuf:"varint,3,opt,name=purchase,proto3" json:"purchase,omitempty"`                     // 购买次数
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`    // IsSpinOver / NeedBetBonus / BonusNextState
+	Fired         bool                   `protobuf:"varint,2,opt,name=fired,proto3" json:"fired,omitempty"` // 判定结果
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BetOrderConfig) Reset() {
-	*x = BetOrderConfig{}
-	mi := &file_stress_v1_stress_proto_msgTypes[21]
+func (x *Predicate) Reset() {
+	*x = Predicate{}
+	mi := &file_stress_v1_stress_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BetOrderConfig) String() string {
+func (x *Predicate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BetOrderConfig) ProtoMessage() {}
+func (*Predicate) ProtoMessage() {}
 
-func (x *BetOrderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[21]
+func (x *Predicate) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,63 +1785,2868 @@ func (x *BetOrderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BetOrderConfig.ProtoReflect.Descriptor instead.
-func (*BetOrderConfig) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Predicate.ProtoReflect.Descriptor instead.
+func (*Predicate) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Predicate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Predicate) GetFired() bool {
+	if x != nil {
+		return x.Fired
+	}
+	return false
+}
+
+// --- 订阅任务进度 ---
+type WatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`              // 任务ID
+	IntervalMs    int32                  `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"` // 快照推送间隔（毫秒），0 为默认 1000，最小 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskRequest) Reset() {
+	*x = WatchTaskRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskRequest) ProtoMessage() {}
+
+func (x *WatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WatchTaskRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type WatchTaskEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          WatchTaskEvent_Kind    `protobuf:"varint,1,opt,name=kind,proto3,enum=stress.v1.WatchTaskEvent_Kind" json:"kind,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 任务ID
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`              // 当前任务状态
+	Time          string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`                   // 事件时间
+	Snapshot      *TaskCompletionReport  `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`           // 进度快照（SNAPSHOT）
+	Errors        []*ErrorSample         `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`               // 错误样本（ERROR）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskEvent) Reset() {
+	*x = WatchTaskEvent{}
+	mi := &file_stress_v1_stress_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskEvent) ProtoMessage() {}
+
+func (x *WatchTaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskEvent.ProtoReflect.Descriptor instead.
+func (*WatchTaskEvent) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WatchTaskEvent) GetKind() WatchTaskEvent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return WatchTaskEvent_KIND_UNSPECIFIED
+}
+
+func (x *WatchTaskEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WatchTaskEvent) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *WatchTaskEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *WatchTaskEvent) GetSnapshot() *TaskCompletionReport {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *WatchTaskEvent) GetErrors() []*ErrorSample {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// --- 删除任务 ---
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 任务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// --- 获取任务结果 ---
+type RecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 任务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRequest) Reset() {
+	*x = RecordRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRequest) ProtoMessage() {}
+
+func (x *RecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRequest.ProtoReflect.Descriptor instead.
+func (*RecordRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecordRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type RecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 提示信息
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordResponse) Reset() {
+	*x = RecordResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordResponse) ProtoMessage() {}
+
+func (x *RecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordResponse.ProtoReflect.Descriptor instead.
+func (*RecordResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RecordResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RecordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecordResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// --- 批量压测 ---
+type BenchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameIds        []int64                `protobuf:"varint,1,rep,packed,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`                 // 游戏ID列表（空=全部游戏）
+	MemberCount    int32                  `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`            // 每游戏用户数
+	TimesPerMember int32                  `protobuf:"varint,3,opt,name=times_per_member,json=timesPerMember,proto3" json:"times_per_member,omitempty"` // 每用户执行次数
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BenchRequest) Reset() {
+	*x = BenchRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchRequest) ProtoMessage() {}
+
+func (x *BenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchRequest.ProtoReflect.Descriptor instead.
+func (*BenchRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BenchRequest) GetGameIds() []int64 {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+func (x *BenchRequest) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *BenchRequest) GetTimesPerMember() int32 {
+	if x != nil {
+		return x.TimesPerMember
+	}
+	return 0
+}
+
+type BenchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TaskIds       string                 `protobuf:"bytes,3,opt,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"` // 创建的任务ID列表
+	Fails         []string               `protobuf:"bytes,4,rep,name=fails,proto3" json:"fails,omitempty"`                    // 失败信息 ["gameID:error", ...]
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BenchResponse) Reset() {
+	*x = BenchResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchResponse) ProtoMessage() {}
+
+func (x *BenchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchResponse.ProtoReflect.Descriptor instead.
+func (*BenchResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BenchResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BenchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BenchResponse) GetTaskIds() string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return ""
+}
+
+func (x *BenchResponse) GetFails() []string {
+	if x != nil {
+		return x.Fails
+	}
+	return nil
+}
+
+// --- 清理环境 ---
+type CleanupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CleanupRequest) Reset() {
+	*x = CleanupRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupRequest) ProtoMessage() {}
+
+func (x *CleanupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupRequest.ProtoReflect.Descriptor instead.
+func (*CleanupRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{32}
+}
+
+type CleanupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RedisError    string                 `protobuf:"bytes,3,opt,name=redis_error,json=redisError,proto3" json:"redis_error,omitempty"` // Redis 清理错误信息
+	MysqlError    string                 `protobuf:"bytes,4,opt,name=mysql_error,json=mysqlError,proto3" json:"mysql_error,omitempty"` // MySQL 清理错误信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CleanupResponse) Reset() {
+	*x = CleanupResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupResponse) ProtoMessage() {}
+
+func (x *CleanupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupResponse.ProtoReflect.Descriptor instead.
+func (*CleanupResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CleanupResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CleanupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CleanupResponse) GetRedisError() string {
+	if x != nil {
+		return x.RedisError
+	}
+	return ""
+}
+
+func (x *CleanupResponse) GetMysqlError() string {
+	if x != nil {
+		return x.MysqlError
+	}
+	return ""
+}
+
+// --- 调度队列 ---
+type ListQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueRequest) Reset() {
+	*x = ListQueueRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueRequest) ProtoMessage() {}
+
+func (x *ListQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{34}
+}
+
+type ListQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paused        bool                   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`   // 是否已暂停调度
+	Running       int32                  `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"` // 运行中任务数
+	Entries       []*QueueEntry          `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`  // 排队任务（按调度顺序）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueResponse) Reset() {
+	*x = ListQueueResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueResponse) ProtoMessage() {}
+
+func (x *ListQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListQueueResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ListQueueResponse) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *ListQueueResponse) GetEntries() []*QueueEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MoveTaskRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	TaskId        string                   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                                // 任务ID
+	Position      MoveTaskRequest_Position `protobuf:"varint,2,opt,name=position,proto3,enum=stress.v1.MoveTaskRequest_Position" json:"position,omitempty"` // 目标位置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MoveTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetPosition() MoveTaskRequest_Position {
+	if x != nil {
+		return x.Position
+	}
+	return MoveTaskRequest_POSITION_UNSPECIFIED
+}
+
+type MoveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskResponse) Reset() {
+	*x = MoveTaskResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskResponse) ProtoMessage() {}
+
+func (x *MoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*MoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MoveTaskResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MoveTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PauseQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseQueueRequest) Reset() {
+	*x = PauseQueueRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseQueueRequest) ProtoMessage() {}
+
+func (x *PauseQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseQueueRequest.ProtoReflect.Descriptor instead.
+func (*PauseQueueRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{38}
+}
+
+type ResumeQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeQueueRequest) Reset() {
+	*x = ResumeQueueRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeQueueRequest) ProtoMessage() {}
+
+func (x *ResumeQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeQueueRequest.ProtoReflect.Descriptor instead.
+func (*ResumeQueueRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{39}
+}
+
+type QueueStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"` // 操作后的暂停状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueStateResponse) Reset() {
+	*x = QueueStateResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStateResponse) ProtoMessage() {}
+
+func (x *QueueStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStateResponse.ProtoReflect.Descriptor instead.
+func (*QueueStateResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *QueueStateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *QueueStateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QueueStateResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// --- 定时任务 ---
+type CreateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 名称
+	Cron  string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"` // 5 段 cron 表达式（分 时 日 月 周，服务器本地时区）
+	// Types that are valid to be assigned to Template:
+	//
+	//	*CreateScheduleRequest_Task
+	//	*CreateScheduleRequest_Bench
+	Template      isCreateScheduleRequest_Template `protobuf_oneof:"template"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetTemplate() isCreateScheduleRequest_Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateScheduleRequest) GetTask() *TaskConfig {
+	if x != nil {
+		if x, ok := x.Template.(*CreateScheduleRequest_Task); ok {
+			return x.Task
+		}
+	}
+	return nil
+}
+
+func (x *CreateScheduleRequest) GetBench() *BenchRequest {
+	if x != nil {
+		if x, ok := x.Template.(*CreateScheduleRequest_Bench); ok {
+			return x.Bench
+		}
+	}
+	return nil
+}
+
+type isCreateScheduleRequest_Template interface {
+	isCreateScheduleRequest_Template()
+}
+
+type CreateScheduleRequest_Task struct {
+	Task *TaskConfig `protobuf:"bytes,3,opt,name=task,proto3,oneof"` // 单任务模板
+}
+
+type CreateScheduleRequest_Bench struct {
+	Bench *BenchRequest `protobuf:"bytes,4,opt,name=bench,proto3,oneof"` // 批量压测模板
+}
+
+func (*CreateScheduleRequest_Task) isCreateScheduleRequest_Template() {}
+
+func (*CreateScheduleRequest_Bench) isCreateScheduleRequest_Template() {}
+
+type CreateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Schedule      *Schedule              `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"` // 创建后的定时任务
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleResponse) Reset() {
+	*x = CreateScheduleResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleResponse) ProtoMessage() {}
+
+func (x *CreateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateScheduleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{43}
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"` // 定时任务列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`        // 总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ListSchedulesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"` // 定时任务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_stress_v1_stress_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteScheduleRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+type DeleteScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_stress_v1_stress_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteScheduleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 游戏信息
+type Game struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`            // 游戏ID
+	GameName      string                 `protobuf:"bytes,2,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`       // 游戏名称
+	BetSize       []float64              `protobuf:"fixed64,3,rep,packed,name=bet_size,json=betSize,proto3" json:"bet_size,omitempty"` // 下注列表
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                           // 来源：plugin（Go 实现）/ spec（声明式定义）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_stress_v1_stress_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Game) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Game) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *Game) GetBetSize() []float64 {
+	if x != nil {
+		return x.BetSize
+	}
+	return nil
+}
+
+func (x *Game) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// 任务配置
+type TaskConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameId         int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                           // 游戏ID
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // 任务描述
+	MemberCount    int32                  `protobuf:"varint,3,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`            // 用户数量
+	TimesPerMember int32                  `protobuf:"varint,4,opt,name=times_per_member,json=timesPerMember,proto3" json:"times_per_member,omitempty"` // 每个用户执行次数（0 表示不限，需配合 duration）
+	BetOrder       *BetOrderConfig        `protobuf:"bytes,5,opt,name=bet_order,json=betOrder,proto3" json:"bet_order,omitempty"`                      // 下注配置
+	Load           *LoadProfile           `protobuf:"bytes,6,opt,name=load,proto3" json:"load,omitempty"`                                              // 开环负载模型（为空则按并发闭环压测）
+	Priority       int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`                                     // 调度优先级，越大越先调度（默认 0，同优先级先进先出）
+	Duration       string                 `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`                                      // 运行时长上限（如 "2h"），与局数目标同时配置时先到先停
+	Retry          *RetryPolicy           `protobuf:"bytes,9,opt,name=retry,proto3" json:"retry,omitempty"`                                            // 会话重试与熔断策略（为空使用默认值）
+	Slo            *SLO                   `protobuf:"bytes,10,opt,name=slo,proto3" json:"slo,omitempty"`                                               // 通过/失败判定标准（为空不判定）
+	Recording      *Recording             `protobuf:"bytes,11,opt,name=recording,proto3" json:"recording,omitempty"`                                   // 请求/响应录制（为空不录制）
+	Chaos          *Chaos                 `protobuf:"bytes,12,opt,name=chaos,proto3" json:"chaos,omitempty"`                                           // 客户端故障注入（为空不注入）
+	Environment    string                 `protobuf:"bytes,13,opt,name=environment,proto3" json:"environment,omitempty"`                               // 目标环境（stress.environments 的名称，为空使用默认环境）
+	Merchant       string                 `protobuf:"bytes,14,opt,name=merchant,proto3" json:"merchant,omitempty"`                                     // 商户（为空使用环境配置的 merchant）
+	Transport      string                 `protobuf:"bytes,15,opt,name=transport,proto3" json:"transport,omitempty"`                                   // 传输方式：http / websocket（为空按 stress.launch.transports 的游戏配置，默认 http）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TaskConfig) Reset() {
+	*x = TaskConfig{}
+	mi := &file_stress_v1_stress_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskConfig) ProtoMessage() {}
+
+func (x *TaskConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskConfig.ProtoReflect.Descriptor instead.
+func (*TaskConfig) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TaskConfig) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *TaskConfig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskConfig) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *TaskConfig) GetTimesPerMember() int32 {
+	if x != nil {
+		return x.TimesPerMember
+	}
+	return 0
+}
+
+func (x *TaskConfig) GetBetOrder() *BetOrderConfig {
+	if x != nil {
+		return x.BetOrder
+	}
+	return nil
+}
+
+func (x *TaskConfig) GetLoad() *LoadProfile {
+	if x != nil {
+		return x.Load
+	}
+	return nil
+}
+
+func (x *TaskConfig) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TaskConfig) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *TaskConfig) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *TaskConfig) GetSlo() *SLO {
+	if x != nil {
+		return x.Slo
+	}
+	return nil
+}
+
+func (x *TaskConfig) GetRecording() *Recording {
+	if x != nil {
+		return x.Recording
+	}
+	return nil
+}
+
+func (x *TaskConfig) GetChaos() *Chaos {
+	if x != nil {
+		return x.Chaos
+	}
+	return nil
+}
+
+func (x *TaskConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *TaskConfig) GetMerchant() string {
+	if x != nil {
+		return x.Merchant
+	}
+	return ""
+}
+
+func (x *TaskConfig) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+// 客户端故障注入：选中的会话在每个时机按概率制造异常行为，用于验证服务端对异常客户端的处理
+type Chaos struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionRate    float64                `protobuf:"fixed64,1,opt,name=session_rate,json=sessionRate,proto3" json:"session_rate,omitempty"`          // 参与注入的会话比例（0 为全部会话）
+	DropConnection float64                `protobuf:"fixed64,2,opt,name=drop_connection,json=dropConnection,proto3" json:"drop_connection,omitempty"` // betorder / betbonus 请求发出后立即断开连接（不读响应）的概率
+	AbandonBonus   float64                `protobuf:"fixed64,3,opt,name=abandon_bonus,json=abandonBonus,proto3" json:"abandon_bonus,omitempty"`       // bonus 中放弃会话的概率（每次 betbonus 前判定，放弃后会话结束）
+	StaleToken     float64                `protobuf:"fixed64,4,opt,name=stale_token,json=staleToken,proto3" json:"stale_token,omitempty"`             // betorder 前先用已失效 token 请求一次的概率
+	DuplicateBet   float64                `protobuf:"fixed64,5,opt,name=duplicate_bet,json=duplicateBet,proto3" json:"duplicate_bet,omitempty"`       // 并发发送两次相同 betorder 的概率
+	IdleExpiry     float64                `protobuf:"fixed64,6,opt,name=idle_expiry,json=idleExpiry,proto3" json:"idle_expiry,omitempty"`             // betorder 前空闲 idle_duration 的概率（触发 token 过期）
+	IdleDuration   string                 `protobuf:"bytes,7,opt,name=idle_duration,json=idleDuration,proto3" json:"idle_duration,omitempty"`         // 空闲时长（如 "10m"，应大于 token 有效期），默认 5m
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Chaos) Reset() {
+	*x = Chaos{}
+	mi := &file_stress_v1_stress_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chaos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chaos) ProtoMessage() {}
+
+func (x *Chaos) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chaos.ProtoReflect.Descriptor instead.
+func (*Chaos) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Chaos) GetSessionRate() float64 {
+	if x != nil {
+		return x.SessionRate
+	}
+	return 0
+}
+
+func (x *Chaos) GetDropConnection() float64 {
+	if x != nil {
+		return x.DropConnection
+	}
+	return 0
+}
+
+func (x *Chaos) GetAbandonBonus() float64 {
+	if x != nil {
+		return x.AbandonBonus
+	}
+	return 0
+}
+
+func (x *Chaos) GetStaleToken() float64 {
+	if x != nil {
+		return x.StaleToken
+	}
+	return 0
+}
+
+func (x *Chaos) GetDuplicateBet() float64 {
+	if x != nil {
+		return x.DuplicateBet
+	}
+	return 0
+}
+
+func (x *Chaos) GetIdleExpiry() float64 {
+	if x != nil {
+		return x.IdleExpiry
+	}
+	return 0
+}
+
+func (x *Chaos) GetIdleDuration() string {
+	if x != nil {
+		return x.IdleDuration
+	}
+	return ""
+}
+
+// 请求/响应录制：被采中的成员录制全部请求（gzip JSONL），可用 cmd/replay 离线回放到游戏插件
+type Recording struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SampleRate    float64                `protobuf:"fixed64,1,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"` // 成员采样率（0 为全部成员）
+	UploadS3      bool                   `protobuf:"varint,2,opt,name=upload_s3,json=uploadS3,proto3" json:"upload_s3,omitempty"`        // 结束后上传 S3（否则保留在本地 stress.recording.dir）
+	MaxRecords    int64                  `protobuf:"varint,3,opt,name=max_records,json=maxRecords,proto3" json:"max_records,omitempty"`  // 录制条数上限，0 为默认 100000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recording) Reset() {
+	*x = Recording{}
+	mi := &file_stress_v1_stress_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recording) ProtoMessage() {}
+
+func (x *Recording) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recording.ProtoReflect.Descriptor instead.
+func (*Recording) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Recording) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Recording) GetUploadS3() bool {
+	if x != nil {
+		return x.UploadS3
+	}
+	return false
+}
+
+func (x *Recording) GetMaxRecords() int64 {
+	if x != nil {
+		return x.MaxRecords
+	}
+	return 0
+}
+
+// 下注配置
+type BetOrderConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseMoney     float64                `protobuf:"fixed64,1,opt,name=base_money,json=baseMoney,proto3" json:"base_money,omitempty"` // 基础金额
+	Multiple      int64                  `protobuf:"varint,2,opt,name=multiple,proto3" json:"multiple,omitempty"`                     // 倍数
+	Purchase      int64                  `protobuf:"varint,3,opt,name=purchase,proto3" json:"purchase,omitempty"`                     // 购买次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BetOrderConfig) Reset() {
+	*x = BetOrderConfig{}
+	mi := &file_stress_v1_stress_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BetOrderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BetOrderConfig) ProtoMessage() {}
+
+func (x *BetOrderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BetOrderConfig.ProtoReflect.Descriptor instead.
+func (*BetOrderConfig) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BetOrderConfig) GetBaseMoney() float64 {
+	if x != nil {
+		return x.BaseMoney
+	}
+	return 0
+}
+
+func (x *BetOrderConfig) GetMultiple() int64 {
+	if x != nil {
+		return x.Multiple
+	}
+	return 0
+}
+
+func (x *BetOrderConfig) GetPurchase() int64 {
+	if x != nil {
+		return x.Purchase
+	}
+	return 0
+}
+
+// 开环负载模型：所有会话共享令牌桶，按目标速率发起 betorder，与服务端响应速度解耦
+type LoadProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          float64                `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`                       // 目标速率（betorder/s），0 表示不限速
+	RampUp        string                 `protobuf:"bytes,2,opt,name=ramp_up,json=rampUp,proto3" json:"ramp_up,omitempty"`       // 爬坡时长（如 "5m"），速率从 0 线性升至 rate
+	Steady        string                 `protobuf:"bytes,3,opt,name=steady,proto3" json:"steady,omitempty"`                     // 稳定时长（如 "30m"），为空表示一直保持直到达成目标
+	RampDown      string                 `protobuf:"bytes,4,opt,name=ramp_down,json=rampDown,proto3" json:"ramp_down,omitempty"` // 降速时长，速率从 rate 线性降至 0 后结束（需配合 steady）
+	Burst         int32                  `protobuf:"varint,5,opt,name=burst,proto3" json:"burst,omitempty"`                      // 令牌桶容量，默认 rate/10（至少 1）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadProfile) Reset() {
+	*x = LoadProfile{}
+	mi := &file_stress_v1_stress_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadProfile) ProtoMessage() {}
+
+func (x *LoadProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadProfile.ProtoReflect.Descriptor instead.
+func (*LoadProfile) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LoadProfile) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *LoadProfile) GetRampUp() string {
+	if x != nil {
+		return x.RampUp
+	}
+	return ""
+}
+
+func (x *LoadProfile) GetSteady() string {
+	if x != nil {
+		return x.Steady
+	}
+	return ""
+}
+
+func (x *LoadProfile) GetRampDown() string {
+	if x != nil {
+		return x.RampDown
+	}
+	return ""
+}
+
+func (x *LoadProfile) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+// 会话重试策略：失败后按 rules 决定动作，未命中规则时按指数退避重试
+type RetryPolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts      int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                                                                            // 连续失败次数上限，超过后会话失败，默认 5
+	StateMaxAttempts map[string]int32       `protobuf:"bytes,2,rep,name=state_max_attempts,json=stateMaxAttempts,proto3" json:"state_max_attempts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 按会话状态覆盖上限，key: launching / logging_in / betting / bonus_select
+	BaseDelay        string                 `protobuf:"bytes,3,opt,name=base_delay,json=baseDelay,proto3" json:"base_delay,omitempty"`                                                                                                   // 首次退避时长，默认 100ms
+	MaxDelay         string                 `protobuf:"bytes,4,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`                                                                                                      // 退避上限，默认 10s
+	Multiplier       float64                `protobuf:"fixed64,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                                                                                                                // 退避倍数，默认 1（固定间隔）
+	Jitter           float64                `protobuf:"fixed64,6,opt,name=jitter,proto3" json:"jitter,omitempty"`                                                                                                                        // 抖动比例，实际等待为 delay×[1-jitter, 1+jitter]
+	Rules            []*ErrorRule           `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`                                                                                                                            // 错误规则，按顺序匹配第一条（为空使用默认规则）
+	Breaker          *CircuitBreaker        `protobuf:"bytes,8,opt,name=breaker,proto3" json:"breaker,omitempty"`                                                                                                                        // 任务级熔断（为空不启用）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_stress_v1_stress_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetStateMaxAttempts() map[string]int32 {
+	if x != nil {
+		return x.StateMaxAttempts
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetBaseDelay() string {
+	if x != nil {
+		return x.BaseDelay
+	}
+	return ""
+}
+
+func (x *RetryPolicy) GetMaxDelay() string {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return ""
+}
+
+func (x *RetryPolicy) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRules() []*ErrorRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.Breaker
+	}
+	return nil
+}
+
+// 错误规则：各匹配条件为空表示不限，全部满足时命中
+type ErrorRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`                                          // 请求类型：launch / login / betorder / betbonus
+	Cause         string                 `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`                                    // 错误原因（见 ErrorClass.cause），支持末尾 * 前缀匹配，如 code_* / http_5*
+	Codes         []int32                `protobuf:"varint,3,rep,packed,name=codes,proto3" json:"codes,omitempty"`                            // 业务错误码
+	Keywords      []string               `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`                              // 错误信息关键字（不区分大小写，任一命中）
+	Action        ErrorRule_Action       `protobuf:"varint,5,opt,name=action,proto3,enum=stress.v1.ErrorRule_Action" json:"action,omitempty"` // 命中后的动作
+	Delay         string                 `protobuf:"bytes,6,opt,name=delay,proto3" json:"delay,omitempty"`                                    // 执行动作前的等待时长（为空按退避计算）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorRule) Reset() {
+	*x = ErrorRule{}
+	mi := &file_stress_v1_stress_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorRule) ProtoMessage() {}
+
+func (x *ErrorRule) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorRule.ProtoReflect.Descriptor instead.
+func (*ErrorRule) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ErrorRule) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *ErrorRule) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *ErrorRule) GetCodes() []int32 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *ErrorRule) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *ErrorRule) GetAction() ErrorRule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ErrorRule_ACTION_UNSPECIFIED
+}
+
+func (x *ErrorRule) GetDelay() string {
+	if x != nil {
+		return x.Delay
+	}
+	return ""
+}
+
+// 任务级熔断：窗口内错误率超过阈值时任务失败
+type CircuitBreaker struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorRate     float64                `protobuf:"fixed64,1,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`      // 错误率阈值 (0,1]，0 不启用
+	Window        string                 `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`                               // 统计窗口，默认 30s
+	MinRequests   int64                  `protobuf:"varint,3,opt,name=min_requests,json=minRequests,proto3" json:"min_requests,omitempty"` // 窗口内最少请求数，默认 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	mi := &file_stress_v1_stress_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CircuitBreaker) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetMinRequests() int64 {
+	if x != nil {
+		return x.MinRequests
+	}
+	return 0
+}
+
+// 通过/失败判定：运行中由上报协程周期检查（预热期后），finalize 时再按最终数据判定一次
+type SLO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assertions    []*Assertion           `protobuf:"bytes,1,rep,name=assertions,proto3" json:"assertions,omitempty"` // 断言列表，全部通过则任务通过
+	Warmup        string                 `protobuf:"bytes,2,opt,name=warmup,proto3" json:"warmup,omitempty"`         // 运行中检查的预热时长（如 "1m"），默认 1m；finalize 判定不受影响
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLO) Reset() {
+	*x = SLO{}
+	mi := &file_stress_v1_stress_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLO) ProtoMessage() {}
+
+func (x *SLO) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLO.ProtoReflect.Descriptor instead.
+func (*SLO) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SLO) GetAssertions() []*Assertion {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *SLO) GetWarmup() string {
+	if x != nil {
+		return x.Warmup
+	}
+	return ""
+}
+
+// 单条断言：<metric>(<request>) <operator> <value>，如 latency_p99(betorder) lt 200
+type Assertion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        string                 `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`     // 指标：qps / error_rate_pct / rtp_pct / client_rtp_pct / latency_avg / latency_p50 / latency_p90 / latency_p95 / latency_p99 / latency_p999 / latency_max（毫秒）
+	Request       string                 `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`   // 延迟类指标的请求类型：launch / login / betorder / betbonus，默认 betorder
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"` // 比较：lt / lte / gt / gte
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`     // 阈值
+	Hard          bool                   `protobuf:"varint,5,opt,name=hard,proto3" json:"hard,omitempty"`        // 硬性指标：运行中违反即提前终止任务（TASK_FAILED）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assertion) Reset() {
+	*x = Assertion{}
+	mi := &file_stress_v1_stress_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assertion) ProtoMessage() {}
+
+func (x *Assertion) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assertion.ProtoReflect.Descriptor instead.
+func (*Assertion) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Assertion) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *Assertion) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *Assertion) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Assertion) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Assertion) GetHard() bool {
+	if x != nil {
+		return x.Hard
+	}
+	return false
+}
+
+// 断言结果
+type AssertionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                    // 断言描述，如 latency_p99(betorder) lt 200
+	Actual        float64                `protobuf:"fixed64,2,opt,name=actual,proto3" json:"actual,omitempty"`              // 实际值
+	Passed        bool                   `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`               // 是否通过
+	Hard          bool                   `protobuf:"varint,4,opt,name=hard,proto3" json:"hard,omitempty"`                   // 是否硬性指标
+	NoData        bool                   `protobuf:"varint,5,opt,name=no_data,json=noData,proto3" json:"no_data,omitempty"` // 无数据（不计为失败）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssertionResult) Reset() {
+	*x = AssertionResult{}
+	mi := &file_stress_v1_stress_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssertionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssertionResult) ProtoMessage() {}
+
+func (x *AssertionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssertionResult.ProtoReflect.Descriptor instead.
+func (*AssertionResult) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AssertionResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssertionResult) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+func (x *AssertionResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *AssertionResult) GetHard() bool {
+	if x != nil {
+		return x.Hard
+	}
+	return false
+}
+
+func (x *AssertionResult) GetNoData() bool {
+	if x != nil {
+		return x.NoData
+	}
+	return false
+}
+
+// 任务完整信息
+type Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // 任务ID
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`              // 任务描述
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                       // 任务状态
+	Process       int64                  `protobuf:"varint,4,opt,name=process,proto3" json:"process,omitempty"`                     // 已完成局数
+	Config        *TaskConfig            `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`                        // 任务配置
+	RecordUrl     string                 `protobuf:"bytes,6,opt,name=record_url,json=recordUrl,proto3" json:"record_url,omitempty"` // 任务结果地址
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间（上海时区）
+	StartAt       string                 `protobuf:"bytes,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`       // 开始时间（上海时区）
+	FinishAt      string                 `protobuf:"bytes,9,opt,name=finish_at,json=finishAt,proto3" json:"finish_at,omitempty"`    // 更新时间（上海时区）
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`                       // 失败原因（仅 TASK_FAILED）
+	Report        *TaskCompletionReport  `protobuf:"bytes,11,opt,name=report,proto3" json:"report,omitempty"`                       // 统计报告：运行中为实时快照，结束后为最终报告（排队中为空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_stress_v1_stress_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Task) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Task) GetProcess() int64 {
+	if x != nil {
+		return x.Process
+	}
+	return 0
+}
+
+func (x *Task) GetConfig() *TaskConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Task) GetRecordUrl() string {
+	if x != nil {
+		return x.RecordUrl
+	}
+	return ""
+}
+
+func (x *Task) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Task) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *Task) GetFinishAt() string {
+	if x != nil {
+		return x.FinishAt
+	}
+	return ""
+}
+
+func (x *Task) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Task) GetReport() *TaskCompletionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// 任务完成/统计报告（供 Prometheus、飞书通知、API 统一复用）
+type TaskCompletionReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                        // 任务ID
+	GameId        int64                  `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                       // 游戏ID
+	GameName      string                 `protobuf:"bytes,3,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`                  // 游戏名称
+	Process       int64                  `protobuf:"varint,4,opt,name=process,proto3" json:"process,omitempty"`                                   // 已完成局数
+	Target        int64                  `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`                                     // 目标局数
+	Step          int64                  `protobuf:"varint,6,opt,name=step,proto3" json:"step,omitempty"`                                         // 总步数
+	Duration      string                 `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`                                  // 耗时（格式化，如 1.5h）
+	Qps           float64                `protobuf:"fixed64,8,opt,name=qps,proto3" json:"qps,omitempty"`                                          // QPS
+	AvgLatency    string                 `protobuf:"bytes,9,opt,name=avg_latency,json=avgLatency,proto3" json:"avg_latency,omitempty"`            // 平均延迟（格式化，如 12.34ms）
+	OrderCount    int64                  `protobuf:"varint,10,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`          // 订单数
+	TotalBet      int64                  `protobuf:"varint,11,opt,name=total_bet,json=totalBet,proto3" json:"total_bet,omitempty"`                // 总下注（×1e4）
+	TotalWin      int64                  `protobuf:"varint,12,opt,name=total_win,json=totalWin,proto3" json:"total_win,omitempty"`                // 总赢（×1e4）
+	RtpPct        float64                `protobuf:"fixed64,13,opt,name=rtp_pct,json=rtpPct,proto3" json:"rtp_pct,omitempty"`                     // RTP %
+	ActiveMembers int64                  `protobuf:"varint,14,opt,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"` // 活跃成员数（运行中）
+	Completed     int64                  `protobuf:"varint,15,opt,name=completed,proto3" json:"completed,omitempty"`                              // 完成成员数
+	Failed        int64                  `protobuf:"varint,16,opt,name=failed,proto3" json:"failed,omitempty"`                                    // 失败成员数
+	FailedReqs    int64                  `protobuf:"varint,17,opt,name=failed_reqs,json=failedReqs,proto3" json:"failed_reqs,omitempty"`          // 失败请求数
+	ProgressPct   float64                `protobuf:"fixed64,18,opt,name=progress_pct,json=progressPct,proto3" json:"progress_pct,omitempty"`      // 进度 % (process/target*100, 上限 100)
+	Url           string                 `protobuf:"bytes,19,opt,name=url,proto3" json:"url,omitempty"`                                           // 图表URL
+	OrderWarning  string                 `protobuf:"bytes,20,opt,name=order_warning,json=orderWarning,proto3" json:"order_warning,omitempty"`     // 订单等待超时警告（为空表示正常）
+	BonusStep     int64                  `protobuf:"varint,21,opt,name=bonus_step,json=bonusStep,proto3" json:"bonus_step,omitempty"`             // bonus 请求数（不写入订单）
+	TargetRate    float64                `protobuf:"fixed64,22,opt,name=target_rate,json=targetRate,proto3" json:"target_rate,omitempty"`         // 当前目标速率（开环模式，betorder/s）
+	Latencies     []*LatencyStats        `protobuf:"bytes,23,rep,name=latencies,proto3" json:"latencies,omitempty"`                               // 各类请求延迟分布
+	Errors        []*ErrorClass          `protobuf:"bytes,24,rep,name=errors,proto3" json:"errors,omitempty"`                                     // 错误分类统计（按次数倒序）
+	Slo           []*AssertionResult     `protobuf:"bytes,25,rep,name=slo,proto3" json:"slo,omitempty"`                                           // SLO 断言结果
+	SloPassed     bool                   `protobuf:"varint,26,opt,name=slo_passed,json=sloPassed,proto3" json:"slo_passed,omitempty"`             // 全部断言通过（未配置 SLO 时为 true）
+	Recorded      int64                  `protobuf:"varint,27,opt,name=recorded,proto3" json:"recorded,omitempty"`                                // 已录制的请求数（运行中为已入队条数）
+	RecordingUrl  string                 `protobuf:"bytes,28,opt,name=recording_url,json=recordingUrl,proto3" json:"recording_url,omitempty"`     // 录制文件地址（S3 URL 或本地路径）
+	Chaos         *ChaosStats            `protobuf:"bytes,29,opt,name=chaos,proto3" json:"chaos,omitempty"`                                       // 客户端故障注入统计（未配置时为空）
+	ClientBet     int64                  `protobuf:"varint,30,opt,name=client_bet,json=clientBet,proto3" json:"client_bet,omitempty"`             // 客户端统计的总下注（×1e4，仅实现类型化响应的游戏）
+	ClientWin     int64                  `protobuf:"varint,31,opt,name=client_win,json=clientWin,proto3" json:"client_win,omitempty"`             // 客户端统计的总赢（×1e4）
+	ClientRtpPct  float64                `protobuf:"fixed64,32,opt,name=client_rtp_pct,json=clientRtpPct,proto3" json:"client_rtp_pct,omitempty"` // 客户端 RTP %（client_win/client_bet，实时）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskCompletionReport) Reset() {
+	*x = TaskCompletionReport{}
+	mi := &file_stress_v1_stress_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskCompletionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskCompletionReport) ProtoMessage() {}
+
+func (x *TaskCompletionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskCompletionReport.ProtoReflect.Descriptor instead.
+func (*TaskCompletionReport) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TaskCompletionReport) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskCompletionReport) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *TaskCompletionReport) GetProcess() int64 {
+	if x != nil {
+		return x.Process
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetStep() int64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *TaskCompletionReport) GetQps() float64 {
+	if x != nil {
+		return x.Qps
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetAvgLatency() string {
+	if x != nil {
+		return x.AvgLatency
+	}
+	return ""
+}
+
+func (x *TaskCompletionReport) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetTotalBet() int64 {
+	if x != nil {
+		return x.TotalBet
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetTotalWin() int64 {
+	if x != nil {
+		return x.TotalWin
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetRtpPct() float64 {
+	if x != nil {
+		return x.RtpPct
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetActiveMembers() int64 {
+	if x != nil {
+		return x.ActiveMembers
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetFailedReqs() int64 {
+	if x != nil {
+		return x.FailedReqs
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetProgressPct() float64 {
+	if x != nil {
+		return x.ProgressPct
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TaskCompletionReport) GetOrderWarning() string {
+	if x != nil {
+		return x.OrderWarning
+	}
+	return ""
+}
+
+func (x *TaskCompletionReport) GetBonusStep() int64 {
+	if x != nil {
+		return x.BonusStep
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetTargetRate() float64 {
+	if x != nil {
+		return x.TargetRate
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetLatencies() []*LatencyStats {
+	if x != nil {
+		return x.Latencies
+	}
+	return nil
+}
+
+func (x *TaskCompletionReport) GetErrors() []*ErrorClass {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TaskCompletionReport) GetSlo() []*AssertionResult {
+	if x != nil {
+		return x.Slo
+	}
+	return nil
+}
+
+func (x *TaskCompletionReport) GetSloPassed() bool {
+	if x != nil {
+		return x.SloPassed
+	}
+	return false
+}
+
+func (x *TaskCompletionReport) GetRecorded() int64 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetRecordingUrl() string {
+	if x != nil {
+		return x.RecordingUrl
+	}
+	return ""
+}
+
+func (x *TaskCompletionReport) GetChaos() *ChaosStats {
+	if x != nil {
+		return x.Chaos
+	}
+	return nil
+}
+
+func (x *TaskCompletionReport) GetClientBet() int64 {
+	if x != nil {
+		return x.ClientBet
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetClientWin() int64 {
+	if x != nil {
+		return x.ClientWin
+	}
+	return 0
+}
+
+func (x *TaskCompletionReport) GetClientRtpPct() float64 {
+	if x != nil {
+		return x.ClientRtpPct
+	}
+	return 0
+}
+
+// 客户端故障注入统计：accepted / extra_orders 非 0 说明服务端未拦截异常请求
+type ChaosStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sessions          int64                  `protobuf:"varint,1,opt,name=sessions,proto3" json:"sessions,omitempty"`                                            // 参与注入的会话数
+	Dropped           int64                  `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`                                              // 请求发出后断开连接次数
+	Abandoned         int64                  `protobuf:"varint,3,opt,name=abandoned,proto3" json:"abandoned,omitempty"`                                          // bonus 中放弃的会话数（计入失败成员数）
+	StaleSent         int64                  `protobuf:"varint,4,opt,name=stale_sent,json=staleSent,proto3" json:"stale_sent,omitempty"`                         // 使用失效 token 的请求数
+	StaleAccepted     int64                  `protobuf:"varint,5,opt,name=stale_accepted,json=staleAccepted,proto3" json:"stale_accepted,omitempty"`             // 其中服务端返回成功的次数（应为 0）
+	DuplicateSent     int64                  `protobuf:"varint,6,opt,name=duplicate_sent,json=duplicateSent,proto3" json:"duplicate_sent,omitempty"`             // 并发重复 betorder 次数
+	DuplicateAccepted int64                  `protobuf:"varint,7,opt,name=duplicate_accepted,json=duplicateAccepted,proto3" json:"duplicate_accepted,omitempty"` // 两次请求均成功的次数（服务端未做并发保护）
+	Idled             int64                  `protobuf:"varint,8,opt,name=idled,proto3" json:"idled,omitempty"`                                                  // 空闲次数
+	IdleRejected      int64                  `protobuf:"varint,9,opt,name=idle_rejected,json=idleRejected,proto3" json:"idle_rejected,omitempty"`                // 空闲后首次 betorder 被拒绝的次数（token 已过期）
+	ExtraOrders       int64                  `protobuf:"varint,10,opt,name=extra_orders,json=extraOrders,proto3" json:"extra_orders,omitempty"`                  // 订单数 - 成功 betorder 数（断开、重复、失效 token 请求在服务端产生的订单）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChaosStats) Reset() {
+	*x = ChaosStats{}
+	mi := &file_stress_v1_stress_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaosStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaosStats) ProtoMessage() {}
+
+func (x *ChaosStats) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaosStats.ProtoReflect.Descriptor instead.
+func (*ChaosStats) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ChaosStats) GetSessions() int64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetAbandoned() int64 {
+	if x != nil {
+		return x.Abandoned
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetStaleSent() int64 {
+	if x != nil {
+		return x.StaleSent
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetStaleAccepted() int64 {
+	if x != nil {
+		return x.StaleAccepted
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetDuplicateSent() int64 {
+	if x != nil {
+		return x.DuplicateSent
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetDuplicateAccepted() int64 {
+	if x != nil {
+		return x.DuplicateAccepted
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetIdled() int64 {
+	if x != nil {
+		return x.Idled
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetIdleRejected() int64 {
+	if x != nil {
+		return x.IdleRejected
+	}
+	return 0
+}
+
+func (x *ChaosStats) GetExtraOrders() int64 {
+	if x != nil {
+		return x.ExtraOrders
+	}
+	return 0
+}
+
+// 单类请求的延迟分布（毫秒）
+type LatencyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`        // 请求类型：launch / login / betorder / betbonus
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // 成功请求数
+	Avg           float64                `protobuf:"fixed64,3,opt,name=avg,proto3" json:"avg,omitempty"`    // 平均值
+	P50           float64                `protobuf:"fixed64,4,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90           float64                `protobuf:"fixed64,5,opt,name=p90,proto3" json:"p90,omitempty"`
+	P95           float64                `protobuf:"fixed64,6,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99           float64                `protobuf:"fixed64,7,opt,name=p99,proto3" json:"p99,omitempty"`
+	P999          float64                `protobuf:"fixed64,8,opt,name=p999,proto3" json:"p999,omitempty"`
+	Max           float64                `protobuf:"fixed64,9,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatencyStats) Reset() {
+	*x = LatencyStats{}
+	mi := &file_stress_v1_stress_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatencyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyStats) ProtoMessage() {}
+
+func (x *LatencyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyStats.ProtoReflect.Descriptor instead.
+func (*LatencyStats) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LatencyStats) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *LatencyStats) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
 }
 
-func (x *BetOrderConfig) GetBaseMoney() float64 {
+func (x *LatencyStats) GetP95() float64 {
 	if x != nil {
-		return x.BaseMoney
+		return x.P95
 	}
 	return 0
 }
 
-func (x *BetOrderConfig) GetMultiple() int64 {
+func (x *LatencyStats) GetP99() float64 {
 	if x != nil {
-		return x.Multiple
+		return x.P99
 	}
 	return 0
 }
 
-func (x *BetOrderConfig) GetPurchase() int64 {
+func (x *LatencyStats) GetP999() float64 {
 	if x != nil {
-		return x.Purchase
+		return x.P999
 	}
 	return 0
 }
 
-// 任务完整信息
-type Task struct {
+func (x *LatencyStats) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// 调度队列条目
+type QueueEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`          // 任务ID
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`              // 任务描述
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                       // 任务状态
-	Process       int64                  `protobuf:"varint,4,opt,name=process,proto3" json:"process,omitempty"`                     // 已完成局数
-	Config        *TaskConfig            `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`                        // 任务配置
-	RecordUrl     string                 `protobuf:"bytes,6,opt,name=record_url,json=recordUrl,proto3" json:"record_url,omitempty"` // 任务结果地址
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间（上海时区）
-	StartAt       string                 `protobuf:"bytes,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`       // 开始时间（上海时区）
-	FinishAt      string                 `protobuf:"bytes,9,opt,name=finish_at,json=finishAt,proto3" json:"finish_at,omitempty"`    // 更新时间（上海时区）
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`                          // 排队位置（从 1 开始）
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                 // 任务ID
+	GameId        int64                  `protobuf:"varint,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                // 游戏ID
+	Priority      int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`                          // 优先级
+	MemberCount   int32                  `protobuf:"varint,5,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"` // 用户数量
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                     // 任务描述
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`        // 创建时间
+	EtaSec        int64                  `protobuf:"varint,8,opt,name=eta_sec,json=etaSec,proto3" json:"eta_sec,omitempty"`                // 预计多少秒后开始（-1 表示暂无吞吐样本无法估算）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
-	mi := &file_stress_v1_stress_proto_msgTypes[22]
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
+	mi := &file_stress_v1_stress_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *QueueEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*QueueEntry) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[22]
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,117 +4657,101 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Task) GetTaskId() string {
+func (x *QueueEntry) GetPosition() int32 {
 	if x != nil {
-		return x.TaskId
+		return x.Position
 	}
-	return ""
+	return 0
 }
 
-func (x *Task) GetDescription() string {
+func (x *QueueEntry) GetTaskId() string {
 	if x != nil {
-		return x.Description
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *Task) GetStatus() int32 {
+func (x *QueueEntry) GetGameId() int64 {
 	if x != nil {
-		return x.Status
+		return x.GameId
 	}
 	return 0
 }
 
-func (x *Task) GetProcess() int64 {
+func (x *QueueEntry) GetPriority() int32 {
 	if x != nil {
-		return x.Process
+		return x.Priority
 	}
 	return 0
 }
 
-func (x *Task) GetConfig() *TaskConfig {
+func (x *QueueEntry) GetMemberCount() int32 {
 	if x != nil {
-		return x.Config
+		return x.MemberCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Task) GetRecordUrl() string {
+func (x *QueueEntry) GetDescription() string {
 	if x != nil {
-		return x.RecordUrl
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Task) GetCreatedAt() string {
+func (x *QueueEntry) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *Task) GetStartAt() string {
-	if x != nil {
-		return x.StartAt
-	}
-	return ""
-}
-
-func (x *Task) GetFinishAt() string {
+func (x *QueueEntry) GetEtaSec() int64 {
 	if x != nil {
-		return x.FinishAt
+		return x.EtaSec
 	}
-	return ""
+	return 0
 }
 
-// 任务完成/统计报告（供 Prometheus、飞书通知、API 统一复用）
-type TaskCompletionReport struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                        // 任务ID
-	GameId        int64                  `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                       // 游戏ID
-	GameName      string                 `protobuf:"bytes,3,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`                  // 游戏名称
-	Process       int64                  `protobuf:"varint,4,opt,name=process,proto3" json:"process,omitempty"`                                   // 已完成局数
-	Target        int64                  `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`                                     // 目标局数
-	Step          int64                  `protobuf:"varint,6,opt,name=step,proto3" json:"step,omitempty"`                                         // 总步数
-	Duration      string                 `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`                                  // 耗时（格式化，如 1.5h）
-	Qps           float64                `protobuf:"fixed64,8,opt,name=qps,proto3" json:"qps,omitempty"`                                          // QPS
-	AvgLatency    string                 `protobuf:"bytes,9,opt,name=avg_latency,json=avgLatency,proto3" json:"avg_latency,omitempty"`            // 平均延迟（格式化，如 12.34ms）
-	OrderCount    int64                  `protobuf:"varint,10,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`          // 订单数
-	TotalBet      int64                  `protobuf:"varint,11,opt,name=total_bet,json=totalBet,proto3" json:"total_bet,omitempty"`                // 总下注（×1e4）
-	TotalWin      int64                  `protobuf:"varint,12,opt,name=total_win,json=totalWin,proto3" json:"total_win,omitempty"`                // 总赢（×1e4）
-	RtpPct        float64                `protobuf:"fixed64,13,opt,name=rtp_pct,json=rtpPct,proto3" json:"rtp_pct,omitempty"`                     // RTP %
-	ActiveMembers int64                  `protobuf:"varint,14,opt,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"` // 活跃成员数（运行中）
-	Completed     int64                  `protobuf:"varint,15,opt,name=completed,proto3" json:"completed,omitempty"`                              // 完成成员数
-	Failed        int64                  `protobuf:"varint,16,opt,name=failed,proto3" json:"failed,omitempty"`                                    // 失败成员数
-	FailedReqs    int64                  `protobuf:"varint,17,opt,name=failed_reqs,json=failedReqs,proto3" json:"failed_reqs,omitempty"`          // 失败请求数
-	ProgressPct   float64                `protobuf:"fixed64,18,opt,name=progress_pct,json=progressPct,proto3" json:"progress_pct,omitempty"`      // 进度 % (process/target*100, 上限 100)
-	Url           string                 `protobuf:"bytes,19,opt,name=url,proto3" json:"url,omitempty"`                                           // 图表URL
-	OrderWarning  string                 `protobuf:"bytes,20,opt,name=order_warning,json=orderWarning,proto3" json:"order_warning,omitempty"`     // 订单等待超时警告（为空表示正常）
-	BonusStep     int64                  `protobuf:"varint,21,opt,name=bonus_step,json=bonusStep,proto3" json:"bonus_step,omitempty"`             // bonus 请求数（不写入订单）
+// 定时任务
+type Schedule struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"` // 定时任务ID
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                // 名称
+	Cron       string                 `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`                                // cron 表达式
+	// Types that are valid to be assigned to Template:
+	//
+	//	*Schedule_Task
+	//	*Schedule_Bench
+	Template      isSchedule_Template `protobuf_oneof:"template"`
+	CreatedAt     string              `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // 创建时间
+	LastRunAt     string              `protobuf:"bytes,7,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`       // 上次触发时间
+	LastTaskIds   []string            `protobuf:"bytes,8,rep,name=last_task_ids,json=lastTaskIds,proto3" json:"last_task_ids,omitempty"` // 上次触发创建的任务
+	NextRunAt     string              `protobuf:"bytes,9,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`       // 下次触发时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskCompletionReport) Reset() {
-	*x = TaskCompletionReport{}
-	mi := &file_stress_v1_stress_proto_msgTypes[23]
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_stress_v1_stress_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskCompletionReport) String() string {
+func (x *Schedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskCompletionReport) ProtoMessage() {}
+func (*Schedule) ProtoMessage() {}
 
-func (x *TaskCompletionReport) ProtoReflect() protoreflect.Message {
-	mi := &file_stress_v1_stress_proto_msgTypes[23]
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,156 +4762,229 @@ func (x *TaskCompletionReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskCompletionReport.ProtoReflect.Descriptor instead.
-func (*TaskCompletionReport) Descriptor() ([]byte, []int) {
-	return file_stress_v1_stress_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *TaskCompletionReport) GetTaskId() string {
+func (x *Schedule) GetScheduleId() int64 {
 	if x != nil {
-		return x.TaskId
+		return x.ScheduleId
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskCompletionReport) GetGameId() int64 {
+func (x *Schedule) GetName() string {
 	if x != nil {
-		return x.GameId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskCompletionReport) GetGameName() string {
+func (x *Schedule) GetCron() string {
 	if x != nil {
-		return x.GameName
+		return x.Cron
 	}
 	return ""
 }
 
-func (x *TaskCompletionReport) GetProcess() int64 {
+func (x *Schedule) GetTemplate() isSchedule_Template {
 	if x != nil {
-		return x.Process
+		return x.Template
 	}
-	return 0
+	return nil
 }
 
-func (x *TaskCompletionReport) GetTarget() int64 {
+func (x *Schedule) GetTask() *TaskConfig {
 	if x != nil {
-		return x.Target
+		if x, ok := x.Template.(*Schedule_Task); ok {
+			return x.Task
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *TaskCompletionReport) GetStep() int64 {
+func (x *Schedule) GetBench() *BenchRequest {
 	if x != nil {
-		return x.Step
+		if x, ok := x.Template.(*Schedule_Bench); ok {
+			return x.Bench
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *TaskCompletionReport) GetDuration() string {
+func (x *Schedule) GetCreatedAt() string {
 	if x != nil {
-		return x.Duration
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *TaskCompletionReport) GetQps() float64 {
+func (x *Schedule) GetLastRunAt() string {
 	if x != nil {
-		return x.Qps
+		return x.LastRunAt
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskCompletionReport) GetAvgLatency() string {
+func (x *Schedule) GetLastTaskIds() []string {
 	if x != nil {
-		return x.AvgLatency
+		return x.LastTaskIds
 	}
-	return ""
+	return nil
 }
 
-func (x *TaskCompletionReport) GetOrderCount() int64 {
+func (x *Schedule) GetNextRunAt() string {
 	if x != nil {
-		return x.OrderCount
+		return x.NextRunAt
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskCompletionReport) GetTotalBet() int64 {
-	if x != nil {
-		return x.TotalBet
-	}
-	return 0
+type isSchedule_Template interface {
+	isSchedule_Template()
 }
 
-func (x *TaskCompletionReport) GetTotalWin() int64 {
-	if x != nil {
-		return x.TotalWin
-	}
-	return 0
+type Schedule_Task struct {
+	Task *TaskConfig `protobuf:"bytes,4,opt,name=task,proto3,oneof"` // 单任务模板
 }
 
-func (x *TaskCompletionReport) GetRtpPct() float64 {
+type Schedule_Bench struct {
+	Bench *BenchRequest `protobuf:"bytes,5,opt,name=bench,proto3,oneof"` // 批量压测模板
+}
+
+func (*Schedule_Task) isSchedule_Template() {}
+
+func (*Schedule_Bench) isSchedule_Template() {}
+
+// 错误分类统计
+type ErrorClass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`           // 请求类型：launch / login / betorder / betbonus（无法归属时为 unknown）
+	Cause         string                 `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`     // 原因：http_<status> / timeout / transport / canceled / code_<code> / decode / other
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`    // 次数
+	Samples       []string               `protobuf:"bytes,4,rep,name=samples,proto3" json:"samples,omitempty"` // 最近样本（旧→新）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorClass) Reset() {
+	*x = ErrorClass{}
+	mi := &file_stress_v1_stress_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorClass) ProtoMessage() {}
+
+func (x *ErrorClass) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[65]
 	if x != nil {
-		return x.RtpPct
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TaskCompletionReport) GetActiveMembers() int64 {
+// Deprecated: Use ErrorClass.ProtoReflect.Descriptor instead.
+func (*ErrorClass) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ErrorClass) GetOp() string {
 	if x != nil {
-		return x.ActiveMembers
+		return x.Op
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskCompletionReport) GetCompleted() int64 {
+func (x *ErrorClass) GetCause() string {
 	if x != nil {
-		return x.Completed
+		return x.Cause
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskCompletionReport) GetFailed() int64 {
+func (x *ErrorClass) GetCount() int64 {
 	if x != nil {
-		return x.Failed
+		return x.Count
 	}
 	return 0
 }
 
-func (x *TaskCompletionReport) GetFailedReqs() int64 {
+func (x *ErrorClass) GetSamples() []string {
 	if x != nil {
-		return x.FailedReqs
+		return x.Samples
 	}
-	return 0
+	return nil
 }
 
-func (x *TaskCompletionReport) GetProgressPct() float64 {
+// 错误样本
+type ErrorSample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`       // 发生时间
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`   // 成员名
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // 错误信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorSample) Reset() {
+	*x = ErrorSample{}
+	mi := &file_stress_v1_stress_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorSample) ProtoMessage() {}
+
+func (x *ErrorSample) ProtoReflect() protoreflect.Message {
+	mi := &file_stress_v1_stress_proto_msgTypes[66]
 	if x != nil {
-		return x.ProgressPct
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TaskCompletionReport) GetUrl() string {
+// Deprecated: Use ErrorSample.ProtoReflect.Descriptor instead.
+func (*ErrorSample) Descriptor() ([]byte, []int) {
+	return file_stress_v1_stress_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ErrorSample) GetTime() string {
 	if x != nil {
-		return x.Url
+		return x.Time
 	}
 	return ""
 }
 
-func (x *TaskCompletionReport) GetOrderWarning() string {
+func (x *ErrorSample) GetMember() string {
 	if x != nil {
-		return x.OrderWarning
+		return x.Member
 	}
 	return ""
 }
 
-func (x *TaskCompletionReport) GetBonusStep() int64 {
+func (x *ErrorSample) GetMessage() string {
 	if x != nil {
-		return x.BonusStep
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
 var File_stress_v1_stress_proto protoreflect.FileDescriptor
@@ -1570,8 +4999,21 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\x10ListGamesRequest\"P\n" +
 	"\x11ListGamesResponse\x12%\n" +
 	"\x05games\x18\x01 \x03(\v2\x0f.stress.v1.GameR\x05games\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\x12\n" +
-	"\x10ListTasksRequest\"P\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x14\n" +
+	"\x12ReloadGamesRequest\"[\n" +
+	"\x13ReloadGamesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06loaded\x18\x03 \x01(\x05R\x06loaded\"\xda\x01\n" +
+	"\x10ListTasksRequest\x12-\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x15.stress.v1.TaskStatusR\x06status\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\x03R\x06gameId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1b\n" +
+	"\x04page\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x06 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\"P\n" +
 	"\x11ListTasksResponse\x12%\n" +
 	"\x05tasks\x18\x01 \x03(\v2\x0f.stress.v1.TaskR\x05tasks\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"L\n" +
@@ -1591,7 +5033,89 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\"B\n" +
 	"\x12CancelTaskResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"4\n" +
+	"\x10PauseTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\"A\n" +
+	"\x11PauseTaskResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\x11ResumeTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\"B\n" +
+	"\x12ResumeTaskResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe0\x01\n" +
+	"\x13ListSessionsRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12-\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x17.stress.v1.SessionStateR\x05state\x12+\n" +
+	"\rmin_state_sec\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vminStateSec\x12)\n" +
+	"\fmin_idle_sec\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"minIdleSec\x12 \n" +
+	"\x05limit\x18\x05 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"\x8e\x01\n" +
+	"\x14ListSessionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\bsessions\x18\x03 \x03(\v2\x16.stress.v1.SessionInfoR\bsessions\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\"\xa4\x02\n" +
+	"\vSessionInfo\x12\x16\n" +
+	"\x06member\x18\x01 \x01(\tR\x06member\x12-\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x17.stress.v1.SessionStateR\x05state\x12\x18\n" +
+	"\aprocess\x18\x03 \x01(\x05R\aprocess\x12\x1b\n" +
+	"\ttry_times\x18\x04 \x01(\x05R\btryTimes\x12\x18\n" +
+	"\aretries\x18\x05 \x01(\x03R\aretries\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12\x1b\n" +
+	"\tstate_sec\x18\a \x01(\x03R\bstateSec\x12\x19\n" +
+	"\bidle_sec\x18\b \x01(\x03R\aidleSec\x12&\n" +
+	"\x0flast_success_at\x18\t \x01(\tR\rlastSuccessAt\"\x81\x02\n" +
+	"\x10SmokeTestRequest\x12 \n" +
+	"\agame_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06gameId\x126\n" +
+	"\tbet_order\x18\x02 \x01(\v2\x19.stress.v1.BetOrderConfigR\bbetOrder\x12\x16\n" +
+	"\x06member\x18\x03 \x01(\tR\x06member\x12\x1f\n" +
+	"\x05spins\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x14(\x00R\x05spins\x12 \n" +
+	"\venvironment\x18\x05 \x01(\tR\venvironment\x12\x1a\n" +
+	"\bmerchant\x18\x06 \x01(\tR\bmerchant\x12\x1c\n" +
+	"\ttransport\x18\a \x01(\tR\ttransport\"\x9d\x01\n" +
+	"\x11SmokeTestResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06passed\x18\x03 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06member\x18\x04 \x01(\tR\x06member\x12*\n" +
+	"\x05steps\x18\x05 \x03(\v2\x14.stress.v1.SmokeStepR\x05steps\"\x89\x02\n" +
+	"\tSmokeStep\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x18\n" +
+	"\arequest\x18\x03 \x01(\tR\arequest\x12\x1f\n" +
+	"\vhttp_status\x18\x04 \x01(\x05R\n" +
+	"httpStatus\x12\x1a\n" +
+	"\bresponse\x18\x05 \x01(\tR\bresponse\x12\x18\n" +
+	"\adecoded\x18\x06 \x01(\tR\adecoded\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\a \x01(\x03R\tlatencyMs\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x124\n" +
+	"\n" +
+	"predicates\x18\t \x03(\v2\x14.stress.v1.PredicateR\n" +
+	"predicates\"5\n" +
+	"\tPredicate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05fired\x18\x02 \x01(\bR\x05fired\"^\n" +
+	"\x10WatchTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12(\n" +
+	"\vinterval_ms\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"intervalMs\"\xb9\x02\n" +
+	"\x0eWatchTaskEvent\x122\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1e.stress.v1.WatchTaskEvent.KindR\x04kind\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\tR\x04time\x12;\n" +
+	"\bsnapshot\x18\x05 \x01(\v2\x1f.stress.v1.TaskCompletionReportR\bsnapshot\x12.\n" +
+	"\x06errors\x18\x06 \x03(\v2\x16.stress.v1.ErrorSampleR\x06errors\"A\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06STATUS\x10\x01\x12\f\n" +
+	"\bSNAPSHOT\x10\x02\x12\t\n" +
+	"\x05ERROR\x10\x03\"5\n" +
 	"\x11DeleteTaskRequest\x12 \n" +
 	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\"1\n" +
 	"\rRecordRequest\x12 \n" +
@@ -1618,11 +5142,54 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\vredis_error\x18\x03 \x01(\tR\n" +
 	"redisError\x12\x1f\n" +
 	"\vmysql_error\x18\x04 \x01(\tR\n" +
-	"mysqlError\"W\n" +
+	"mysqlError\"\x12\n" +
+	"\x10ListQueueRequest\"v\n" +
+	"\x11ListQueueResponse\x12\x16\n" +
+	"\x06paused\x18\x01 \x01(\bR\x06paused\x12\x18\n" +
+	"\arunning\x18\x02 \x01(\x05R\arunning\x12/\n" +
+	"\aentries\x18\x03 \x03(\v2\x15.stress.v1.QueueEntryR\aentries\"\xbb\x01\n" +
+	"\x0fMoveTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12K\n" +
+	"\bposition\x18\x02 \x01(\x0e2#.stress.v1.MoveTaskRequest.PositionB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\bposition\"9\n" +
+	"\bPosition\x12\x18\n" +
+	"\x14POSITION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05FRONT\x10\x01\x12\b\n" +
+	"\x04BACK\x10\x02\"@\n" +
+	"\x10MoveTaskResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x13\n" +
+	"\x11PauseQueueRequest\"\x14\n" +
+	"\x12ResumeQueueRequest\"Z\n" +
+	"\x12QueueStateResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\"\xb7\x01\n" +
+	"\x15CreateScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\x04cron\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04cron\x12+\n" +
+	"\x04task\x18\x03 \x01(\v2\x15.stress.v1.TaskConfigH\x00R\x04task\x12/\n" +
+	"\x05bench\x18\x04 \x01(\v2\x17.stress.v1.BenchRequestH\x00R\x05benchB\x0f\n" +
+	"\btemplate\x12\x03\xf8B\x01\"w\n" +
+	"\x16CreateScheduleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\bschedule\x18\x03 \x01(\v2\x13.stress.v1.ScheduleR\bschedule\"\x16\n" +
+	"\x14ListSchedulesRequest\"`\n" +
+	"\x15ListSchedulesResponse\x121\n" +
+	"\tschedules\x18\x01 \x03(\v2\x13.stress.v1.ScheduleR\tschedules\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"A\n" +
+	"\x15DeleteScheduleRequest\x12(\n" +
+	"\vschedule_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"scheduleId\"F\n" +
+	"\x16DeleteScheduleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"o\n" +
 	"\x04Game\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x12\x1b\n" +
 	"\tgame_name\x18\x02 \x01(\tR\bgameName\x12\x19\n" +
-	"\bbet_size\x18\x03 \x03(\x01R\abetSize\"\xed\x01\n" +
+	"\bbet_size\x18\x03 \x03(\x01R\abetSize\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"\xd9\x04\n" +
 	"\n" +
 	"TaskConfig\x12 \n" +
 	"\agame_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06gameId\x12 \n" +
@@ -1630,13 +5197,96 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\fmember_count\x18\x03 \x01(\x05B\n" +
 	"\xfaB\a\x1a\x05\x18\x90N(\x01R\vmemberCount\x124\n" +
 	"\x10times_per_member\x18\x04 \x01(\x05B\n" +
-	"\xfaB\a\x1a\x05\x18\x90N(\x01R\x0etimesPerMember\x126\n" +
-	"\tbet_order\x18\x05 \x01(\v2\x19.stress.v1.BetOrderConfigR\bbetOrder\"\x89\x01\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x00R\x0etimesPerMember\x126\n" +
+	"\tbet_order\x18\x05 \x01(\v2\x19.stress.v1.BetOrderConfigR\bbetOrder\x12*\n" +
+	"\x04load\x18\x06 \x01(\v2\x16.stress.v1.LoadProfileR\x04load\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12\x1a\n" +
+	"\bduration\x18\b \x01(\tR\bduration\x12,\n" +
+	"\x05retry\x18\t \x01(\v2\x16.stress.v1.RetryPolicyR\x05retry\x12 \n" +
+	"\x03slo\x18\n" +
+	" \x01(\v2\x0e.stress.v1.SLOR\x03slo\x122\n" +
+	"\trecording\x18\v \x01(\v2\x14.stress.v1.RecordingR\trecording\x12&\n" +
+	"\x05chaos\x18\f \x01(\v2\x10.stress.v1.ChaosR\x05chaos\x12 \n" +
+	"\venvironment\x18\r \x01(\tR\venvironment\x12\x1a\n" +
+	"\bmerchant\x18\x0e \x01(\tR\bmerchant\x12\x1c\n" +
+	"\ttransport\x18\x0f \x01(\tR\ttransport\"\x9a\x03\n" +
+	"\x05Chaos\x12:\n" +
+	"\fsession_rate\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\vsessionRate\x12@\n" +
+	"\x0fdrop_connection\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x0edropConnection\x12<\n" +
+	"\rabandon_bonus\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\fabandonBonus\x128\n" +
+	"\vstale_token\x18\x04 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"staleToken\x12<\n" +
+	"\rduplicate_bet\x18\x05 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\fduplicateBet\x128\n" +
+	"\vidle_expiry\x18\x06 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"idleExpiry\x12#\n" +
+	"\ridle_duration\x18\a \x01(\tR\fidleDuration\"\x8c\x01\n" +
+	"\tRecording\x128\n" +
+	"\vsample_rate\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"sampleRate\x12\x1b\n" +
+	"\tupload_s3\x18\x02 \x01(\bR\buploadS3\x12(\n" +
+	"\vmax_records\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"maxRecords\"\x89\x01\n" +
 	"\x0eBetOrderConfig\x12-\n" +
 	"\n" +
 	"base_money\x18\x01 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\tbaseMoney\x12#\n" +
 	"\bmultiple\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bmultiple\x12#\n" +
-	"\bpurchase\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bpurchase\"\x98\x02\n" +
+	"\bpurchase\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bpurchase\"\x9e\x01\n" +
+	"\vLoadProfile\x12\"\n" +
+	"\x04rate\x18\x01 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12\x17\n" +
+	"\aramp_up\x18\x02 \x01(\tR\x06rampUp\x12\x16\n" +
+	"\x06steady\x18\x03 \x01(\tR\x06steady\x12\x1b\n" +
+	"\tramp_down\x18\x04 \x01(\tR\brampDown\x12\x1d\n" +
+	"\x05burst\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05burst\"\xd8\x03\n" +
+	"\vRetryPolicy\x12*\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\vmaxAttempts\x12Z\n" +
+	"\x12state_max_attempts\x18\x02 \x03(\v2,.stress.v1.RetryPolicy.StateMaxAttemptsEntryR\x10stateMaxAttempts\x12\x1d\n" +
+	"\n" +
+	"base_delay\x18\x03 \x01(\tR\tbaseDelay\x12\x1b\n" +
+	"\tmax_delay\x18\x04 \x01(\tR\bmaxDelay\x12.\n" +
+	"\n" +
+	"multiplier\x18\x05 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"multiplier\x12/\n" +
+	"\x06jitter\x18\x06 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x06jitter\x12*\n" +
+	"\x05rules\x18\a \x03(\v2\x14.stress.v1.ErrorRuleR\x05rules\x123\n" +
+	"\abreaker\x18\b \x01(\v2\x19.stress.v1.CircuitBreakerR\abreaker\x1aC\n" +
+	"\x15StateMaxAttemptsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x80\x02\n" +
+	"\tErrorRule\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x14\n" +
+	"\x05cause\x18\x02 \x01(\tR\x05cause\x12\x14\n" +
+	"\x05codes\x18\x03 \x03(\x05R\x05codes\x12\x1a\n" +
+	"\bkeywords\x18\x04 \x03(\tR\bkeywords\x123\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x1b.stress.v1.ErrorRule.ActionR\x06action\x12\x14\n" +
+	"\x05delay\x18\x06 \x01(\tR\x05delay\"P\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05RETRY\x10\x01\x12\v\n" +
+	"\aRELOGIN\x10\x02\x12\f\n" +
+	"\bRELAUNCH\x10\x03\x12\b\n" +
+	"\x04FAIL\x10\x04\"\x8c\x01\n" +
+	"\x0eCircuitBreaker\x126\n" +
+	"\n" +
+	"error_rate\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\terrorRate\x12\x16\n" +
+	"\x06window\x18\x02 \x01(\tR\x06window\x12*\n" +
+	"\fmin_requests\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vminRequests\"S\n" +
+	"\x03SLO\x124\n" +
+	"\n" +
+	"assertions\x18\x01 \x03(\v2\x14.stress.v1.AssertionR\n" +
+	"assertions\x12\x16\n" +
+	"\x06warmup\x18\x02 \x01(\tR\x06warmup\"\x95\x01\n" +
+	"\tAssertion\x12\x1f\n" +
+	"\x06metric\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06metric\x12\x18\n" +
+	"\arequest\x18\x02 \x01(\tR\arequest\x12#\n" +
+	"\boperator\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\boperator\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04hard\x18\x05 \x01(\bR\x04hard\"\x82\x01\n" +
+	"\x0fAssertionResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06actual\x18\x02 \x01(\x01R\x06actual\x12\x16\n" +
+	"\x06passed\x18\x03 \x01(\bR\x06passed\x12\x12\n" +
+	"\x04hard\x18\x04 \x01(\bR\x04hard\x12\x17\n" +
+	"\ano_data\x18\x05 \x01(\bR\x06noData\"\xe9\x02\n" +
 	"\x04Task\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
@@ -1648,7 +5298,10 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x19\n" +
 	"\bstart_at\x18\b \x01(\tR\astartAt\x12\x1b\n" +
-	"\tfinish_at\x18\t \x01(\tR\bfinishAt\"\xe5\x04\n" +
+	"\tfinish_at\x18\t \x01(\tR\bfinishAt\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x127\n" +
+	"\x06report\x18\v \x01(\v2\x1f.stress.v1.TaskCompletionReportR\x06report\"\x8b\b\n" +
 	"\x14TaskCompletionReport\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\x03R\x06gameId\x12\x1b\n" +
@@ -1675,7 +5328,81 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\x03url\x18\x13 \x01(\tR\x03url\x12#\n" +
 	"\rorder_warning\x18\x14 \x01(\tR\forderWarning\x12\x1d\n" +
 	"\n" +
-	"bonus_step\x18\x15 \x01(\x03R\tbonusStep*\x94\x01\n" +
+	"bonus_step\x18\x15 \x01(\x03R\tbonusStep\x12\x1f\n" +
+	"\vtarget_rate\x18\x16 \x01(\x01R\n" +
+	"targetRate\x125\n" +
+	"\tlatencies\x18\x17 \x03(\v2\x17.stress.v1.LatencyStatsR\tlatencies\x12-\n" +
+	"\x06errors\x18\x18 \x03(\v2\x15.stress.v1.ErrorClassR\x06errors\x12,\n" +
+	"\x03slo\x18\x19 \x03(\v2\x1a.stress.v1.AssertionResultR\x03slo\x12\x1d\n" +
+	"\n" +
+	"slo_passed\x18\x1a \x01(\bR\tsloPassed\x12\x1a\n" +
+	"\brecorded\x18\x1b \x01(\x03R\brecorded\x12#\n" +
+	"\rrecording_url\x18\x1c \x01(\tR\frecordingUrl\x12+\n" +
+	"\x05chaos\x18\x1d \x01(\v2\x15.stress.v1.ChaosStatsR\x05chaos\x12\x1d\n" +
+	"\n" +
+	"client_bet\x18\x1e \x01(\x03R\tclientBet\x12\x1d\n" +
+	"\n" +
+	"client_win\x18\x1f \x01(\x03R\tclientWin\x12$\n" +
+	"\x0eclient_rtp_pct\x18  \x01(\x01R\fclientRtpPct\"\xda\x02\n" +
+	"\n" +
+	"ChaosStats\x12\x1a\n" +
+	"\bsessions\x18\x01 \x01(\x03R\bsessions\x12\x18\n" +
+	"\adropped\x18\x02 \x01(\x03R\adropped\x12\x1c\n" +
+	"\tabandoned\x18\x03 \x01(\x03R\tabandoned\x12\x1d\n" +
+	"\n" +
+	"stale_sent\x18\x04 \x01(\x03R\tstaleSent\x12%\n" +
+	"\x0estale_accepted\x18\x05 \x01(\x03R\rstaleAccepted\x12%\n" +
+	"\x0eduplicate_sent\x18\x06 \x01(\x03R\rduplicateSent\x12-\n" +
+	"\x12duplicate_accepted\x18\a \x01(\x03R\x11duplicateAccepted\x12\x14\n" +
+	"\x05idled\x18\b \x01(\x03R\x05idled\x12#\n" +
+	"\ridle_rejected\x18\t \x01(\x03R\fidleRejected\x12!\n" +
+	"\fextra_orders\x18\n" +
+	" \x01(\x03R\vextraOrders\"\xb4\x01\n" +
+	"\fLatencyStats\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x10\n" +
+	"\x03avg\x18\x03 \x01(\x01R\x03avg\x12\x10\n" +
+	"\x03p50\x18\x04 \x01(\x01R\x03p50\x12\x10\n" +
+	"\x03p90\x18\x05 \x01(\x01R\x03p90\x12\x10\n" +
+	"\x03p95\x18\x06 \x01(\x01R\x03p95\x12\x10\n" +
+	"\x03p99\x18\a \x01(\x01R\x03p99\x12\x12\n" +
+	"\x04p999\x18\b \x01(\x01R\x04p999\x12\x10\n" +
+	"\x03max\x18\t \x01(\x01R\x03max\"\xf3\x01\n" +
+	"\n" +
+	"QueueEntry\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\x03R\x06gameId\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12!\n" +
+	"\fmember_count\x18\x05 \x01(\x05R\vmemberCount\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x17\n" +
+	"\aeta_sec\x18\b \x01(\x03R\x06etaSec\"\xc0\x02\n" +
+	"\bSchedule\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12+\n" +
+	"\x04task\x18\x04 \x01(\v2\x15.stress.v1.TaskConfigH\x00R\x04task\x12/\n" +
+	"\x05bench\x18\x05 \x01(\v2\x17.stress.v1.BenchRequestH\x00R\x05bench\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1e\n" +
+	"\vlast_run_at\x18\a \x01(\tR\tlastRunAt\x12\"\n" +
+	"\rlast_task_ids\x18\b \x03(\tR\vlastTaskIds\x12\x1e\n" +
+	"\vnext_run_at\x18\t \x01(\tR\tnextRunAtB\n" +
+	"\n" +
+	"\btemplate\"b\n" +
+	"\n" +
+	"ErrorClass\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x14\n" +
+	"\x05cause\x18\x02 \x01(\tR\x05cause\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x18\n" +
+	"\asamples\x18\x04 \x03(\tR\asamples\"S\n" +
+	"\vErrorSample\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*\xa5\x01\n" +
 	"\n" +
 	"TaskStatus\x12\x14\n" +
 	"\x10TASK_UNSPECIFIED\x10\x00\x12\x10\n" +
@@ -1684,10 +5411,21 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\x0fTASK_PROCESSING\x10\x03\x12\x12\n" +
 	"\x0eTASK_COMPLETED\x10\x04\x12\x0f\n" +
 	"\vTASK_FAILED\x10\x05\x12\x12\n" +
-	"\x0eTASK_CANCELLED\x10\x062\xdf\a\n" +
+	"\x0eTASK_CANCELLED\x10\x06\x12\x0f\n" +
+	"\vTASK_PAUSED\x10\a*\xc2\x01\n" +
+	"\fSessionState\x12\x17\n" +
+	"\x13SESSION_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fSESSION_IDLE\x10\x01\x12\x15\n" +
+	"\x11SESSION_LAUNCHING\x10\x02\x12\x16\n" +
+	"\x12SESSION_LOGGING_IN\x10\x03\x12\x13\n" +
+	"\x0fSESSION_BETTING\x10\x04\x12\x18\n" +
+	"\x14SESSION_BONUS_SELECT\x10\x05\x12\x15\n" +
+	"\x11SESSION_COMPLETED\x10\x06\x12\x12\n" +
+	"\x0eSESSION_FAILED\x10\a2\xc5\x12\n" +
 	"\rStressService\x12T\n" +
 	"\aPingReq\x12\x16.stress.v1.PingRequest\x1a\x14.stress.v1.PingReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/stress/ping/{name}\x12d\n" +
-	"\tListGames\x12\x1b.stress.v1.ListGamesRequest\x1a\x1c.stress.v1.ListGamesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/stress/ListGames\x12d\n" +
+	"\tListGames\x12\x1b.stress.v1.ListGamesRequest\x1a\x1c.stress.v1.ListGamesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/stress/ListGames\x12l\n" +
+	"\vReloadGames\x12\x1d.stress.v1.ReloadGamesRequest\x1a\x1e.stress.v1.ReloadGamesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/stress/ReloadGames\x12d\n" +
 	"\tListTasks\x12\x1b.stress.v1.ListTasksRequest\x1a\x1c.stress.v1.ListTasksResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/stress/ListTasks\x12h\n" +
 	"\n" +
 	"CreateTask\x12\x1c.stress.v1.CreateTaskRequest\x1a\x1d.stress.v1.CreateTaskResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stress/CreateTask\x12`\n" +
@@ -1695,10 +5433,24 @@ const file_stress_v1_stress_proto_rawDesc = "" +
 	"\n" +
 	"DeleteTask\x12\x1c.stress.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stress/DeleteTask\x12h\n" +
 	"\n" +
-	"CancelTask\x12\x1c.stress.v1.CancelTaskRequest\x1a\x1d.stress.v1.CancelTaskResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stress/CancelTask\x12_\n" +
+	"CancelTask\x12\x1c.stress.v1.CancelTaskRequest\x1a\x1d.stress.v1.CancelTaskResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stress/CancelTask\x12d\n" +
+	"\tPauseTask\x12\x1b.stress.v1.PauseTaskRequest\x1a\x1c.stress.v1.PauseTaskResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/stress/PauseTask\x12h\n" +
+	"\n" +
+	"ResumeTask\x12\x1c.stress.v1.ResumeTaskRequest\x1a\x1d.stress.v1.ResumeTaskResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stress/ResumeTask\x12p\n" +
+	"\fListSessions\x12\x1e.stress.v1.ListSessionsRequest\x1a\x1f.stress.v1.ListSessionsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/stress/ListSessions\x12d\n" +
+	"\tSmokeTest\x12\x1b.stress.v1.SmokeTestRequest\x1a\x1c.stress.v1.SmokeTestResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/stress/SmokeTest\x12E\n" +
+	"\tWatchTask\x12\x1b.stress.v1.WatchTaskRequest\x1a\x19.stress.v1.WatchTaskEvent0\x01\x12_\n" +
 	"\tGetRecord\x12\x18.stress.v1.RecordRequest\x1a\x19.stress.v1.RecordResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stress/TaskRecord\x12\\\n" +
 	"\aCleanup\x12\x19.stress.v1.CleanupRequest\x1a\x1a.stress.v1.CleanupResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/stress/Cleanup\x12T\n" +
-	"\x05Bench\x12\x17.stress.v1.BenchRequest\x1a\x18.stress.v1.BenchResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/stress/BenchB\x19Z\x17stress/api/stress/v1;v1b\x06proto3"
+	"\x05Bench\x12\x17.stress.v1.BenchRequest\x1a\x18.stress.v1.BenchResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/stress/Bench\x12d\n" +
+	"\tListQueue\x12\x1b.stress.v1.ListQueueRequest\x1a\x1c.stress.v1.ListQueueResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/stress/ListQueue\x12`\n" +
+	"\bMoveTask\x12\x1a.stress.v1.MoveTaskRequest\x1a\x1b.stress.v1.MoveTaskResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/stress/MoveTask\x12h\n" +
+	"\n" +
+	"PauseQueue\x12\x1c.stress.v1.PauseQueueRequest\x1a\x1d.stress.v1.QueueStateResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stress/PauseQueue\x12k\n" +
+	"\vResumeQueue\x12\x1d.stress.v1.ResumeQueueRequest\x1a\x1d.stress.v1.QueueStateResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/stress/ResumeQueue\x12x\n" +
+	"\x0eCreateSchedule\x12 .stress.v1.CreateScheduleRequest\x1a!.stress.v1.CreateScheduleResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stress/CreateSchedule\x12t\n" +
+	"\rListSchedules\x12\x1f.stress.v1.ListSchedulesRequest\x1a .stress.v1.ListSchedulesResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/stress/ListSchedules\x12x\n" +
+	"\x0eDeleteSchedule\x12 .stress.v1.DeleteScheduleRequest\x1a!.stress.v1.DeleteScheduleResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stress/DeleteScheduleB\x19Z\x17stress/api/stress/v1;v1b\x06proto3"
 
 var (
 	file_stress_v1_stress_proto_rawDescOnce sync.Once
@@ -1712,69 +5464,176 @@ func file_stress_v1_stress_proto_rawDescGZIP() []byte {
 	return file_stress_v1_stress_proto_rawDescData
 }
 
-var file_stress_v1_stress_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_stress_v1_stress_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_stress_v1_stress_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_stress_v1_stress_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_stress_v1_stress_proto_goTypes = []any{
-	(TaskStatus)(0),              // 0: stress.v1.TaskStatus
-	(*PingRequest)(nil),          // 1: stress.v1.PingRequest
-	(*PingReply)(nil),            // 2: stress.v1.PingReply
-	(*ListGamesRequest)(nil),     // 3: stress.v1.ListGamesRequest
-	(*ListGamesResponse)(nil),    // 4: stress.v1.ListGamesResponse
-	(*ListTasksRequest)(nil),     // 5: stress.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),    // 6: stress.v1.ListTasksResponse
-	(*CreateTaskRequest)(nil),    // 7: stress.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),   // 8: stress.v1.CreateTaskResponse
-	(*TaskInfoRequest)(nil),      // 9: stress.v1.TaskInfoRequest
-	(*TaskInfoResponse)(nil),     // 10: stress.v1.TaskInfoResponse
-	(*CancelTaskRequest)(nil),    // 11: stress.v1.CancelTaskRequest
-	(*CancelTaskResponse)(nil),   // 12: stress.v1.CancelTaskResponse
-	(*DeleteTaskRequest)(nil),    // 13: stress.v1.DeleteTaskRequest
-	(*RecordRequest)(nil),        // 14: stress.v1.RecordRequest
-	(*RecordResponse)(nil),       // 15: stress.v1.RecordResponse
-	(*BenchRequest)(nil),         // 16: stress.v1.BenchRequest
-	(*BenchResponse)(nil),        // 17: stress.v1.BenchResponse
-	(*CleanupRequest)(nil),       // 18: stress.v1.CleanupRequest
-	(*CleanupResponse)(nil),      // 19: stress.v1.CleanupResponse
-	(*Game)(nil),                 // 20: stress.v1.Game
-	(*TaskConfig)(nil),           // 21: stress.v1.TaskConfig
-	(*BetOrderConfig)(nil),       // 22: stress.v1.BetOrderConfig
-	(*Task)(nil),                 // 23: stress.v1.Task
-	(*TaskCompletionReport)(nil), // 24: stress.v1.TaskCompletionReport
-	(*emptypb.Empty)(nil),        // 25: google.protobuf.Empty
+	(TaskStatus)(0),                // 0: stress.v1.TaskStatus
+	(SessionState)(0),              // 1: stress.v1.SessionState
+	(WatchTaskEvent_Kind)(0),       // 2: stress.v1.WatchTaskEvent.Kind
+	(MoveTaskRequest_Position)(0),  // 3: stress.v1.MoveTaskRequest.Position
+	(ErrorRule_Action)(0),          // 4: stress.v1.ErrorRule.Action
+	(*PingRequest)(nil),            // 5: stress.v1.PingRequest
+	(*PingReply)(nil),              // 6: stress.v1.PingReply
+	(*ListGamesRequest)(nil),       // 7: stress.v1.ListGamesRequest
+	(*ListGamesResponse)(nil),      // 8: stress.v1.ListGamesResponse
+	(*ReloadGamesRequest)(nil),     // 9: stress.v1.ReloadGamesRequest
+	(*ReloadGamesResponse)(nil),    // 10: stress.v1.ReloadGamesResponse
+	(*ListTasksRequest)(nil),       // 11: stress.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),      // 12: stress.v1.ListTasksResponse
+	(*CreateTaskRequest)(nil),      // 13: stress.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),     // 14: stress.v1.CreateTaskResponse
+	(*TaskInfoRequest)(nil),        // 15: stress.v1.TaskInfoRequest
+	(*TaskInfoResponse)(nil),       // 16: stress.v1.TaskInfoResponse
+	(*CancelTaskRequest)(nil),      // 17: stress.v1.CancelTaskRequest
+	(*CancelTaskResponse)(nil),     // 18: stress.v1.CancelTaskResponse
+	(*PauseTaskRequest)(nil),       // 19: stress.v1.PauseTaskRequest
+	(*PauseTaskResponse)(nil),      // 20: stress.v1.PauseTaskResponse
+	(*ResumeTaskRequest)(nil),      // 21: stress.v1.ResumeTaskRequest
+	(*ResumeTaskResponse)(nil),     // 22: stress.v1.ResumeTaskResponse
+	(*ListSessionsRequest)(nil),    // 23: stress.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),   // 24: stress.v1.ListSessionsResponse
+	(*SessionInfo)(nil),            // 25: stress.v1.SessionInfo
+	(*SmokeTestRequest)(nil),       // 26: stress.v1.SmokeTestRequest
+	(*SmokeTestResponse)(nil),      // 27: stress.v1.SmokeTestResponse
+	(*SmokeStep)(nil),              // 28: stress.v1.SmokeStep
+	(*Predicate)(nil),              // 29: stress.v1.Predicate
+	(*WatchTaskRequest)(nil),       // 30: stress.v1.WatchTaskRequest
+	(*WatchTaskEvent)(nil),         // 31: stress.v1.WatchTaskEvent
+	(*DeleteTaskRequest)(nil),      // 32: stress.v1.DeleteTaskRequest
+	(*RecordRequest)(nil),          // 33: stress.v1.RecordRequest
+	(*RecordResponse)(nil),         // 34: stress.v1.RecordResponse
+	(*BenchRequest)(nil),           // 35: stress.v1.BenchRequest
+	(*BenchResponse)(nil),          // 36: stress.v1.BenchResponse
+	(*CleanupRequest)(nil),         // 37: stress.v1.CleanupRequest
+	(*CleanupResponse)(nil),        // 38: stress.v1.CleanupResponse
+	(*ListQueueRequest)(nil),       // 39: stress.v1.ListQueueRequest
+	(*ListQueueResponse)(nil),      // 40: stress.v1.ListQueueResponse
+	(*MoveTaskRequest)(nil),        // 41: stress.v1.MoveTaskRequest
+	(*MoveTaskResponse)(nil),       // 42: stress.v1.MoveTaskResponse
+	(*PauseQueueRequest)(nil),      // 43: stress.v1.PauseQueueRequest
+	(*ResumeQueueRequest)(nil),     // 44: stress.v1.ResumeQueueRequest
+	(*QueueStateResponse)(nil),     // 45: stress.v1.QueueStateResponse
+	(*CreateScheduleRequest)(nil),  // 46: stress.v1.CreateScheduleRequest
+	(*CreateScheduleResponse)(nil), // 47: stress.v1.CreateScheduleResponse
+	(*ListSchedulesRequest)(nil),   // 48: stress.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),  // 49: stress.v1.ListSchedulesResponse
+	(*DeleteScheduleRequest)(nil),  // 50: stress.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil), // 51: stress.v1.DeleteScheduleResponse
+	(*Game)(nil),                   // 52: stress.v1.Game
+	(*TaskConfig)(nil),             // 53: stress.v1.TaskConfig
+	(*Chaos)(nil),                  // 54: stress.v1.Chaos
+	(*Recording)(nil),              // 55: stress.v1.Recording
+	(*BetOrderConfig)(nil),         // 56: stress.v1.BetOrderConfig
+	(*LoadProfile)(nil),            // 57: stress.v1.LoadProfile
+	(*RetryPolicy)(nil),            // 58: stress.v1.RetryPolicy
+	(*ErrorRule)(nil),              // 59: stress.v1.ErrorRule
+	(*CircuitBreaker)(nil),         // 60: stress.v1.CircuitBreaker
+	(*SLO)(nil),                    // 61: stress.v1.SLO
+	(*Assertion)(nil),              // 62: stress.v1.Assertion
+	(*AssertionResult)(nil),        // 63: stress.v1.AssertionResult
+	(*Task)(nil),                   // 64: stress.v1.Task
+	(*TaskCompletionReport)(nil),   // 65: stress.v1.TaskCompletionReport
+	(*ChaosStats)(nil),             // 66: stress.v1.ChaosStats
+	(*LatencyStats)(nil),           // 67: stress.v1.LatencyStats
+	(*QueueEntry)(nil),             // 68: stress.v1.QueueEntry
+	(*Schedule)(nil),               // 69: stress.v1.Schedule
+	(*ErrorClass)(nil),             // 70: stress.v1.ErrorClass
+	(*ErrorSample)(nil),            // 71: stress.v1.ErrorSample
+	nil,                            // 72: stress.v1.RetryPolicy.StateMaxAttemptsEntry
+	(*emptypb.Empty)(nil),          // 73: google.protobuf.Empty
 }
 var file_stress_v1_stress_proto_depIdxs = []int32{
-	20, // 0: stress.v1.ListGamesResponse.games:type_name -> stress.v1.Game
-	23, // 1: stress.v1.ListTasksResponse.tasks:type_name -> stress.v1.Task
-	21, // 2: stress.v1.CreateTaskRequest.config:type_name -> stress.v1.TaskConfig
-	23, // 3: stress.v1.CreateTaskResponse.task:type_name -> stress.v1.Task
-	23, // 4: stress.v1.TaskInfoResponse.task:type_name -> stress.v1.Task
-	22, // 5: stress.v1.TaskConfig.bet_order:type_name -> stress.v1.BetOrderConfig
-	21, // 6: stress.v1.Task.config:type_name -> stress.v1.TaskConfig
-	1,  // 7: stress.v1.StressService.PingReq:input_type -> stress.v1.PingRequest
-	3,  // 8: stress.v1.StressService.ListGames:input_type -> stress.v1.ListGamesRequest
-	5,  // 9: stress.v1.StressService.ListTasks:input_type -> stress.v1.ListTasksRequest
-	7,  // 10: stress.v1.StressService.CreateTask:input_type -> stress.v1.CreateTaskRequest
-	9,  // 11: stress.v1.StressService.TaskInfo:input_type -> stress.v1.TaskInfoRequest
-	13, // 12: stress.v1.StressService.DeleteTask:input_type -> stress.v1.DeleteTaskRequest
-	11, // 13: stress.v1.StressService.CancelTask:input_type -> stress.v1.CancelTaskRequest
-	14, // 14: stress.v1.StressService.GetRecord:input_type -> stress.v1.RecordRequest
-	18, // 15: stress.v1.StressService.Cleanup:input_type -> stress.v1.CleanupRequest
-	16, // 16: stress.v1.StressService.Bench:input_type -> stress.v1.BenchRequest
-	2,  // 17: stress.v1.StressService.PingReq:output_type -> stress.v1.PingReply
-	4,  // 18: stress.v1.StressService.ListGames:output_type -> stress.v1.ListGamesResponse
-	6,  // 19: stress.v1.StressService.ListTasks:output_type -> stress.v1.ListTasksResponse
-	8,  // 20: stress.v1.StressService.CreateTask:output_type -> stress.v1.CreateTaskResponse
-	10, // 21: stress.v1.StressService.TaskInfo:output_type -> stress.v1.TaskInfoResponse
-	25, // 22: stress.v1.StressService.DeleteTask:output_type -> google.protobuf.Empty
-	12, // 23: stress.v1.StressService.CancelTask:output_type -> stress.v1.CancelTaskResponse
-	15, // 24: stress.v1.StressService.GetRecord:output_type -> stress.v1.RecordResponse
-	19, // 25: stress.v1.StressService.Cleanup:output_type -> stress.v1.CleanupResponse
-	17, // 26: stress.v1.StressService.Bench:output_type -> stress.v1.BenchResponse
-	17, // [17:27] is the sub-list for method output_type
-	7,  // [7:17] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	52, // 0: stress.v1.ListGamesResponse.games:type_name -> stress.v1.Game
+	0,  // 1: stress.v1.ListTasksRequest.status:type_name -> stress.v1.TaskStatus
+	64, // 2: stress.v1.ListTasksResponse.tasks:type_name -> stress.v1.Task
+	53, // 3: stress.v1.CreateTaskRequest.config:type_name -> stress.v1.TaskConfig
+	64, // 4: stress.v1.CreateTaskResponse.task:type_name -> stress.v1.Task
+	64, // 5: stress.v1.TaskInfoResponse.task:type_name -> stress.v1.Task
+	1,  // 6: stress.v1.ListSessionsRequest.state:type_name -> stress.v1.SessionState
+	25, // 7: stress.v1.ListSessionsResponse.sessions:type_name -> stress.v1.SessionInfo
+	1,  // 8: stress.v1.SessionInfo.state:type_name -> stress.v1.SessionState
+	56, // 9: stress.v1.SmokeTestRequest.bet_order:type_name -> stress.v1.BetOrderConfig
+	28, // 10: stress.v1.SmokeTestResponse.steps:type_name -> stress.v1.SmokeStep
+	29, // 11: stress.v1.SmokeStep.predicates:type_name -> stress.v1.Predicate
+	2,  // 12: stress.v1.WatchTaskEvent.kind:type_name -> stress.v1.WatchTaskEvent.Kind
+	65, // 13: stress.v1.WatchTaskEvent.snapshot:type_name -> stress.v1.TaskCompletionReport
+	71, // 14: stress.v1.WatchTaskEvent.errors:type_name -> stress.v1.ErrorSample
+	68, // 15: stress.v1.ListQueueResponse.entries:type_name -> stress.v1.QueueEntry
+	3,  // 16: stress.v1.MoveTaskRequest.position:type_name -> stress.v1.MoveTaskRequest.Position
+	53, // 17: stress.v1.CreateScheduleRequest.task:type_name -> stress.v1.TaskConfig
+	35, // 18: stress.v1.CreateScheduleRequest.bench:type_name -> stress.v1.BenchRequest
+	69, // 19: stress.v1.CreateScheduleResponse.schedule:type_name -> stress.v1.Schedule
+	69, // 20: stress.v1.ListSchedulesResponse.schedules:type_name -> stress.v1.Schedule
+	56, // 21: stress.v1.TaskConfig.bet_order:type_name -> stress.v1.BetOrderConfig
+	57, // 22: stress.v1.TaskConfig.load:type_name -> stress.v1.LoadProfile
+	58, // 23: stress.v1.TaskConfig.retry:type_name -> stress.v1.RetryPolicy
+	61, // 24: stress.v1.TaskConfig.slo:type_name -> stress.v1.SLO
+	55, // 25: stress.v1.TaskConfig.recording:type_name -> stress.v1.Recording
+	54, // 26: stress.v1.TaskConfig.chaos:type_name -> stress.v1.Chaos
+	72, // 27: stress.v1.RetryPolicy.state_max_attempts:type_name -> stress.v1.RetryPolicy.StateMaxAttemptsEntry
+	59, // 28: stress.v1.RetryPolicy.rules:type_name -> stress.v1.ErrorRule
+	60, // 29: stress.v1.RetryPolicy.breaker:type_name -> stress.v1.CircuitBreaker
+	4,  // 30: stress.v1.ErrorRule.action:type_name -> stress.v1.ErrorRule.Action
+	62, // 31: stress.v1.SLO.assertions:type_name -> stress.v1.Assertion
+	53, // 32: stress.v1.Task.config:type_name -> stress.v1.TaskConfig
+	65, // 33: stress.v1.Task.report:type_name -> stress.v1.TaskCompletionReport
+	67, // 34: stress.v1.TaskCompletionReport.latencies:type_name -> stress.v1.LatencyStats
+	70, // 35: stress.v1.TaskCompletionReport.errors:type_name -> stress.v1.ErrorClass
+	63, // 36: stress.v1.TaskCompletionReport.slo:type_name -> stress.v1.AssertionResult
+	66, // 37: stress.v1.TaskCompletionReport.chaos:type_name -> stress.v1.ChaosStats
+	53, // 38: stress.v1.Schedule.task:type_name -> stress.v1.TaskConfig
+	35, // 39: stress.v1.Schedule.bench:type_name -> stress.v1.BenchRequest
+	5,  // 40: stress.v1.StressService.PingReq:input_type -> stress.v1.PingRequest
+	7,  // 41: stress.v1.StressService.ListGames:input_type -> stress.v1.ListGamesRequest
+	9,  // 42: stress.v1.StressService.ReloadGames:input_type -> stress.v1.ReloadGamesRequest
+	11, // 43: stress.v1.StressService.ListTasks:input_type -> stress.v1.ListTasksRequest
+	13, // 44: stress.v1.StressService.CreateTask:input_type -> stress.v1.CreateTaskRequest
+	15, // 45: stress.v1.StressService.TaskInfo:input_type -> stress.v1.TaskInfoRequest
+	32, // 46: stress.v1.StressService.DeleteTask:input_type -> stress.v1.DeleteTaskRequest
+	17, // 47: stress.v1.StressService.CancelTask:input_type -> stress.v1.CancelTaskRequest
+	19, // 48: stress.v1.StressService.PauseTask:input_type -> stress.v1.PauseTaskRequest
+	21, // 49: stress.v1.StressService.ResumeTask:input_type -> stress.v1.ResumeTaskRequest
+	23, // 50: stress.v1.StressService.ListSessions:input_type -> stress.v1.ListSessionsRequest
+	26, // 51: stress.v1.StressService.SmokeTest:input_type -> stress.v1.SmokeTestRequest
+	30, // 52: stress.v1.StressService.WatchTask:input_type -> stress.v1.WatchTaskRequest
+	33, // 53: stress.v1.StressService.GetRecord:input_type -> stress.v1.RecordRequest
+	37, // 54: stress.v1.StressService.Cleanup:input_type -> stress.v1.CleanupRequest
+	35, // 55: stress.v1.StressService.Bench:input_type -> stress.v1.BenchRequest
+	39, // 56: stress.v1.StressService.ListQueue:input_type -> stress.v1.ListQueueRequest
+	41, // 57: stress.v1.StressService.MoveTask:input_type -> stress.v1.MoveTaskRequest
+	43, // 58: stress.v1.StressService.PauseQueue:input_type -> stress.v1.PauseQueueRequest
+	44, // 59: stress.v1.StressService.ResumeQueue:input_type -> stress.v1.ResumeQueueRequest
+	46, // 60: stress.v1.StressService.CreateSchedule:input_type -> stress.v1.CreateScheduleRequest
+	48, // 61: stress.v1.StressService.ListSchedules:input_type -> stress.v1.ListSchedulesRequest
+	50, // 62: stress.v1.StressService.DeleteSchedule:input_type -> stress.v1.DeleteScheduleRequest
+	6,  // 63: stress.v1.StressService.PingReq:output_type -> stress.v1.PingReply
+	8,  // 64: stress.v1.StressService.ListGames:output_type -> stress.v1.ListGamesResponse
+	10, // 65: stress.v1.StressService.ReloadGames:output_type -> stress.v1.ReloadGamesResponse
+	12, // 66: stress.v1.StressService.ListTasks:output_type -> stress.v1.ListTasksResponse
+	14, // 67: stress.v1.StressService.CreateTask:output_type -> stress.v1.CreateTaskResponse
+	16, // 68: stress.v1.StressService.TaskInfo:output_type -> stress.v1.TaskInfoResponse
+	73, // 69: stress.v1.StressService.DeleteTask:output_type -> google.protobuf.Empty
+	18, // 70: stress.v1.StressService.CancelTask:output_type -> stress.v1.CancelTaskResponse
+	20, // 71: stress.v1.StressService.PauseTask:output_type -> stress.v1.PauseTaskResponse
+	22, // 72: stress.v1.StressService.ResumeTask:output_type -> stress.v1.ResumeTaskResponse
+	24, // 73: stress.v1.StressService.ListSessions:output_type -> stress.v1.ListSessionsResponse
+	27, // 74: stress.v1.StressService.SmokeTest:output_type -> stress.v1.SmokeTestResponse
+	31, // 75: stress.v1.StressService.WatchTask:output_type -> stress.v1.WatchTaskEvent
+	34, // 76: stress.v1.StressService.GetRecord:output_type -> stress.v1.RecordResponse
+	38, // 77: stress.v1.StressService.Cleanup:output_type -> stress.v1.CleanupResponse
+	36, // 78: stress.v1.StressService.Bench:output_type -> stress.v1.BenchResponse
+	40, // 79: stress.v1.StressService.ListQueue:output_type -> stress.v1.ListQueueResponse
+	42, // 80: stress.v1.StressService.MoveTask:output_type -> stress.v1.MoveTaskResponse
+	45, // 81: stress.v1.StressService.PauseQueue:output_type -> stress.v1.QueueStateResponse
+	45, // 82: stress.v1.StressService.ResumeQueue:output_type -> stress.v1.QueueStateResponse
+	47, // 83: stress.v1.StressService.CreateSchedule:output_type -> stress.v1.CreateScheduleResponse
+	49, // 84: stress.v1.StressService.ListSchedules:output_type -> stress.v1.ListSchedulesResponse
+	51, // 85: stress.v1.StressService.DeleteSchedule:output_type -> stress.v1.DeleteScheduleResponse
+	63, // [63:86] is the sub-list for method output_type
+	40, // [40:63] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_stress_v1_stress_proto_init() }
@@ -1782,13 +5641,21 @@ func file_stress_v1_stress_proto_init() {
 	if File_stress_v1_stress_proto != nil {
 		return
 	}
+	file_stress_v1_stress_proto_msgTypes[41].OneofWrappers = []any{
+		(*CreateScheduleRequest_Task)(nil),
+		(*CreateScheduleRequest_Bench)(nil),
+	}
+	file_stress_v1_stress_proto_msgTypes[64].OneofWrappers = []any{
+		(*Schedule_Task)(nil),
+		(*Schedule_Bench)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stress_v1_stress_proto_rawDesc), len(file_stress_v1_stress_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   24,
+			NumEnums:      5,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -475,6 +475,216 @@ var _ interface {
 	ErrorName() string
 } = ListGamesResponseValidationError{}
 
+// Validate checks the field values on ReloadGamesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadGamesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadGamesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadGamesRequestMultiError, or nil if none found.
+func (m *ReloadGamesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadGamesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReloadGamesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadGamesRequestMultiError is an error wrapping multiple validation errors
+// returned by ReloadGamesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReloadGamesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadGamesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadGamesRequestMultiError) AllErrors() []error { return m }
+
+// ReloadGamesRequestValidationError is the validation error returned by
+// ReloadGamesRequest.Validate if the designated constraints aren't met.
+type ReloadGamesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadGamesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadGamesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadGamesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadGamesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadGamesRequestValidationError) ErrorName() string {
+	return "ReloadGamesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadGamesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadGamesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadGamesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadGamesRequestValidationError{}
+
+// Validate checks the field values on ReloadGamesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadGamesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadGamesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadGamesResponseMultiError, or nil if none found.
+func (m *ReloadGamesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadGamesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Loaded
+
+	if len(errors) > 0 {
+		return ReloadGamesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadGamesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReloadGamesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReloadGamesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadGamesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadGamesResponseMultiError) AllErrors() []error { return m }
+
+// ReloadGamesResponseValidationError is the validation error returned by
+// ReloadGamesResponse.Validate if the designated constraints aren't met.
+type ReloadGamesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadGamesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadGamesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadGamesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadGamesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadGamesResponseValidationError) ErrorName() string {
+	return "ReloadGamesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadGamesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadGamesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadGamesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadGamesResponseValidationError{}
+
 // Validate checks the field values on ListTasksRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -497,6 +707,36 @@ func (m *ListTasksRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Status
+
+	// no validation rules for GameId
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if m.GetPage() < 0 {
+		err := ListTasksRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 200 {
+		err := ListTasksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 200]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListTasksRequestMultiError(errors)
 	}
@@ -1453,22 +1693,22 @@ var _ interface {
 	ErrorName() string
 } = CancelTaskResponseValidationError{}
 
-// Validate checks the field values on DeleteTaskRequest with the rules defined
+// Validate checks the field values on PauseTaskRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTaskRequest) Validate() error {
+func (m *PauseTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTaskRequest with the rules
+// ValidateAll checks the field values on PauseTaskRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTaskRequestMultiError, or nil if none found.
-func (m *DeleteTaskRequest) ValidateAll() error {
+// PauseTaskRequestMultiError, or nil if none found.
+func (m *PauseTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTaskRequest) validate(all bool) error {
+func (m *PauseTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1476,7 +1716,7 @@ func (m *DeleteTaskRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
-		err := DeleteTaskRequestValidationError{
+		err := PauseTaskRequestValidationError{
 			field:  "TaskId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1487,19 +1727,19 @@ func (m *DeleteTaskRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteTaskRequestMultiError(errors)
+		return PauseTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// PauseTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseTaskRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteTaskRequestMultiError []error
+type PauseTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTaskRequestMultiError) Error() string {
+func (m PauseTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1508,11 +1748,11 @@ func (m DeleteTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+func (m PauseTaskRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTaskRequestValidationError is the validation error returned by
-// DeleteTaskRequest.Validate if the designated constraints aren't met.
-type DeleteTaskRequestValidationError struct {
+// PauseTaskRequestValidationError is the validation error returned by
+// PauseTaskRequest.Validate if the designated constraints aren't met.
+type PauseTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1520,24 +1760,22 @@ type DeleteTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+func (e PauseTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+func (e PauseTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+func (e PauseTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+func (e PauseTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTaskRequestValidationError) ErrorName() string {
-	return "DeleteTaskRequestValidationError"
-}
+func (e PauseTaskRequestValidationError) ErrorName() string { return "PauseTaskRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteTaskRequestValidationError) Error() string {
+func (e PauseTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1549,14 +1787,14 @@ func (e DeleteTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTaskRequest.%s: %s%s",
+		"invalid %sPauseTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTaskRequestValidationError{}
+var _ error = PauseTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1564,55 +1802,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTaskRequestValidationError{}
+} = PauseTaskRequestValidationError{}
 
-// Validate checks the field values on RecordRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RecordRequest) Validate() error {
+// Validate checks the field values on PauseTaskResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecordRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RecordRequestMultiError, or
-// nil if none found.
-func (m *RecordRequest) ValidateAll() error {
+// ValidateAll checks the field values on PauseTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseTaskResponseMultiError, or nil if none found.
+func (m *PauseTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecordRequest) validate(all bool) error {
+func (m *PauseTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
-		err := RecordRequestValidationError{
-			field:  "TaskId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Code
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return RecordRequestMultiError(errors)
+		return PauseTaskResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecordRequestMultiError is an error wrapping multiple validation errors
-// returned by RecordRequest.ValidateAll() if the designated constraints
+// PauseTaskResponseMultiError is an error wrapping multiple validation errors
+// returned by PauseTaskResponse.ValidateAll() if the designated constraints
 // aren't met.
-type RecordRequestMultiError []error
+type PauseTaskResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecordRequestMultiError) Error() string {
+func (m PauseTaskResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1621,11 +1852,11 @@ func (m RecordRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecordRequestMultiError) AllErrors() []error { return m }
+func (m PauseTaskResponseMultiError) AllErrors() []error { return m }
 
-// RecordRequestValidationError is the validation error returned by
-// RecordRequest.Validate if the designated constraints aren't met.
-type RecordRequestValidationError struct {
+// PauseTaskResponseValidationError is the validation error returned by
+// PauseTaskResponse.Validate if the designated constraints aren't met.
+type PauseTaskResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1633,22 +1864,24 @@ type RecordRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecordRequestValidationError) Field() string { return e.field }
+func (e PauseTaskResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecordRequestValidationError) Reason() string { return e.reason }
+func (e PauseTaskResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecordRequestValidationError) Cause() error { return e.cause }
+func (e PauseTaskResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecordRequestValidationError) Key() bool { return e.key }
+func (e PauseTaskResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecordRequestValidationError) ErrorName() string { return "RecordRequestValidationError" }
+func (e PauseTaskResponseValidationError) ErrorName() string {
+	return "PauseTaskResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RecordRequestValidationError) Error() string {
+func (e PauseTaskResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1660,14 +1893,14 @@ func (e RecordRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecordRequest.%s: %s%s",
+		"invalid %sPauseTaskResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecordRequestValidationError{}
+var _ error = PauseTaskResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1675,50 +1908,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecordRequestValidationError{}
+} = PauseTaskResponseValidationError{}
 
-// Validate checks the field values on RecordResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RecordResponse) Validate() error {
+// Validate checks the field values on ResumeTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecordResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RecordResponseMultiError,
-// or nil if none found.
-func (m *RecordResponse) ValidateAll() error {
+// ValidateAll checks the field values on ResumeTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTaskRequestMultiError, or nil if none found.
+func (m *ResumeTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecordResponse) validate(all bool) error {
+func (m *ResumeTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
-
-	// no validation rules for Message
-
-	// no validation rules for Url
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := ResumeTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RecordResponseMultiError(errors)
+		return ResumeTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecordResponseMultiError is an error wrapping multiple validation errors
-// returned by RecordResponse.ValidateAll() if the designated constraints
+// ResumeTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeTaskRequest.ValidateAll() if the designated constraints
 // aren't met.
-type RecordResponseMultiError []error
+type ResumeTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecordResponseMultiError) Error() string {
+func (m ResumeTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1727,11 +1965,11 @@ func (m RecordResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecordResponseMultiError) AllErrors() []error { return m }
+func (m ResumeTaskRequestMultiError) AllErrors() []error { return m }
 
-// RecordResponseValidationError is the validation error returned by
-// RecordResponse.Validate if the designated constraints aren't met.
-type RecordResponseValidationError struct {
+// ResumeTaskRequestValidationError is the validation error returned by
+// ResumeTaskRequest.Validate if the designated constraints aren't met.
+type ResumeTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1739,22 +1977,24 @@ type RecordResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecordResponseValidationError) Field() string { return e.field }
+func (e ResumeTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecordResponseValidationError) Reason() string { return e.reason }
+func (e ResumeTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecordResponseValidationError) Cause() error { return e.cause }
+func (e ResumeTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecordResponseValidationError) Key() bool { return e.key }
+func (e ResumeTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecordResponseValidationError) ErrorName() string { return "RecordResponseValidationError" }
+func (e ResumeTaskRequestValidationError) ErrorName() string {
+	return "ResumeTaskRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RecordResponseValidationError) Error() string {
+func (e ResumeTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1766,14 +2006,14 @@ func (e RecordResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecordResponse.%s: %s%s",
+		"invalid %sResumeTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecordResponseValidationError{}
+var _ error = ResumeTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1781,65 +2021,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecordResponseValidationError{}
+} = ResumeTaskRequestValidationError{}
 
-// Validate checks the field values on BenchRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BenchRequest) Validate() error {
+// Validate checks the field values on ResumeTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BenchRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BenchRequestMultiError, or
-// nil if none found.
-func (m *BenchRequest) ValidateAll() error {
+// ValidateAll checks the field values on ResumeTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTaskResponseMultiError, or nil if none found.
+func (m *ResumeTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BenchRequest) validate(all bool) error {
+func (m *ResumeTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if val := m.GetMemberCount(); val < 1 || val > 10000 {
-		err := BenchRequestValidationError{
-			field:  "MemberCount",
-			reason: "value must be inside range [1, 10000]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Code
 
-	if val := m.GetTimesPerMember(); val < 1 || val > 10000 {
-		err := BenchRequestValidationError{
-			field:  "TimesPerMember",
-			reason: "value must be inside range [1, 10000]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return BenchRequestMultiError(errors)
+		return ResumeTaskResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BenchRequestMultiError is an error wrapping multiple validation errors
-// returned by BenchRequest.ValidateAll() if the designated constraints aren't met.
-type BenchRequestMultiError []error
+// ResumeTaskResponseMultiError is an error wrapping multiple validation errors
+// returned by ResumeTaskResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeTaskResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BenchRequestMultiError) Error() string {
+func (m ResumeTaskResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1848,11 +2071,11 @@ func (m BenchRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BenchRequestMultiError) AllErrors() []error { return m }
+func (m ResumeTaskResponseMultiError) AllErrors() []error { return m }
 
-// BenchRequestValidationError is the validation error returned by
-// BenchRequest.Validate if the designated constraints aren't met.
-type BenchRequestValidationError struct {
+// ResumeTaskResponseValidationError is the validation error returned by
+// ResumeTaskResponse.Validate if the designated constraints aren't met.
+type ResumeTaskResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1860,22 +2083,24 @@ type BenchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BenchRequestValidationError) Field() string { return e.field }
+func (e ResumeTaskResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BenchRequestValidationError) Reason() string { return e.reason }
+func (e ResumeTaskResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BenchRequestValidationError) Cause() error { return e.cause }
+func (e ResumeTaskResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BenchRequestValidationError) Key() bool { return e.key }
+func (e ResumeTaskResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BenchRequestValidationError) ErrorName() string { return "BenchRequestValidationError" }
+func (e ResumeTaskResponseValidationError) ErrorName() string {
+	return "ResumeTaskResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BenchRequestValidationError) Error() string {
+func (e ResumeTaskResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1887,14 +2112,14 @@ func (e BenchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBenchRequest.%s: %s%s",
+		"invalid %sResumeTaskResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BenchRequestValidationError{}
+var _ error = ResumeTaskResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1902,50 +2127,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BenchRequestValidationError{}
+} = ResumeTaskResponseValidationError{}
 
-// Validate checks the field values on BenchResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BenchResponse) Validate() error {
+// Validate checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BenchResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BenchResponseMultiError, or
-// nil if none found.
-func (m *BenchResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsRequestMultiError, or nil if none found.
+func (m *ListSessionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BenchResponse) validate(all bool) error {
+func (m *ListSessionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := ListSessionsRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Message
+	// no validation rules for State
 
-	// no validation rules for TaskIds
+	if m.GetMinStateSec() < 0 {
+		err := ListSessionsRequestValidationError{
+			field:  "MinStateSec",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinIdleSec() < 0 {
+		err := ListSessionsRequestValidationError{
+			field:  "MinIdleSec",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := ListSessionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return BenchResponseMultiError(errors)
+		return ListSessionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BenchResponseMultiError is an error wrapping multiple validation errors
-// returned by BenchResponse.ValidateAll() if the designated constraints
-// aren't met.
-type BenchResponseMultiError []error
+// ListSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BenchResponseMultiError) Error() string {
+func (m ListSessionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1954,11 +2219,11 @@ func (m BenchResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BenchResponseMultiError) AllErrors() []error { return m }
+func (m ListSessionsRequestMultiError) AllErrors() []error { return m }
 
-// BenchResponseValidationError is the validation error returned by
-// BenchResponse.Validate if the designated constraints aren't met.
-type BenchResponseValidationError struct {
+// ListSessionsRequestValidationError is the validation error returned by
+// ListSessionsRequest.Validate if the designated constraints aren't met.
+type ListSessionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1966,22 +2231,24 @@ type BenchResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BenchResponseValidationError) Field() string { return e.field }
+func (e ListSessionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BenchResponseValidationError) Reason() string { return e.reason }
+func (e ListSessionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BenchResponseValidationError) Cause() error { return e.cause }
+func (e ListSessionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BenchResponseValidationError) Key() bool { return e.key }
+func (e ListSessionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BenchResponseValidationError) ErrorName() string { return "BenchResponseValidationError" }
+func (e ListSessionsRequestValidationError) ErrorName() string {
+	return "ListSessionsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BenchResponseValidationError) Error() string {
+func (e ListSessionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1993,14 +2260,14 @@ func (e BenchResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBenchResponse.%s: %s%s",
+		"invalid %sListSessionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BenchResponseValidationError{}
+var _ error = ListSessionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2008,44 +2275,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BenchResponseValidationError{}
+} = ListSessionsRequestValidationError{}
 
-// Validate checks the field values on CleanupRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CleanupRequest) Validate() error {
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CleanupRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CleanupRequestMultiError,
-// or nil if none found.
-func (m *CleanupRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CleanupRequest) validate(all bool) error {
+func (m *ListSessionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return CleanupRequestMultiError(errors)
+		return ListSessionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CleanupRequestMultiError is an error wrapping multiple validation errors
-// returned by CleanupRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CleanupRequestMultiError []error
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CleanupRequestMultiError) Error() string {
+func (m ListSessionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2054,11 +2361,11 @@ func (m CleanupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CleanupRequestMultiError) AllErrors() []error { return m }
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
 
-// CleanupRequestValidationError is the validation error returned by
-// CleanupRequest.Validate if the designated constraints aren't met.
-type CleanupRequestValidationError struct {
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2066,22 +2373,24 @@ type CleanupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CleanupRequestValidationError) Field() string { return e.field }
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CleanupRequestValidationError) Reason() string { return e.reason }
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CleanupRequestValidationError) Cause() error { return e.cause }
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CleanupRequestValidationError) Key() bool { return e.key }
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CleanupRequestValidationError) ErrorName() string { return "CleanupRequestValidationError" }
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CleanupRequestValidationError) Error() string {
+func (e ListSessionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2093,14 +2402,14 @@ func (e CleanupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCleanupRequest.%s: %s%s",
+		"invalid %sListSessionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CleanupRequestValidationError{}
+var _ error = ListSessionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2108,52 +2417,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CleanupRequestValidationError{}
+} = ListSessionsResponseValidationError{}
 
-// Validate checks the field values on CleanupResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CleanupResponse) Validate() error {
+// Validate checks the field values on SessionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CleanupResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CleanupResponseMultiError, or nil if none found.
-func (m *CleanupResponse) ValidateAll() error {
+// ValidateAll checks the field values on SessionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionInfoMultiError, or
+// nil if none found.
+func (m *SessionInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CleanupResponse) validate(all bool) error {
+func (m *SessionInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for Member
 
-	// no validation rules for Message
+	// no validation rules for State
 
-	// no validation rules for RedisError
+	// no validation rules for Process
 
-	// no validation rules for MysqlError
+	// no validation rules for TryTimes
+
+	// no validation rules for Retries
+
+	// no validation rules for LastError
+
+	// no validation rules for StateSec
+
+	// no validation rules for IdleSec
+
+	// no validation rules for LastSuccessAt
 
 	if len(errors) > 0 {
-		return CleanupResponseMultiError(errors)
+		return SessionInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// CleanupResponseMultiError is an error wrapping multiple validation errors
-// returned by CleanupResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CleanupResponseMultiError []error
+// SessionInfoMultiError is an error wrapping multiple validation errors
+// returned by SessionInfo.ValidateAll() if the designated constraints aren't met.
+type SessionInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CleanupResponseMultiError) Error() string {
+func (m SessionInfoMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2162,11 +2480,11 @@ func (m CleanupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CleanupResponseMultiError) AllErrors() []error { return m }
+func (m SessionInfoMultiError) AllErrors() []error { return m }
 
-// CleanupResponseValidationError is the validation error returned by
-// CleanupResponse.Validate if the designated constraints aren't met.
-type CleanupResponseValidationError struct {
+// SessionInfoValidationError is the validation error returned by
+// SessionInfo.Validate if the designated constraints aren't met.
+type SessionInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2174,22 +2492,22 @@ type CleanupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CleanupResponseValidationError) Field() string { return e.field }
+func (e SessionInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CleanupResponseValidationError) Reason() string { return e.reason }
+func (e SessionInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CleanupResponseValidationError) Cause() error { return e.cause }
+func (e SessionInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CleanupResponseValidationError) Key() bool { return e.key }
+func (e SessionInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CleanupResponseValidationError) ErrorName() string { return "CleanupResponseValidationError" }
+func (e SessionInfoValidationError) ErrorName() string { return "SessionInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CleanupResponseValidationError) Error() string {
+func (e SessionInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2201,14 +2519,14 @@ func (e CleanupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCleanupResponse.%s: %s%s",
+		"invalid %sSessionInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CleanupResponseValidationError{}
+var _ error = SessionInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -2216,46 +2534,103 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CleanupResponseValidationError{}
+} = SessionInfoValidationError{}
 
-// Validate checks the field values on Game with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Game) Validate() error {
+// Validate checks the field values on SmokeTestRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SmokeTestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Game with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GameMultiError, or nil if none found.
-func (m *Game) ValidateAll() error {
+// ValidateAll checks the field values on SmokeTestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SmokeTestRequestMultiError, or nil if none found.
+func (m *SmokeTestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Game) validate(all bool) error {
+func (m *SmokeTestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GameId
+	if m.GetGameId() <= 0 {
+		err := SmokeTestRequestValidationError{
+			field:  "GameId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for GameName
+	if all {
+		switch v := interface{}(m.GetBetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SmokeTestRequestValidationError{
+					field:  "BetOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SmokeTestRequestValidationError{
+					field:  "BetOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SmokeTestRequestValidationError{
+				field:  "BetOrder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Member
+
+	if val := m.GetSpins(); val < 0 || val > 20 {
+		err := SmokeTestRequestValidationError{
+			field:  "Spins",
+			reason: "value must be inside range [0, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Environment
+
+	// no validation rules for Merchant
+
+	// no validation rules for Transport
 
 	if len(errors) > 0 {
-		return GameMultiError(errors)
+		return SmokeTestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GameMultiError is an error wrapping multiple validation errors returned by
-// Game.ValidateAll() if the designated constraints aren't met.
-type GameMultiError []error
+// SmokeTestRequestMultiError is an error wrapping multiple validation errors
+// returned by SmokeTestRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SmokeTestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GameMultiError) Error() string {
+func (m SmokeTestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2264,11 +2639,11 @@ func (m GameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GameMultiError) AllErrors() []error { return m }
+func (m SmokeTestRequestMultiError) AllErrors() []error { return m }
 
-// GameValidationError is the validation error returned by Game.Validate if the
-// designated constraints aren't met.
-type GameValidationError struct {
+// SmokeTestRequestValidationError is the validation error returned by
+// SmokeTestRequest.Validate if the designated constraints aren't met.
+type SmokeTestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2276,22 +2651,22 @@ type GameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GameValidationError) Field() string { return e.field }
+func (e SmokeTestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GameValidationError) Reason() string { return e.reason }
+func (e SmokeTestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GameValidationError) Cause() error { return e.cause }
+func (e SmokeTestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GameValidationError) Key() bool { return e.key }
+func (e SmokeTestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GameValidationError) ErrorName() string { return "GameValidationError" }
+func (e SmokeTestRequestValidationError) ErrorName() string { return "SmokeTestRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GameValidationError) Error() string {
+func (e SmokeTestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2303,14 +2678,14 @@ func (e GameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGame.%s: %s%s",
+		"invalid %sSmokeTestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GameValidationError{}
+var _ error = SmokeTestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2318,107 +2693,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GameValidationError{}
+} = SmokeTestRequestValidationError{}
 
-// Validate checks the field values on TaskConfig with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TaskConfig) Validate() error {
+// Validate checks the field values on SmokeTestResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SmokeTestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TaskConfig with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TaskConfigMultiError, or
-// nil if none found.
-func (m *TaskConfig) ValidateAll() error {
+// ValidateAll checks the field values on SmokeTestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SmokeTestResponseMultiError, or nil if none found.
+func (m *SmokeTestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TaskConfig) validate(all bool) error {
+func (m *SmokeTestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetGameId() <= 0 {
-		err := TaskConfigValidationError{
-			field:  "GameId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Code
 
-	// no validation rules for Description
+	// no validation rules for Message
 
-	if val := m.GetMemberCount(); val < 1 || val > 10000 {
-		err := TaskConfigValidationError{
-			field:  "MemberCount",
-			reason: "value must be inside range [1, 10000]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Passed
 
-	if val := m.GetTimesPerMember(); val < 1 || val > 10000 {
-		err := TaskConfigValidationError{
-			field:  "TimesPerMember",
-			reason: "value must be inside range [1, 10000]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Member
 
-	if all {
-		switch v := interface{}(m.GetBetOrder()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TaskConfigValidationError{
-					field:  "BetOrder",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SmokeTestResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SmokeTestResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TaskConfigValidationError{
-					field:  "BetOrder",
+				return SmokeTestResponseValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBetOrder()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TaskConfigValidationError{
-				field:  "BetOrder",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return TaskConfigMultiError(errors)
+		return SmokeTestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TaskConfigMultiError is an error wrapping multiple validation errors
-// returned by TaskConfig.ValidateAll() if the designated constraints aren't met.
-type TaskConfigMultiError []error
+// SmokeTestResponseMultiError is an error wrapping multiple validation errors
+// returned by SmokeTestResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SmokeTestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TaskConfigMultiError) Error() string {
+func (m SmokeTestResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2427,11 +2781,11 @@ func (m TaskConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TaskConfigMultiError) AllErrors() []error { return m }
+func (m SmokeTestResponseMultiError) AllErrors() []error { return m }
 
-// TaskConfigValidationError is the validation error returned by
-// TaskConfig.Validate if the designated constraints aren't met.
-type TaskConfigValidationError struct {
+// SmokeTestResponseValidationError is the validation error returned by
+// SmokeTestResponse.Validate if the designated constraints aren't met.
+type SmokeTestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2439,22 +2793,24 @@ type TaskConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskConfigValidationError) Field() string { return e.field }
+func (e SmokeTestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskConfigValidationError) Reason() string { return e.reason }
+func (e SmokeTestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskConfigValidationError) Cause() error { return e.cause }
+func (e SmokeTestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskConfigValidationError) Key() bool { return e.key }
+func (e SmokeTestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskConfigValidationError) ErrorName() string { return "TaskConfigValidationError" }
+func (e SmokeTestResponseValidationError) ErrorName() string {
+	return "SmokeTestResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TaskConfigValidationError) Error() string {
+func (e SmokeTestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2466,14 +2822,14 @@ func (e TaskConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTaskConfig.%s: %s%s",
+		"invalid %sSmokeTestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskConfigValidationError{}
+var _ error = SmokeTestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2481,77 +2837,5444 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskConfigValidationError{}
+} = SmokeTestResponseValidationError{}
 
-// Validate checks the field values on BetOrderConfig with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on SmokeStep with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *BetOrderConfig) Validate() error {
+func (m *SmokeStep) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BetOrderConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BetOrderConfigMultiError,
-// or nil if none found.
-func (m *BetOrderConfig) ValidateAll() error {
+// ValidateAll checks the field values on SmokeStep with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SmokeStepMultiError, or nil
+// if none found.
+func (m *SmokeStep) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BetOrderConfig) validate(all bool) error {
+func (m *SmokeStep) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetBaseMoney() <= 0 {
-		err := BetOrderConfigValidationError{
-			field:  "BaseMoney",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Op
 
-	if m.GetMultiple() <= 0 {
-		err := BetOrderConfigValidationError{
-			field:  "Multiple",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Url
+
+	// no validation rules for Request
+
+	// no validation rules for HttpStatus
+
+	// no validation rules for Response
+
+	// no validation rules for Decoded
+
+	// no validation rules for LatencyMs
+
+	// no validation rules for Error
+
+	for idx, item := range m.GetPredicates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SmokeStepValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SmokeStepValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SmokeStepValidationError{
+					field:  fmt.Sprintf("Predicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SmokeStepMultiError(errors)
+	}
+
+	return nil
+}
+
+// SmokeStepMultiError is an error wrapping multiple validation errors returned
+// by SmokeStep.ValidateAll() if the designated constraints aren't met.
+type SmokeStepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SmokeStepMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SmokeStepMultiError) AllErrors() []error { return m }
+
+// SmokeStepValidationError is the validation error returned by
+// SmokeStep.Validate if the designated constraints aren't met.
+type SmokeStepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SmokeStepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SmokeStepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SmokeStepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SmokeStepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SmokeStepValidationError) ErrorName() string { return "SmokeStepValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SmokeStepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSmokeStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SmokeStepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SmokeStepValidationError{}
+
+// Validate checks the field values on Predicate with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Predicate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Predicate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PredicateMultiError, or nil
+// if none found.
+func (m *Predicate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Predicate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Fired
+
+	if len(errors) > 0 {
+		return PredicateMultiError(errors)
+	}
+
+	return nil
+}
+
+// PredicateMultiError is an error wrapping multiple validation errors returned
+// by Predicate.ValidateAll() if the designated constraints aren't met.
+type PredicateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PredicateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PredicateMultiError) AllErrors() []error { return m }
+
+// PredicateValidationError is the validation error returned by
+// Predicate.Validate if the designated constraints aren't met.
+type PredicateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PredicateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PredicateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PredicateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PredicateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PredicateValidationError) ErrorName() string { return "PredicateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PredicateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPredicate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PredicateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PredicateValidationError{}
+
+// Validate checks the field values on WatchTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchTaskRequestMultiError, or nil if none found.
+func (m *WatchTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := WatchTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIntervalMs() < 0 {
+		err := WatchTaskRequestValidationError{
+			field:  "IntervalMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchTaskRequestMultiError) AllErrors() []error { return m }
+
+// WatchTaskRequestValidationError is the validation error returned by
+// WatchTaskRequest.Validate if the designated constraints aren't met.
+type WatchTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchTaskRequestValidationError) ErrorName() string { return "WatchTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchTaskRequestValidationError{}
+
+// Validate checks the field values on WatchTaskEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchTaskEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchTaskEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchTaskEventMultiError,
+// or nil if none found.
+func (m *WatchTaskEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchTaskEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for TaskId
+
+	// no validation rules for Status
+
+	// no validation rules for Time
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchTaskEventValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchTaskEventValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchTaskEventValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchTaskEventValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchTaskEventValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchTaskEventValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchTaskEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchTaskEventMultiError is an error wrapping multiple validation errors
+// returned by WatchTaskEvent.ValidateAll() if the designated constraints
+// aren't met.
+type WatchTaskEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchTaskEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchTaskEventMultiError) AllErrors() []error { return m }
+
+// WatchTaskEventValidationError is the validation error returned by
+// WatchTaskEvent.Validate if the designated constraints aren't met.
+type WatchTaskEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchTaskEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchTaskEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchTaskEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchTaskEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchTaskEventValidationError) ErrorName() string { return "WatchTaskEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchTaskEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchTaskEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchTaskEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchTaskEventValidationError{}
+
+// Validate checks the field values on DeleteTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaskRequestMultiError, or nil if none found.
+func (m *DeleteTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := DeleteTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTaskRequestValidationError is the validation error returned by
+// DeleteTaskRequest.Validate if the designated constraints aren't met.
+type DeleteTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaskRequestValidationError) ErrorName() string {
+	return "DeleteTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaskRequestValidationError{}
+
+// Validate checks the field values on RecordRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecordRequestMultiError, or
+// nil if none found.
+func (m *RecordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := RecordRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordRequestMultiError is an error wrapping multiple validation errors
+// returned by RecordRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RecordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordRequestMultiError) AllErrors() []error { return m }
+
+// RecordRequestValidationError is the validation error returned by
+// RecordRequest.Validate if the designated constraints aren't met.
+type RecordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordRequestValidationError) ErrorName() string { return "RecordRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordRequestValidationError{}
+
+// Validate checks the field values on RecordResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecordResponseMultiError,
+// or nil if none found.
+func (m *RecordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return RecordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordResponseMultiError is an error wrapping multiple validation errors
+// returned by RecordResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RecordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordResponseMultiError) AllErrors() []error { return m }
+
+// RecordResponseValidationError is the validation error returned by
+// RecordResponse.Validate if the designated constraints aren't met.
+type RecordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordResponseValidationError) ErrorName() string { return "RecordResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordResponseValidationError{}
+
+// Validate checks the field values on BenchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BenchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BenchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BenchRequestMultiError, or
+// nil if none found.
+func (m *BenchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BenchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetMemberCount(); val < 1 || val > 10000 {
+		err := BenchRequestValidationError{
+			field:  "MemberCount",
+			reason: "value must be inside range [1, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTimesPerMember(); val < 1 || val > 10000 {
+		err := BenchRequestValidationError{
+			field:  "TimesPerMember",
+			reason: "value must be inside range [1, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BenchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BenchRequestMultiError is an error wrapping multiple validation errors
+// returned by BenchRequest.ValidateAll() if the designated constraints aren't met.
+type BenchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BenchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BenchRequestMultiError) AllErrors() []error { return m }
+
+// BenchRequestValidationError is the validation error returned by
+// BenchRequest.Validate if the designated constraints aren't met.
+type BenchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BenchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BenchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BenchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BenchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BenchRequestValidationError) ErrorName() string { return "BenchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BenchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBenchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BenchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BenchRequestValidationError{}
+
+// Validate checks the field values on BenchResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BenchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BenchResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BenchResponseMultiError, or
+// nil if none found.
+func (m *BenchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BenchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for TaskIds
+
+	if len(errors) > 0 {
+		return BenchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BenchResponseMultiError is an error wrapping multiple validation errors
+// returned by BenchResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BenchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BenchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BenchResponseMultiError) AllErrors() []error { return m }
+
+// BenchResponseValidationError is the validation error returned by
+// BenchResponse.Validate if the designated constraints aren't met.
+type BenchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BenchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BenchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BenchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BenchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BenchResponseValidationError) ErrorName() string { return "BenchResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BenchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBenchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BenchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BenchResponseValidationError{}
+
+// Validate checks the field values on CleanupRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CleanupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CleanupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CleanupRequestMultiError,
+// or nil if none found.
+func (m *CleanupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CleanupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CleanupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CleanupRequestMultiError is an error wrapping multiple validation errors
+// returned by CleanupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CleanupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CleanupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CleanupRequestMultiError) AllErrors() []error { return m }
+
+// CleanupRequestValidationError is the validation error returned by
+// CleanupRequest.Validate if the designated constraints aren't met.
+type CleanupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CleanupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CleanupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CleanupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CleanupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CleanupRequestValidationError) ErrorName() string { return "CleanupRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CleanupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCleanupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CleanupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CleanupRequestValidationError{}
+
+// Validate checks the field values on CleanupResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CleanupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CleanupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CleanupResponseMultiError, or nil if none found.
+func (m *CleanupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CleanupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for RedisError
+
+	// no validation rules for MysqlError
+
+	if len(errors) > 0 {
+		return CleanupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CleanupResponseMultiError is an error wrapping multiple validation errors
+// returned by CleanupResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CleanupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CleanupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CleanupResponseMultiError) AllErrors() []error { return m }
+
+// CleanupResponseValidationError is the validation error returned by
+// CleanupResponse.Validate if the designated constraints aren't met.
+type CleanupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CleanupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CleanupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CleanupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CleanupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CleanupResponseValidationError) ErrorName() string { return "CleanupResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CleanupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCleanupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CleanupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CleanupResponseValidationError{}
+
+// Validate checks the field values on ListQueueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueueRequestMultiError, or nil if none found.
+func (m *ListQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by ListQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueueRequestMultiError) AllErrors() []error { return m }
+
+// ListQueueRequestValidationError is the validation error returned by
+// ListQueueRequest.Validate if the designated constraints aren't met.
+type ListQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueueRequestValidationError) ErrorName() string { return "ListQueueRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueueRequestValidationError{}
+
+// Validate checks the field values on ListQueueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueueResponseMultiError, or nil if none found.
+func (m *ListQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Paused
+
+	// no validation rules for Running
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQueueResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQueueResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueueResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueueResponseMultiError is an error wrapping multiple validation errors
+// returned by ListQueueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueueResponseMultiError) AllErrors() []error { return m }
+
+// ListQueueResponseValidationError is the validation error returned by
+// ListQueueResponse.Validate if the designated constraints aren't met.
+type ListQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueueResponseValidationError) ErrorName() string {
+	return "ListQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueueResponseValidationError{}
+
+// Validate checks the field values on MoveTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveTaskRequestMultiError, or nil if none found.
+func (m *MoveTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := MoveTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _MoveTaskRequest_Position_NotInLookup[m.GetPosition()]; ok {
+		err := MoveTaskRequestValidationError{
+			field:  "Position",
+			reason: "value must not be in list [POSITION_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MoveTaskRequest_Position_name[int32(m.GetPosition())]; !ok {
+		err := MoveTaskRequestValidationError{
+			field:  "Position",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveTaskRequestMultiError) AllErrors() []error { return m }
+
+// MoveTaskRequestValidationError is the validation error returned by
+// MoveTaskRequest.Validate if the designated constraints aren't met.
+type MoveTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveTaskRequestValidationError) ErrorName() string { return "MoveTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveTaskRequestValidationError{}
+
+var _MoveTaskRequest_Position_NotInLookup = map[MoveTaskRequest_Position]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on MoveTaskResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveTaskResponseMultiError, or nil if none found.
+func (m *MoveTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return MoveTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveTaskResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveTaskResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveTaskResponseMultiError) AllErrors() []error { return m }
+
+// MoveTaskResponseValidationError is the validation error returned by
+// MoveTaskResponse.Validate if the designated constraints aren't met.
+type MoveTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveTaskResponseValidationError) ErrorName() string { return "MoveTaskResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveTaskResponseValidationError{}
+
+// Validate checks the field values on PauseQueueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseQueueRequestMultiError, or nil if none found.
+func (m *PauseQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PauseQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseQueueRequestMultiError) AllErrors() []error { return m }
+
+// PauseQueueRequestValidationError is the validation error returned by
+// PauseQueueRequest.Validate if the designated constraints aren't met.
+type PauseQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseQueueRequestValidationError) ErrorName() string {
+	return "PauseQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseQueueRequestValidationError{}
+
+// Validate checks the field values on ResumeQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeQueueRequestMultiError, or nil if none found.
+func (m *ResumeQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResumeQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeQueueRequestMultiError) AllErrors() []error { return m }
+
+// ResumeQueueRequestValidationError is the validation error returned by
+// ResumeQueueRequest.Validate if the designated constraints aren't met.
+type ResumeQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeQueueRequestValidationError) ErrorName() string {
+	return "ResumeQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeQueueRequestValidationError{}
+
+// Validate checks the field values on QueueStateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueStateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueStateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueStateResponseMultiError, or nil if none found.
+func (m *QueueStateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueStateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Paused
+
+	if len(errors) > 0 {
+		return QueueStateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueStateResponseMultiError is an error wrapping multiple validation errors
+// returned by QueueStateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type QueueStateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueStateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueStateResponseMultiError) AllErrors() []error { return m }
+
+// QueueStateResponseValidationError is the validation error returned by
+// QueueStateResponse.Validate if the designated constraints aren't met.
+type QueueStateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueStateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueStateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueStateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueStateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueStateResponseValidationError) ErrorName() string {
+	return "QueueStateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueStateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueStateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueStateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueStateResponseValidationError{}
+
+// Validate checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateScheduleRequestMultiError, or nil if none found.
+func (m *CreateScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if utf8.RuneCountInString(m.GetCron()) < 1 {
+		err := CreateScheduleRequestValidationError{
+			field:  "Cron",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofTemplatePresent := false
+	switch v := m.Template.(type) {
+	case *CreateScheduleRequest_Task:
+		if v == nil {
+			err := CreateScheduleRequestValidationError{
+				field:  "Template",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTemplatePresent = true
+
+		if all {
+			switch v := interface{}(m.GetTask()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateScheduleRequestValidationError{
+						field:  "Task",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateScheduleRequestValidationError{
+						field:  "Task",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateScheduleRequestValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateScheduleRequest_Bench:
+		if v == nil {
+			err := CreateScheduleRequestValidationError{
+				field:  "Template",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTemplatePresent = true
+
+		if all {
+			switch v := interface{}(m.GetBench()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateScheduleRequestValidationError{
+						field:  "Bench",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateScheduleRequestValidationError{
+						field:  "Bench",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBench()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateScheduleRequestValidationError{
+					field:  "Bench",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTemplatePresent {
+		err := CreateScheduleRequestValidationError{
+			field:  "Template",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CreateScheduleRequestValidationError is the validation error returned by
+// CreateScheduleRequest.Validate if the designated constraints aren't met.
+type CreateScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduleRequestValidationError) ErrorName() string {
+	return "CreateScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduleRequestValidationError{}
+
+// Validate checks the field values on CreateScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateScheduleResponseMultiError, or nil if none found.
+func (m *CreateScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateScheduleResponseValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateScheduleResponseValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateScheduleResponseValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduleResponseMultiError) AllErrors() []error { return m }
+
+// CreateScheduleResponseValidationError is the validation error returned by
+// CreateScheduleResponse.Validate if the designated constraints aren't met.
+type CreateScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduleResponseValidationError) ErrorName() string {
+	return "CreateScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduleResponseValidationError{}
+
+// Validate checks the field values on ListSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchedulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchedulesRequestMultiError, or nil if none found.
+func (m *ListSchedulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchedulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSchedulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchedulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSchedulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSchedulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchedulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchedulesRequestMultiError) AllErrors() []error { return m }
+
+// ListSchedulesRequestValidationError is the validation error returned by
+// ListSchedulesRequest.Validate if the designated constraints aren't met.
+type ListSchedulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchedulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchedulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchedulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchedulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchedulesRequestValidationError) ErrorName() string {
+	return "ListSchedulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchedulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchedulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchedulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchedulesRequestValidationError{}
+
+// Validate checks the field values on ListSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchedulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchedulesResponseMultiError, or nil if none found.
+func (m *ListSchedulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchedulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchedules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSchedulesResponseValidationError{
+					field:  fmt.Sprintf("Schedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListSchedulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchedulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSchedulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSchedulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchedulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchedulesResponseMultiError) AllErrors() []error { return m }
+
+// ListSchedulesResponseValidationError is the validation error returned by
+// ListSchedulesResponse.Validate if the designated constraints aren't met.
+type ListSchedulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchedulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchedulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchedulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchedulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchedulesResponseValidationError) ErrorName() string {
+	return "ListSchedulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchedulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchedulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchedulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchedulesResponseValidationError{}
+
+// Validate checks the field values on DeleteScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteScheduleRequestMultiError, or nil if none found.
+func (m *DeleteScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetScheduleId() <= 0 {
+		err := DeleteScheduleRequestValidationError{
+			field:  "ScheduleId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteScheduleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteScheduleRequestValidationError is the validation error returned by
+// DeleteScheduleRequest.Validate if the designated constraints aren't met.
+type DeleteScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScheduleRequestValidationError) ErrorName() string {
+	return "DeleteScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScheduleRequestValidationError{}
+
+// Validate checks the field values on DeleteScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteScheduleResponseMultiError, or nil if none found.
+func (m *DeleteScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteScheduleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteScheduleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteScheduleResponseValidationError is the validation error returned by
+// DeleteScheduleResponse.Validate if the designated constraints aren't met.
+type DeleteScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScheduleResponseValidationError) ErrorName() string {
+	return "DeleteScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScheduleResponseValidationError{}
+
+// Validate checks the field values on Game with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Game) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Game with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GameMultiError, or nil if none found.
+func (m *Game) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Game) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for GameName
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return GameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GameMultiError is an error wrapping multiple validation errors returned by
+// Game.ValidateAll() if the designated constraints aren't met.
+type GameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GameMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GameMultiError) AllErrors() []error { return m }
+
+// GameValidationError is the validation error returned by Game.Validate if the
+// designated constraints aren't met.
+type GameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GameValidationError) ErrorName() string { return "GameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGame.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GameValidationError{}
+
+// Validate checks the field values on TaskConfig with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskConfigMultiError, or
+// nil if none found.
+func (m *TaskConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGameId() <= 0 {
+		err := TaskConfigValidationError{
+			field:  "GameId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if val := m.GetMemberCount(); val < 1 || val > 10000 {
+		err := TaskConfigValidationError{
+			field:  "MemberCount",
+			reason: "value must be inside range [1, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTimesPerMember(); val < 0 || val > 10000 {
+		err := TaskConfigValidationError{
+			field:  "TimesPerMember",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "BetOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "BetOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskConfigValidationError{
+				field:  "BetOrder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLoad()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Load",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Load",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoad()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskConfigValidationError{
+				field:  "Load",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for Duration
+
+	if all {
+		switch v := interface{}(m.GetRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskConfigValidationError{
+				field:  "Retry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSlo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Slo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Slo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSlo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskConfigValidationError{
+				field:  "Slo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecording()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Recording",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Recording",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecording()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskConfigValidationError{
+				field:  "Recording",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetChaos()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Chaos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskConfigValidationError{
+					field:  "Chaos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChaos()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskConfigValidationError{
+				field:  "Chaos",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Environment
+
+	// no validation rules for Merchant
+
+	// no validation rules for Transport
+
+	if len(errors) > 0 {
+		return TaskConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskConfigMultiError is an error wrapping multiple validation errors
+// returned by TaskConfig.ValidateAll() if the designated constraints aren't met.
+type TaskConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskConfigMultiError) AllErrors() []error { return m }
+
+// TaskConfigValidationError is the validation error returned by
+// TaskConfig.Validate if the designated constraints aren't met.
+type TaskConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskConfigValidationError) ErrorName() string { return "TaskConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskConfigValidationError{}
+
+// Validate checks the field values on Chaos with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Chaos) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Chaos with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChaosMultiError, or nil if none found.
+func (m *Chaos) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Chaos) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetSessionRate(); val < 0 || val > 1 {
+		err := ChaosValidationError{
+			field:  "SessionRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDropConnection(); val < 0 || val > 1 {
+		err := ChaosValidationError{
+			field:  "DropConnection",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetAbandonBonus(); val < 0 || val > 1 {
+		err := ChaosValidationError{
+			field:  "AbandonBonus",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetStaleToken(); val < 0 || val > 1 {
+		err := ChaosValidationError{
+			field:  "StaleToken",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDuplicateBet(); val < 0 || val > 1 {
+		err := ChaosValidationError{
+			field:  "DuplicateBet",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetIdleExpiry(); val < 0 || val > 1 {
+		err := ChaosValidationError{
+			field:  "IdleExpiry",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IdleDuration
+
+	if len(errors) > 0 {
+		return ChaosMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChaosMultiError is an error wrapping multiple validation errors returned by
+// Chaos.ValidateAll() if the designated constraints aren't met.
+type ChaosMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChaosMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChaosMultiError) AllErrors() []error { return m }
+
+// ChaosValidationError is the validation error returned by Chaos.Validate if
+// the designated constraints aren't met.
+type ChaosValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChaosValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChaosValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChaosValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChaosValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChaosValidationError) ErrorName() string { return "ChaosValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChaosValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChaos.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChaosValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChaosValidationError{}
+
+// Validate checks the field values on Recording with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Recording) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Recording with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecordingMultiError, or nil
+// if none found.
+func (m *Recording) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Recording) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetSampleRate(); val < 0 || val > 1 {
+		err := RecordingValidationError{
+			field:  "SampleRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UploadS3
+
+	if m.GetMaxRecords() < 0 {
+		err := RecordingValidationError{
+			field:  "MaxRecords",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecordingMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordingMultiError is an error wrapping multiple validation errors returned
+// by Recording.ValidateAll() if the designated constraints aren't met.
+type RecordingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordingMultiError) AllErrors() []error { return m }
+
+// RecordingValidationError is the validation error returned by
+// Recording.Validate if the designated constraints aren't met.
+type RecordingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordingValidationError) ErrorName() string { return "RecordingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecordingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecording.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordingValidationError{}
+
+// Validate checks the field values on BetOrderConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BetOrderConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BetOrderConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BetOrderConfigMultiError,
+// or nil if none found.
+func (m *BetOrderConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BetOrderConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBaseMoney() <= 0 {
+		err := BetOrderConfigValidationError{
+			field:  "BaseMoney",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMultiple() <= 0 {
+		err := BetOrderConfigValidationError{
+			field:  "Multiple",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPurchase() < 0 {
+		err := BetOrderConfigValidationError{
+			field:  "Purchase",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BetOrderConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// BetOrderConfigMultiError is an error wrapping multiple validation errors
+// returned by BetOrderConfig.ValidateAll() if the designated constraints
+// aren't met.
+type BetOrderConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BetOrderConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BetOrderConfigMultiError) AllErrors() []error { return m }
+
+// BetOrderConfigValidationError is the validation error returned by
+// BetOrderConfig.Validate if the designated constraints aren't met.
+type BetOrderConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BetOrderConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BetOrderConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BetOrderConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BetOrderConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BetOrderConfigValidationError) ErrorName() string { return "BetOrderConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BetOrderConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBetOrderConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BetOrderConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BetOrderConfigValidationError{}
+
+// Validate checks the field values on LoadProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoadProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoadProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoadProfileMultiError, or
+// nil if none found.
+func (m *LoadProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoadProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRate() < 0 {
+		err := LoadProfileValidationError{
+			field:  "Rate",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RampUp
+
+	// no validation rules for Steady
+
+	// no validation rules for RampDown
+
+	if m.GetBurst() < 0 {
+		err := LoadProfileValidationError{
+			field:  "Burst",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LoadProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoadProfileMultiError is an error wrapping multiple validation errors
+// returned by LoadProfile.ValidateAll() if the designated constraints aren't met.
+type LoadProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoadProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoadProfileMultiError) AllErrors() []error { return m }
+
+// LoadProfileValidationError is the validation error returned by
+// LoadProfile.Validate if the designated constraints aren't met.
+type LoadProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoadProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoadProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoadProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoadProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoadProfileValidationError) ErrorName() string { return "LoadProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoadProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoadProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoadProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoadProfileValidationError{}
+
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMaxAttempts() < 0 {
+		err := RetryPolicyValidationError{
+			field:  "MaxAttempts",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StateMaxAttempts
+
+	// no validation rules for BaseDelay
+
+	// no validation rules for MaxDelay
+
+	if m.GetMultiplier() < 0 {
+		err := RetryPolicyValidationError{
+			field:  "Multiplier",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetJitter(); val < 0 || val > 1 {
+		err := RetryPolicyValidationError{
+			field:  "Jitter",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RetryPolicyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RetryPolicyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RetryPolicyValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetryPolicyValidationError{
+					field:  "Breaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetryPolicyValidationError{
+					field:  "Breaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetryPolicyValidationError{
+				field:  "Breaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
+
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryPolicyValidationError{}
+
+// Validate checks the field values on ErrorRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorRuleMultiError, or nil
+// if none found.
+func (m *ErrorRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Op
+
+	// no validation rules for Cause
+
+	// no validation rules for Action
+
+	// no validation rules for Delay
+
+	if len(errors) > 0 {
+		return ErrorRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorRuleMultiError is an error wrapping multiple validation errors returned
+// by ErrorRule.ValidateAll() if the designated constraints aren't met.
+type ErrorRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorRuleMultiError) AllErrors() []error { return m }
+
+// ErrorRuleValidationError is the validation error returned by
+// ErrorRule.Validate if the designated constraints aren't met.
+type ErrorRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorRuleValidationError) ErrorName() string { return "ErrorRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorRuleValidationError{}
+
+// Validate checks the field values on CircuitBreaker with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CircuitBreaker) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CircuitBreaker with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CircuitBreakerMultiError,
+// or nil if none found.
+func (m *CircuitBreaker) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CircuitBreaker) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetErrorRate(); val < 0 || val > 1 {
+		err := CircuitBreakerValidationError{
+			field:  "ErrorRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Window
+
+	if m.GetMinRequests() < 0 {
+		err := CircuitBreakerValidationError{
+			field:  "MinRequests",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CircuitBreakerMultiError(errors)
+	}
+
+	return nil
+}
+
+// CircuitBreakerMultiError is an error wrapping multiple validation errors
+// returned by CircuitBreaker.ValidateAll() if the designated constraints
+// aren't met.
+type CircuitBreakerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CircuitBreakerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CircuitBreakerMultiError) AllErrors() []error { return m }
+
+// CircuitBreakerValidationError is the validation error returned by
+// CircuitBreaker.Validate if the designated constraints aren't met.
+type CircuitBreakerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CircuitBreakerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CircuitBreakerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CircuitBreakerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CircuitBreakerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CircuitBreakerValidationError) ErrorName() string { return "CircuitBreakerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CircuitBreakerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCircuitBreaker.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CircuitBreakerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CircuitBreakerValidationError{}
+
+// Validate checks the field values on SLO with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *SLO) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SLO with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SLOMultiError, or nil if none found.
+func (m *SLO) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SLO) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAssertions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SLOValidationError{
+						field:  fmt.Sprintf("Assertions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SLOValidationError{
+						field:  fmt.Sprintf("Assertions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SLOValidationError{
+					field:  fmt.Sprintf("Assertions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Warmup
+
+	if len(errors) > 0 {
+		return SLOMultiError(errors)
+	}
+
+	return nil
+}
+
+// SLOMultiError is an error wrapping multiple validation errors returned by
+// SLO.ValidateAll() if the designated constraints aren't met.
+type SLOMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SLOMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SLOMultiError) AllErrors() []error { return m }
+
+// SLOValidationError is the validation error returned by SLO.Validate if the
+// designated constraints aren't met.
+type SLOValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SLOValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SLOValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SLOValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SLOValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SLOValidationError) ErrorName() string { return "SLOValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SLOValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSLO.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SLOValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SLOValidationError{}
+
+// Validate checks the field values on Assertion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Assertion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Assertion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssertionMultiError, or nil
+// if none found.
+func (m *Assertion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Assertion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMetric()) < 1 {
+		err := AssertionValidationError{
+			field:  "Metric",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Request
+
+	if utf8.RuneCountInString(m.GetOperator()) < 1 {
+		err := AssertionValidationError{
+			field:  "Operator",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Value
+
+	// no validation rules for Hard
+
+	if len(errors) > 0 {
+		return AssertionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssertionMultiError is an error wrapping multiple validation errors returned
+// by Assertion.ValidateAll() if the designated constraints aren't met.
+type AssertionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssertionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssertionMultiError) AllErrors() []error { return m }
+
+// AssertionValidationError is the validation error returned by
+// Assertion.Validate if the designated constraints aren't met.
+type AssertionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssertionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssertionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssertionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssertionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssertionValidationError) ErrorName() string { return "AssertionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssertionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssertion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssertionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssertionValidationError{}
+
+// Validate checks the field values on AssertionResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssertionResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssertionResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssertionResultMultiError, or nil if none found.
+func (m *AssertionResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssertionResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Actual
+
+	// no validation rules for Passed
+
+	// no validation rules for Hard
+
+	// no validation rules for NoData
+
+	if len(errors) > 0 {
+		return AssertionResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssertionResultMultiError is an error wrapping multiple validation errors
+// returned by AssertionResult.ValidateAll() if the designated constraints
+// aren't met.
+type AssertionResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssertionResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssertionResultMultiError) AllErrors() []error { return m }
+
+// AssertionResultValidationError is the validation error returned by
+// AssertionResult.Validate if the designated constraints aren't met.
+type AssertionResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssertionResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssertionResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssertionResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssertionResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssertionResultValidationError) ErrorName() string { return "AssertionResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssertionResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssertionResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssertionResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssertionResultValidationError{}
+
+// Validate checks the field values on Task with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Task) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Task with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaskMultiError, or nil if none found.
+func (m *Task) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Task) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for Description
+
+	// no validation rules for Status
+
+	// no validation rules for Process
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RecordUrl
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for StartAt
+
+	// no validation rules for FinishAt
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskMultiError is an error wrapping multiple validation errors returned by
+// Task.ValidateAll() if the designated constraints aren't met.
+type TaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskMultiError) AllErrors() []error { return m }
+
+// TaskValidationError is the validation error returned by Task.Validate if the
+// designated constraints aren't met.
+type TaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskValidationError) ErrorName() string { return "TaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskValidationError{}
+
+// Validate checks the field values on TaskCompletionReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TaskCompletionReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskCompletionReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskCompletionReportMultiError, or nil if none found.
+func (m *TaskCompletionReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskCompletionReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for GameId
+
+	// no validation rules for GameName
+
+	// no validation rules for Process
+
+	// no validation rules for Target
+
+	// no validation rules for Step
+
+	// no validation rules for Duration
+
+	// no validation rules for Qps
+
+	// no validation rules for AvgLatency
+
+	// no validation rules for OrderCount
+
+	// no validation rules for TotalBet
+
+	// no validation rules for TotalWin
+
+	// no validation rules for RtpPct
+
+	// no validation rules for ActiveMembers
+
+	// no validation rules for Completed
+
+	// no validation rules for Failed
+
+	// no validation rules for FailedReqs
+
+	// no validation rules for ProgressPct
+
+	// no validation rules for Url
+
+	// no validation rules for OrderWarning
+
+	// no validation rules for BonusStep
+
+	// no validation rules for TargetRate
+
+	for idx, item := range m.GetLatencies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskCompletionReportValidationError{
+						field:  fmt.Sprintf("Latencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskCompletionReportValidationError{
+						field:  fmt.Sprintf("Latencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskCompletionReportValidationError{
+					field:  fmt.Sprintf("Latencies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskCompletionReportValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskCompletionReportValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskCompletionReportValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSlo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskCompletionReportValidationError{
+						field:  fmt.Sprintf("Slo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskCompletionReportValidationError{
+						field:  fmt.Sprintf("Slo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskCompletionReportValidationError{
+					field:  fmt.Sprintf("Slo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SloPassed
+
+	// no validation rules for Recorded
+
+	// no validation rules for RecordingUrl
+
+	if all {
+		switch v := interface{}(m.GetChaos()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskCompletionReportValidationError{
+					field:  "Chaos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskCompletionReportValidationError{
+					field:  "Chaos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChaos()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskCompletionReportValidationError{
+				field:  "Chaos",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClientBet
+
+	// no validation rules for ClientWin
+
+	// no validation rules for ClientRtpPct
+
+	if len(errors) > 0 {
+		return TaskCompletionReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskCompletionReportMultiError is an error wrapping multiple validation
+// errors returned by TaskCompletionReport.ValidateAll() if the designated
+// constraints aren't met.
+type TaskCompletionReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskCompletionReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskCompletionReportMultiError) AllErrors() []error { return m }
+
+// TaskCompletionReportValidationError is the validation error returned by
+// TaskCompletionReport.Validate if the designated constraints aren't met.
+type TaskCompletionReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskCompletionReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskCompletionReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskCompletionReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskCompletionReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskCompletionReportValidationError) ErrorName() string {
+	return "TaskCompletionReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskCompletionReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskCompletionReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskCompletionReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskCompletionReportValidationError{}
+
+// Validate checks the field values on ChaosStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChaosStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChaosStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChaosStatsMultiError, or
+// nil if none found.
+func (m *ChaosStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChaosStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sessions
+
+	// no validation rules for Dropped
+
+	// no validation rules for Abandoned
+
+	// no validation rules for StaleSent
+
+	// no validation rules for StaleAccepted
+
+	// no validation rules for DuplicateSent
+
+	// no validation rules for DuplicateAccepted
+
+	// no validation rules for Idled
+
+	// no validation rules for IdleRejected
+
+	// no validation rules for ExtraOrders
+
+	if len(errors) > 0 {
+		return ChaosStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChaosStatsMultiError is an error wrapping multiple validation errors
+// returned by ChaosStats.ValidateAll() if the designated constraints aren't met.
+type ChaosStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChaosStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChaosStatsMultiError) AllErrors() []error { return m }
+
+// ChaosStatsValidationError is the validation error returned by
+// ChaosStats.Validate if the designated constraints aren't met.
+type ChaosStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChaosStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChaosStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChaosStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChaosStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChaosStatsValidationError) ErrorName() string { return "ChaosStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChaosStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChaosStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChaosStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChaosStatsValidationError{}
+
+// Validate checks the field values on LatencyStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LatencyStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LatencyStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LatencyStatsMultiError, or
+// nil if none found.
+func (m *LatencyStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LatencyStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Op
+
+	// no validation rules for Count
+
+	// no validation rules for Avg
+
+	// no validation rules for P50
+
+	// no validation rules for P90
+
+	// no validation rules for P95
+
+	// no validation rules for P99
+
+	// no validation rules for P999
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return LatencyStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// LatencyStatsMultiError is an error wrapping multiple validation errors
+// returned by LatencyStats.ValidateAll() if the designated constraints aren't met.
+type LatencyStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LatencyStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LatencyStatsMultiError) AllErrors() []error { return m }
+
+// LatencyStatsValidationError is the validation error returned by
+// LatencyStats.Validate if the designated constraints aren't met.
+type LatencyStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LatencyStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LatencyStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LatencyStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LatencyStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LatencyStatsValidationError) ErrorName() string { return "LatencyStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LatencyStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLatencyStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LatencyStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LatencyStatsValidationError{}
+
+// Validate checks the field values on QueueEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueueEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueueEntryMultiError, or
+// nil if none found.
+func (m *QueueEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Position
+
+	// no validation rules for TaskId
+
+	// no validation rules for GameId
+
+	// no validation rules for Priority
+
+	// no validation rules for MemberCount
+
+	// no validation rules for Description
 
-	if m.GetPurchase() < 0 {
-		err := BetOrderConfigValidationError{
-			field:  "Purchase",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for CreatedAt
+
+	// no validation rules for EtaSec
 
 	if len(errors) > 0 {
-		return BetOrderConfigMultiError(errors)
+		return QueueEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// BetOrderConfigMultiError is an error wrapping multiple validation errors
-// returned by BetOrderConfig.ValidateAll() if the designated constraints
-// aren't met.
-type BetOrderConfigMultiError []error
+// QueueEntryMultiError is an error wrapping multiple validation errors
+// returned by QueueEntry.ValidateAll() if the designated constraints aren't met.
+type QueueEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BetOrderConfigMultiError) Error() string {
+func (m QueueEntryMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2560,11 +8283,11 @@ func (m BetOrderConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BetOrderConfigMultiError) AllErrors() []error { return m }
+func (m QueueEntryMultiError) AllErrors() []error { return m }
 
-// BetOrderConfigValidationError is the validation error returned by
-// BetOrderConfig.Validate if the designated constraints aren't met.
-type BetOrderConfigValidationError struct {
+// QueueEntryValidationError is the validation error returned by
+// QueueEntry.Validate if the designated constraints aren't met.
+type QueueEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2572,22 +8295,22 @@ type BetOrderConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e BetOrderConfigValidationError) Field() string { return e.field }
+func (e QueueEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BetOrderConfigValidationError) Reason() string { return e.reason }
+func (e QueueEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BetOrderConfigValidationError) Cause() error { return e.cause }
+func (e QueueEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BetOrderConfigValidationError) Key() bool { return e.key }
+func (e QueueEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BetOrderConfigValidationError) ErrorName() string { return "BetOrderConfigValidationError" }
+func (e QueueEntryValidationError) ErrorName() string { return "QueueEntryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BetOrderConfigValidationError) Error() string {
+func (e QueueEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2599,14 +8322,14 @@ func (e BetOrderConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBetOrderConfig.%s: %s%s",
+		"invalid %sQueueEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BetOrderConfigValidationError{}
+var _ error = QueueEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -2614,87 +8337,142 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BetOrderConfigValidationError{}
+} = QueueEntryValidationError{}
 
-// Validate checks the field values on Task with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Task) Validate() error {
+// Validate checks the field values on Schedule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Schedule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Task with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TaskMultiError, or nil if none found.
-func (m *Task) ValidateAll() error {
+// ValidateAll checks the field values on Schedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleMultiError, or nil
+// if none found.
+func (m *Schedule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Task) validate(all bool) error {
+func (m *Schedule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TaskId
+	// no validation rules for ScheduleId
 
-	// no validation rules for Description
+	// no validation rules for Name
 
-	// no validation rules for Status
+	// no validation rules for Cron
 
-	// no validation rules for Process
+	// no validation rules for CreatedAt
 
-	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TaskValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for LastRunAt
+
+	// no validation rules for NextRunAt
+
+	switch v := m.Template.(type) {
+	case *Schedule_Task:
+		if v == nil {
+			err := ScheduleValidationError{
+				field:  "Template",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTask()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScheduleValidationError{
+						field:  "Task",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScheduleValidationError{
+						field:  "Task",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TaskValidationError{
-					field:  "Config",
+				return ScheduleValidationError{
+					field:  "Task",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TaskValidationError{
-				field:  "Config",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	case *Schedule_Bench:
+		if v == nil {
+			err := ScheduleValidationError{
+				field:  "Template",
+				reason: "oneof value cannot be a typed-nil",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
-
-	// no validation rules for RecordUrl
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for StartAt
+		if all {
+			switch v := interface{}(m.GetBench()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScheduleValidationError{
+						field:  "Bench",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScheduleValidationError{
+						field:  "Bench",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBench()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScheduleValidationError{
+					field:  "Bench",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for FinishAt
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return TaskMultiError(errors)
+		return ScheduleMultiError(errors)
 	}
 
 	return nil
 }
 
-// TaskMultiError is an error wrapping multiple validation errors returned by
-// Task.ValidateAll() if the designated constraints aren't met.
-type TaskMultiError []error
+// ScheduleMultiError is an error wrapping multiple validation errors returned
+// by Schedule.ValidateAll() if the designated constraints aren't met.
+type ScheduleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TaskMultiError) Error() string {
+func (m ScheduleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2703,11 +8481,11 @@ func (m TaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TaskMultiError) AllErrors() []error { return m }
+func (m ScheduleMultiError) AllErrors() []error { return m }
 
-// TaskValidationError is the validation error returned by Task.Validate if the
-// designated constraints aren't met.
-type TaskValidationError struct {
+// ScheduleValidationError is the validation error returned by
+// Schedule.Validate if the designated constraints aren't met.
+type ScheduleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2715,22 +8493,22 @@ type TaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskValidationError) Field() string { return e.field }
+func (e ScheduleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskValidationError) Reason() string { return e.reason }
+func (e ScheduleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskValidationError) Cause() error { return e.cause }
+func (e ScheduleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskValidationError) Key() bool { return e.key }
+func (e ScheduleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskValidationError) ErrorName() string { return "TaskValidationError" }
+func (e ScheduleValidationError) ErrorName() string { return "ScheduleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TaskValidationError) Error() string {
+func (e ScheduleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2742,14 +8520,14 @@ func (e TaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTask.%s: %s%s",
+		"invalid %sSchedule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskValidationError{}
+var _ error = ScheduleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2757,86 +8535,154 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskValidationError{}
+} = ScheduleValidationError{}
 
-// Validate checks the field values on TaskCompletionReport with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TaskCompletionReport) Validate() error {
+// Validate checks the field values on ErrorClass with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorClass) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TaskCompletionReport with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TaskCompletionReportMultiError, or nil if none found.
-func (m *TaskCompletionReport) ValidateAll() error {
+// ValidateAll checks the field values on ErrorClass with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorClassMultiError, or
+// nil if none found.
+func (m *ErrorClass) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TaskCompletionReport) validate(all bool) error {
+func (m *ErrorClass) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TaskId
+	// no validation rules for Op
 
-	// no validation rules for GameId
+	// no validation rules for Cause
 
-	// no validation rules for GameName
+	// no validation rules for Count
 
-	// no validation rules for Process
+	if len(errors) > 0 {
+		return ErrorClassMultiError(errors)
+	}
 
-	// no validation rules for Target
+	return nil
+}
 
-	// no validation rules for Step
+// ErrorClassMultiError is an error wrapping multiple validation errors
+// returned by ErrorClass.ValidateAll() if the designated constraints aren't met.
+type ErrorClassMultiError []error
 
-	// no validation rules for Duration
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorClassMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for Qps
+// AllErrors returns a list of validation violation errors.
+func (m ErrorClassMultiError) AllErrors() []error { return m }
 
-	// no validation rules for AvgLatency
+// ErrorClassValidationError is the validation error returned by
+// ErrorClass.Validate if the designated constraints aren't met.
+type ErrorClassValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for OrderCount
+// Field function returns field value.
+func (e ErrorClassValidationError) Field() string { return e.field }
 
-	// no validation rules for TotalBet
+// Reason function returns reason value.
+func (e ErrorClassValidationError) Reason() string { return e.reason }
 
-	// no validation rules for TotalWin
+// Cause function returns cause value.
+func (e ErrorClassValidationError) Cause() error { return e.cause }
 
-	// no validation rules for RtpPct
+// Key function returns key value.
+func (e ErrorClassValidationError) Key() bool { return e.key }
 
-	// no validation rules for ActiveMembers
+// ErrorName returns error name.
+func (e ErrorClassValidationError) ErrorName() string { return "ErrorClassValidationError" }
 
-	// no validation rules for Completed
+// Error satisfies the builtin error interface
+func (e ErrorClassValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for Failed
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for FailedReqs
+	return fmt.Sprintf(
+		"invalid %sErrorClass.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for ProgressPct
+var _ error = ErrorClassValidationError{}
 
-	// no validation rules for Url
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorClassValidationError{}
 
-	// no validation rules for OrderWarning
+// Validate checks the field values on ErrorSample with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorSample) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for BonusStep
+// ValidateAll checks the field values on ErrorSample with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorSampleMultiError, or
+// nil if none found.
+func (m *ErrorSample) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorSample) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Time
+
+	// no validation rules for Member
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return TaskCompletionReportMultiError(errors)
+		return ErrorSampleMultiError(errors)
 	}
 
 	return nil
 }
 
-// TaskCompletionReportMultiError is an error wrapping multiple validation
-// errors returned by TaskCompletionReport.ValidateAll() if the designated
-// constraints aren't met.
-type TaskCompletionReportMultiError []error
+// ErrorSampleMultiError is an error wrapping multiple validation errors
+// returned by ErrorSample.ValidateAll() if the designated constraints aren't met.
+type ErrorSampleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TaskCompletionReportMultiError) Error() string {
+func (m ErrorSampleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2845,11 +8691,11 @@ func (m TaskCompletionReportMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TaskCompletionReportMultiError) AllErrors() []error { return m }
+func (m ErrorSampleMultiError) AllErrors() []error { return m }
 
-// TaskCompletionReportValidationError is the validation error returned by
-// TaskCompletionReport.Validate if the designated constraints aren't met.
-type TaskCompletionReportValidationError struct {
+// ErrorSampleValidationError is the validation error returned by
+// ErrorSample.Validate if the designated constraints aren't met.
+type ErrorSampleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2857,24 +8703,22 @@ type TaskCompletionReportValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskCompletionReportValidationError) Field() string { return e.field }
+func (e ErrorSampleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskCompletionReportValidationError) Reason() string { return e.reason }
+func (e ErrorSampleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskCompletionReportValidationError) Cause() error { return e.cause }
+func (e ErrorSampleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskCompletionReportValidationError) Key() bool { return e.key }
+func (e ErrorSampleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskCompletionReportValidationError) ErrorName() string {
-	return "TaskCompletionReportValidationError"
-}
+func (e ErrorSampleValidationError) ErrorName() string { return "ErrorSampleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TaskCompletionReportValidationError) Error() string {
+func (e ErrorSampleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2886,14 +8730,14 @@ func (e TaskCompletionReportValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTaskCompletionReport.%s: %s%s",
+		"invalid %sErrorSample.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskCompletionReportValidationError{}
+var _ error = ErrorSampleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2901,4 +8745,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskCompletionReportValidationError{}
+} = ErrorSampleValidationError{}
@@ -20,16 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StressService_PingReq_FullMethodName    = "/stress.v1.StressService/PingReq"
-	StressService_ListGames_FullMethodName  = "/stress.v1.StressService/ListGames"
-	StressService_ListTasks_FullMethodName  = "/stress.v1.StressService/ListTasks"
-	StressService_CreateTask_FullMethodName = "/stress.v1.StressService/CreateTask"
-	StressService_TaskInfo_FullMethodName   = "/stress.v1.StressService/TaskInfo"
-	StressService_DeleteTask_FullMethodName = "/stress.v1.StressService/DeleteTask"
-	StressService_CancelTask_FullMethodName = "/stress.v1.StressService/CancelTask"
-	StressService_GetRecord_FullMethodName  = "/stress.v1.StressService/GetRecord"
-	StressService_Cleanup_FullMethodName    = "/stress.v1.StressService/Cleanup"
-	StressService_Bench_FullMethodName      = "/stress.v1.StressService/Bench"
+	StressService_PingReq_FullMethodName        = "/stress.v1.StressService/PingReq"
+	StressService_ListGames_FullMethodName      = "/stress.v1.StressService/ListGames"
+	StressService_ReloadGames_FullMethodName    = "/stress.v1.StressService/ReloadGames"
+	StressService_ListTasks_FullMethodName      = "/stress.v1.StressService/ListTasks"
+	StressService_CreateTask_FullMethodName     = "/stress.v1.StressService/CreateTask"
+	StressService_TaskInfo_FullMethodName       = "/stress.v1.StressService/TaskInfo"
+	StressService_DeleteTask_FullMethodName     = "/stress.v1.StressService/DeleteTask"
+	StressService_CancelTask_FullMethodName     = "/stress.v1.StressService/CancelTask"
+	StressService_PauseTask_FullMethodName      = "/stress.v1.StressService/PauseTask"
+	StressService_ResumeTask_FullMethodName     = "/stress.v1.StressService/ResumeTask"
+	StressService_ListSessions_FullMethodName   = "/stress.v1.StressService/ListSessions"
+	StressService_SmokeTest_FullMethodName      = "/stress.v1.StressService/SmokeTest"
+	StressService_WatchTask_FullMethodName      = "/stress.v1.StressService/WatchTask"
+	StressService_GetRecord_FullMethodName      = "/stress.v1.StressService/GetRecord"
+	StressService_Cleanup_FullMethodName        = "/stress.v1.StressService/Cleanup"
+	StressService_Bench_FullMethodName          = "/stress.v1.StressService/Bench"
+	StressService_ListQueue_FullMethodName      = "/stress.v1.StressService/ListQueue"
+	StressService_MoveTask_FullMethodName       = "/stress.v1.StressService/MoveTask"
+	StressService_PauseQueue_FullMethodName     = "/stress.v1.StressService/PauseQueue"
+	StressService_ResumeQueue_FullMethodName    = "/stress.v1.StressService/ResumeQueue"
+	StressService_CreateSchedule_FullMethodName = "/stress.v1.StressService/CreateSchedule"
+	StressService_ListSchedules_FullMethodName  = "/stress.v1.StressService/ListSchedules"
+	StressService_DeleteSchedule_FullMethodName = "/stress.v1.StressService/DeleteSchedule"
 )
 
 // StressServiceClient is the client API for StressService service.
@@ -40,6 +53,8 @@ type StressServiceClient interface {
 	PingReq(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingReply, error)
 	// 获取游戏列表
 	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	// 重新加载声明式游戏定义（stress.game_specs），全部校验通过才生效
+	ReloadGames(ctx context.Context, in *ReloadGamesRequest, opts ...grpc.CallOption) (*ReloadGamesResponse, error)
 	// 获取任务列表
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	// 创建压测任务
@@ -50,12 +65,36 @@ type StressServiceClient interface {
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 取消任务
 	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*CancelTaskResponse, error)
+	// 暂停运行中的任务
+	PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error)
+	// 恢复已暂停的任务
+	ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error)
+	// 查看任务内各成员会话（状态、进度、重试、最近错误）
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// 冒烟测试：单成员走完 launch → login → betorder → betbonus，返回原始报文与游戏判定结果
+	SmokeTest(ctx context.Context, in *SmokeTestRequest, opts ...grpc.CallOption) (*SmokeTestResponse, error)
+	// 订阅任务进度（服务端流：快照、状态变更、错误样本）；HTTP 使用 SSE 端点 GET /stress/WatchTask?task_id=xxx&interval_ms=1000
+	WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchTaskEvent], error)
 	// 获取任务结果
 	GetRecord(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error)
 	// 清理 Redis 和 MySQL 订单数据
 	Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error)
 	// 批量压测启动
 	Bench(ctx context.Context, in *BenchRequest, opts ...grpc.CallOption) (*BenchResponse, error)
+	// 查看调度队列（排队位置 + 预计开始时间）
+	ListQueue(ctx context.Context, in *ListQueueRequest, opts ...grpc.CallOption) (*ListQueueResponse, error)
+	// 调整排队任务位置（移到队首/队尾）
+	MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...grpc.CallOption) (*MoveTaskResponse, error)
+	// 暂停调度（运行中任务不受影响）
+	PauseQueue(ctx context.Context, in *PauseQueueRequest, opts ...grpc.CallOption) (*QueueStateResponse, error)
+	// 恢复调度
+	ResumeQueue(ctx context.Context, in *ResumeQueueRequest, opts ...grpc.CallOption) (*QueueStateResponse, error)
+	// 创建定时任务
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// 获取定时任务列表
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// 删除定时任务
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
 }
 
 type stressServiceClient struct {
@@ -86,6 +125,16 @@ func (c *stressServiceClient) ListGames(ctx context.Context, in *ListGamesReques
 	return out, nil
 }
 
+func (c *stressServiceClient) ReloadGames(ctx context.Context, in *ReloadGamesRequest, opts ...grpc.CallOption) (*ReloadGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadGamesResponse)
+	err := c.cc.Invoke(ctx, StressService_ReloadGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stressServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTasksResponse)
@@ -136,6 +185,65 @@ func (c *stressServiceClient) CancelTask(ctx context.Context, in *CancelTaskRequ
 	return out, nil
 }
 
+func (c *stressServiceClient) PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseTaskResponse)
+	err := c.cc.Invoke(ctx, StressService_PauseTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeTaskResponse)
+	err := c.cc.Invoke(ctx, StressService_ResumeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, StressService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) SmokeTest(ctx context.Context, in *SmokeTestRequest, opts ...grpc.CallOption) (*SmokeTestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SmokeTestResponse)
+	err := c.cc.Invoke(ctx, StressService_SmokeTest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchTaskEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StressService_ServiceDesc.Streams[0], StressService_WatchTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTaskRequest, WatchTaskEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StressService_WatchTaskClient = grpc.ServerStreamingClient[WatchTaskEvent]
+
 func (c *stressServiceClient) GetRecord(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordResponse)
@@ -166,6 +274,76 @@ func (c *stressServiceClient) Bench(ctx context.Context, in *BenchRequest, opts
 	return out, nil
 }
 
+func (c *stressServiceClient) ListQueue(ctx context.Context, in *ListQueueRequest, opts ...grpc.CallOption) (*ListQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueueResponse)
+	err := c.cc.Invoke(ctx, StressService_ListQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...grpc.CallOption) (*MoveTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveTaskResponse)
+	err := c.cc.Invoke(ctx, StressService_MoveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) PauseQueue(ctx context.Context, in *PauseQueueRequest, opts ...grpc.CallOption) (*QueueStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueStateResponse)
+	err := c.cc.Invoke(ctx, StressService_PauseQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) ResumeQueue(ctx context.Context, in *ResumeQueueRequest, opts ...grpc.CallOption) (*QueueStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueStateResponse)
+	err := c.cc.Invoke(ctx, StressService_ResumeQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, StressService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, StressService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stressServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, StressService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StressServiceServer is the server API for StressService service.
 // All implementations must embed UnimplementedStressServiceServer
 // for forward compatibility.
@@ -174,6 +352,8 @@ type StressServiceServer interface {
 	PingReq(context.Context, *PingRequest) (*PingReply, error)
 	// 获取游戏列表
 	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	// 重新加载声明式游戏定义（stress.game_specs），全部校验通过才生效
+	ReloadGames(context.Context, *ReloadGamesRequest) (*ReloadGamesResponse, error)
 	// 获取任务列表
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	// 创建压测任务
@@ -184,12 +364,36 @@ type StressServiceServer interface {
 	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
 	// 取消任务
 	CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error)
+	// 暂停运行中的任务
+	PauseTask(context.Context, *PauseTaskRequest) (*PauseTaskResponse, error)
+	// 恢复已暂停的任务
+	ResumeTask(context.Context, *ResumeTaskRequest) (*ResumeTaskResponse, error)
+	// 查看任务内各成员会话（状态、进度、重试、最近错误）
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// 冒烟测试：单成员走完 launch → login → betorder → betbonus，返回原始报文与游戏判定结果
+	SmokeTest(context.Context, *SmokeTestRequest) (*SmokeTestResponse, error)
+	// 订阅任务进度（服务端流：快照、状态变更、错误样本）；HTTP 使用 SSE 端点 GET /stress/WatchTask?task_id=xxx&interval_ms=1000
+	WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[WatchTaskEvent]) error
 	// 获取任务结果
 	GetRecord(context.Context, *RecordRequest) (*RecordResponse, error)
 	// 清理 Redis 和 MySQL 订单数据
 	Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error)
 	// 批量压测启动
 	Bench(context.Context, *BenchRequest) (*BenchResponse, error)
+	// 查看调度队列（排队位置 + 预计开始时间）
+	ListQueue(context.Context, *ListQueueRequest) (*ListQueueResponse, error)
+	// 调整排队任务位置（移到队首/队尾）
+	MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error)
+	// 暂停调度（运行中任务不受影响）
+	PauseQueue(context.Context, *PauseQueueRequest) (*QueueStateResponse, error)
+	// 恢复调度
+	ResumeQueue(context.Context, *ResumeQueueRequest) (*QueueStateResponse, error)
+	// 创建定时任务
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// 获取定时任务列表
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// 删除定时任务
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
 	mustEmbedUnimplementedStressServiceServer()
 }
 
@@ -206,6 +410,9 @@ func (UnimplementedStressServiceServer) PingReq(context.Context, *PingRequest) (
 func (UnimplementedStressServiceServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListGames not implemented")
 }
+func (UnimplementedStressServiceServer) ReloadGames(context.Context, *ReloadGamesRequest) (*ReloadGamesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadGames not implemented")
+}
 func (UnimplementedStressServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
 }
@@ -221,6 +428,21 @@ func (UnimplementedStressServiceServer) DeleteTask(context.Context, *DeleteTaskR
 func (UnimplementedStressServiceServer) CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelTask not implemented")
 }
+func (UnimplementedStressServiceServer) PauseTask(context.Context, *PauseTaskRequest) (*PauseTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseTask not implemented")
+}
+func (UnimplementedStressServiceServer) ResumeTask(context.Context, *ResumeTaskRequest) (*ResumeTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeTask not implemented")
+}
+func (UnimplementedStressServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedStressServiceServer) SmokeTest(context.Context, *SmokeTestRequest) (*SmokeTestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SmokeTest not implemented")
+}
+func (UnimplementedStressServiceServer) WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[WatchTaskEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchTask not implemented")
+}
 func (UnimplementedStressServiceServer) GetRecord(context.Context, *RecordRequest) (*RecordResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRecord not implemented")
 }
@@ -230,6 +452,27 @@ func (UnimplementedStressServiceServer) Cleanup(context.Context, *CleanupRequest
 func (UnimplementedStressServiceServer) Bench(context.Context, *BenchRequest) (*BenchResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Bench not implemented")
 }
+func (UnimplementedStressServiceServer) ListQueue(context.Context, *ListQueueRequest) (*ListQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueue not implemented")
+}
+func (UnimplementedStressServiceServer) MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveTask not implemented")
+}
+func (UnimplementedStressServiceServer) PauseQueue(context.Context, *PauseQueueRequest) (*QueueStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseQueue not implemented")
+}
+func (UnimplementedStressServiceServer) ResumeQueue(context.Context, *ResumeQueueRequest) (*QueueStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeQueue not implemented")
+}
+func (UnimplementedStressServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedStressServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedStressServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
 func (UnimplementedStressServiceServer) mustEmbedUnimplementedStressServiceServer() {}
 func (UnimplementedStressServiceServer) testEmbeddedByValue()                       {}
 
@@ -287,6 +530,24 @@ func _StressService_ListGames_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StressService_ReloadGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).ReloadGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_ReloadGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).ReloadGames(ctx, req.(*ReloadGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StressService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTasksRequest)
 	if err := dec(in); err != nil {
@@ -377,6 +638,89 @@ func _StressService_CancelTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StressService_PauseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).PauseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_PauseTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).PauseTask(ctx, req.(*PauseTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_ResumeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).ResumeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_ResumeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).ResumeTask(ctx, req.(*ResumeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_SmokeTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmokeTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).SmokeTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_SmokeTest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).SmokeTest(ctx, req.(*SmokeTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_WatchTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StressServiceServer).WatchTask(m, &grpc.GenericServerStream[WatchTaskRequest, WatchTaskEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StressService_WatchTaskServer = grpc.ServerStreamingServer[WatchTaskEvent]
+
 func _StressService_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordRequest)
 	if err := dec(in); err != nil {
@@ -431,6 +775,132 @@ func _StressService_Bench_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StressService_ListQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).ListQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_ListQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).ListQueue(ctx, req.(*ListQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_MoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).MoveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_MoveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).MoveTask(ctx, req.(*MoveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_PauseQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).PauseQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_PauseQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).PauseQueue(ctx, req.(*PauseQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_ResumeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).ResumeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_ResumeQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).ResumeQueue(ctx, req.(*ResumeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StressService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StressServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StressService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StressServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StressService_ServiceDesc is the grpc.ServiceDesc for StressService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -446,6 +916,10 @@ var StressService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGames",
 			Handler:    _StressService_ListGames_Handler,
 		},
+		{
+			MethodName: "ReloadGames",
+			Handler:    _StressService_ReloadGames_Handler,
+		},
 		{
 			MethodName: "ListTasks",
 			Handler:    _StressService_ListTasks_Handler,
@@ -466,6 +940,22 @@ var StressService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTask",
 			Handler:    _StressService_CancelTask_Handler,
 		},
+		{
+			MethodName: "PauseTask",
+			Handler:    _StressService_PauseTask_Handler,
+		},
+		{
+			MethodName: "ResumeTask",
+			Handler:    _StressService_ResumeTask_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _StressService_ListSessions_Handler,
+		},
+		{
+			MethodName: "SmokeTest",
+			Handler:    _StressService_SmokeTest_Handler,
+		},
 		{
 			MethodName: "GetRecord",
 			Handler:    _StressService_GetRecord_Handler,
@@ -478,7 +968,41 @@ var StressService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Bench",
 			Handler:    _StressService_Bench_Handler,
 		},
+		{
+			MethodName: "ListQueue",
+			Handler:    _StressService_ListQueue_Handler,
+		},
+		{
+			MethodName: "MoveTask",
+			Handler:    _StressService_MoveTask_Handler,
+		},
+		{
+			MethodName: "PauseQueue",
+			Handler:    _StressService_PauseQueue_Handler,
+		},
+		{
+			MethodName: "ResumeQueue",
+			Handler:    _StressService_ResumeQueue_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _StressService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _StressService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _StressService_DeleteSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTask",
+			Handler:       _StressService_WatchTask_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "stress/v1/stress.proto",
 }
@@ -23,12 +23,24 @@ const _ = http.SupportPackageIsVersion1
 const OperationStressServiceBench = "/stress.v1.StressService/Bench"
 const OperationStressServiceCancelTask = "/stress.v1.StressService/CancelTask"
 const OperationStressServiceCleanup = "/stress.v1.StressService/Cleanup"
+const OperationStressServiceCreateSchedule = "/stress.v1.StressService/CreateSchedule"
 const OperationStressServiceCreateTask = "/stress.v1.StressService/CreateTask"
+const OperationStressServiceDeleteSchedule = "/stress.v1.StressService/DeleteSchedule"
 const OperationStressServiceDeleteTask = "/stress.v1.StressService/DeleteTask"
 const OperationStressServiceGetRecord = "/stress.v1.StressService/GetRecord"
 const OperationStressServiceListGames = "/stress.v1.StressService/ListGames"
+const OperationStressServiceListQueue = "/stress.v1.StressService/ListQueue"
+const OperationStressServiceListSchedules = "/stress.v1.StressService/ListSchedules"
+const OperationStressServiceListSessions = "/stress.v1.StressService/ListSessions"
 const OperationStressServiceListTasks = "/stress.v1.StressService/ListTasks"
+const OperationStressServiceMoveTask = "/stress.v1.StressService/MoveTask"
+const OperationStressServicePauseQueue = "/stress.v1.StressService/PauseQueue"
+const OperationStressServicePauseTask = "/stress.v1.StressService/PauseTask"
 const OperationStressServicePingReq = "/stress.v1.StressService/PingReq"
+const OperationStressServiceReloadGames = "/stress.v1.StressService/ReloadGames"
+const OperationStressServiceResumeQueue = "/stress.v1.StressService/ResumeQueue"
+const OperationStressServiceResumeTask = "/stress.v1.StressService/ResumeTask"
+const OperationStressServiceSmokeTest = "/stress.v1.StressService/SmokeTest"
 const OperationStressServiceTaskInfo = "/stress.v1.StressService/TaskInfo"
 
 type StressServiceHTTPServer interface {
@@ -38,18 +50,42 @@ type StressServiceHTTPServer interface {
 	CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error)
 	// Cleanup 清理 Redis 和 MySQL 订单数据
 	Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error)
+	// CreateSchedule 创建定时任务
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
 	// CreateTask 创建压测任务
 	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
+	// DeleteSchedule 删除定时任务
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
 	// DeleteTask 删除任务
 	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
 	// GetRecord 获取任务结果
 	GetRecord(context.Context, *RecordRequest) (*RecordResponse, error)
 	// ListGames 获取游戏列表
 	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	// ListQueue 查看调度队列（排队位置 + 预计开始时间）
+	ListQueue(context.Context, *ListQueueRequest) (*ListQueueResponse, error)
+	// ListSchedules 获取定时任务列表
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// ListSessions 查看任务内各成员会话（状态、进度、重试、最近错误）
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
 	// ListTasks 获取任务列表
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// MoveTask 调整排队任务位置（移到队首/队尾）
+	MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error)
+	// PauseQueue 暂停调度（运行中任务不受影响）
+	PauseQueue(context.Context, *PauseQueueRequest) (*QueueStateResponse, error)
+	// PauseTask 暂停运行中的任务
+	PauseTask(context.Context, *PauseTaskRequest) (*PauseTaskResponse, error)
 	// PingReq Sends a greeting
 	PingReq(context.Context, *PingRequest) (*PingReply, error)
+	// ReloadGames 重新加载声明式游戏定义（stress.game_specs），全部校验通过才生效
+	ReloadGames(context.Context, *ReloadGamesRequest) (*ReloadGamesResponse, error)
+	// ResumeQueue 恢复调度
+	ResumeQueue(context.Context, *ResumeQueueRequest) (*QueueStateResponse, error)
+	// ResumeTask 恢复已暂停的任务
+	ResumeTask(context.Context, *ResumeTaskRequest) (*ResumeTaskResponse, error)
+	// SmokeTest 冒烟测试：单成员走完 launch → login → betorder → betbonus，返回原始报文与游戏判定结果
+	SmokeTest(context.Context, *SmokeTestRequest) (*SmokeTestResponse, error)
 	// TaskInfo 获取任务详情
 	TaskInfo(context.Context, *TaskInfoRequest) (*TaskInfoResponse, error)
 }
@@ -58,14 +94,26 @@ func RegisterStressServiceHTTPServer(s *http.Server, srv StressServiceHTTPServer
 	r := s.Route("/")
 	r.GET("/stress/ping/{name}", _StressService_PingReq0_HTTP_Handler(srv))
 	r.POST("/stress/ListGames", _StressService_ListGames0_HTTP_Handler(srv))
+	r.POST("/stress/ReloadGames", _StressService_ReloadGames0_HTTP_Handler(srv))
 	r.POST("/stress/ListTasks", _StressService_ListTasks0_HTTP_Handler(srv))
 	r.POST("/stress/CreateTask", _StressService_CreateTask0_HTTP_Handler(srv))
 	r.POST("/stress/TaskInfo", _StressService_TaskInfo0_HTTP_Handler(srv))
 	r.POST("/stress/DeleteTask", _StressService_DeleteTask0_HTTP_Handler(srv))
 	r.POST("/stress/CancelTask", _StressService_CancelTask0_HTTP_Handler(srv))
+	r.POST("/stress/PauseTask", _StressService_PauseTask0_HTTP_Handler(srv))
+	r.POST("/stress/ResumeTask", _StressService_ResumeTask0_HTTP_Handler(srv))
+	r.POST("/stress/ListSessions", _StressService_ListSessions0_HTTP_Handler(srv))
+	r.POST("/stress/SmokeTest", _StressService_SmokeTest0_HTTP_Handler(srv))
 	r.POST("/stress/TaskRecord", _StressService_GetRecord0_HTTP_Handler(srv))
 	r.POST("/stress/Cleanup", _StressService_Cleanup0_HTTP_Handler(srv))
 	r.POST("/stress/Bench", _StressService_Bench0_HTTP_Handler(srv))
+	r.POST("/stress/ListQueue", _StressService_ListQueue0_HTTP_Handler(srv))
+	r.POST("/stress/MoveTask", _StressService_MoveTask0_HTTP_Handler(srv))
+	r.POST("/stress/PauseQueue", _StressService_PauseQueue0_HTTP_Handler(srv))
+	r.POST("/stress/ResumeQueue", _StressService_ResumeQueue0_HTTP_Handler(srv))
+	r.POST("/stress/CreateSchedule", _StressService_CreateSchedule0_HTTP_Handler(srv))
+	r.POST("/stress/ListSchedules", _StressService_ListSchedules0_HTTP_Handler(srv))
+	r.POST("/stress/DeleteSchedule", _StressService_DeleteSchedule0_HTTP_Handler(srv))
 }
 
 func _StressService_PingReq0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
@@ -112,6 +160,28 @@ func _StressService_ListGames0_HTTP_Handler(srv StressServiceHTTPServer) func(ct
 	}
 }
 
+func _StressService_ReloadGames0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReloadGamesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceReloadGames)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReloadGames(ctx, req.(*ReloadGamesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReloadGamesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _StressService_ListTasks0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListTasksRequest
@@ -222,6 +292,94 @@ func _StressService_CancelTask0_HTTP_Handler(srv StressServiceHTTPServer) func(c
 	}
 }
 
+func _StressService_PauseTask0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PauseTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServicePauseTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseTask(ctx, req.(*PauseTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PauseTaskResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_ResumeTask0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResumeTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceResumeTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResumeTask(ctx, req.(*ResumeTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResumeTaskResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_ListSessions0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*ListSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_SmokeTest0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SmokeTestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceSmokeTest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SmokeTest(ctx, req.(*SmokeTestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SmokeTestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _StressService_GetRecord0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in RecordRequest
@@ -288,6 +446,160 @@ func _StressService_Bench0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx ht
 	}
 }
 
+func _StressService_ListQueue0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListQueueRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceListQueue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListQueue(ctx, req.(*ListQueueRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListQueueResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_MoveTask0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MoveTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceMoveTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MoveTask(ctx, req.(*MoveTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MoveTaskResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_PauseQueue0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PauseQueueRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServicePauseQueue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseQueue(ctx, req.(*PauseQueueRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QueueStateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_ResumeQueue0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResumeQueueRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceResumeQueue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResumeQueue(ctx, req.(*ResumeQueueRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QueueStateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_CreateSchedule0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceCreateSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSchedule(ctx, req.(*CreateScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateScheduleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_ListSchedules0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSchedulesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceListSchedules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSchedules(ctx, req.(*ListSchedulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSchedulesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StressService_DeleteSchedule0_HTTP_Handler(srv StressServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStressServiceDeleteSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteScheduleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type StressServiceHTTPClient interface {
 	// Bench 批量压测启动
 	Bench(ctx context.Context, req *BenchRequest, opts ...http.CallOption) (rsp *BenchResponse, err error)
@@ -295,18 +607,42 @@ type StressServiceHTTPClient interface {
 	CancelTask(ctx context.Context, req *CancelTaskRequest, opts ...http.CallOption) (rsp *CancelTaskResponse, err error)
 	// Cleanup 清理 Redis 和 MySQL 订单数据
 	Cleanup(ctx context.Context, req *CleanupRequest, opts ...http.CallOption) (rsp *CleanupResponse, err error)
+	// CreateSchedule 创建定时任务
+	CreateSchedule(ctx context.Context, req *CreateScheduleRequest, opts ...http.CallOption) (rsp *CreateScheduleResponse, err error)
 	// CreateTask 创建压测任务
 	CreateTask(ctx context.Context, req *CreateTaskRequest, opts ...http.CallOption) (rsp *CreateTaskResponse, err error)
+	// DeleteSchedule 删除定时任务
+	DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest, opts ...http.CallOption) (rsp *DeleteScheduleResponse, err error)
 	// DeleteTask 删除任务
 	DeleteTask(ctx context.Context, req *DeleteTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// GetRecord 获取任务结果
 	GetRecord(ctx context.Context, req *RecordRequest, opts ...http.CallOption) (rsp *RecordResponse, err error)
 	// ListGames 获取游戏列表
 	ListGames(ctx context.Context, req *ListGamesRequest, opts ...http.CallOption) (rsp *ListGamesResponse, err error)
+	// ListQueue 查看调度队列（排队位置 + 预计开始时间）
+	ListQueue(ctx context.Context, req *ListQueueRequest, opts ...http.CallOption) (rsp *ListQueueResponse, err error)
+	// ListSchedules 获取定时任务列表
+	ListSchedules(ctx context.Context, req *ListSchedulesRequest, opts ...http.CallOption) (rsp *ListSchedulesResponse, err error)
+	// ListSessions 查看任务内各成员会话（状态、进度、重试、最近错误）
+	ListSessions(ctx context.Context, req *ListSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsResponse, err error)
 	// ListTasks 获取任务列表
 	ListTasks(ctx context.Context, req *ListTasksRequest, opts ...http.CallOption) (rsp *ListTasksResponse, err error)
+	// MoveTask 调整排队任务位置（移到队首/队尾）
+	MoveTask(ctx context.Context, req *MoveTaskRequest, opts ...http.CallOption) (rsp *MoveTaskResponse, err error)
+	// PauseQueue 暂停调度（运行中任务不受影响）
+	PauseQueue(ctx context.Context, req *PauseQueueRequest, opts ...http.CallOption) (rsp *QueueStateResponse, err error)
+	// PauseTask 暂停运行中的任务
+	PauseTask(ctx context.Context, req *PauseTaskRequest, opts ...http.CallOption) (rsp *PauseTaskResponse, err error)
 	// PingReq Sends a greeting
 	PingReq(ctx context.Context, req *PingRequest, opts ...http.CallOption) (rsp *PingReply, err error)
+	// ReloadGames 重新加载声明式游戏定义（stress.game_specs），全部校验通过才生效
+	ReloadGames(ctx context.Context, req *ReloadGamesRequest, opts ...http.CallOption) (rsp *ReloadGamesResponse, err error)
+	// ResumeQueue 恢复调度
+	ResumeQueue(ctx context.Context, req *ResumeQueueRequest, opts ...http.CallOption) (rsp *QueueStateResponse, err error)
+	// ResumeTask 恢复已暂停的任务
+	ResumeTask(ctx context.Context, req *ResumeTaskRequest, opts ...http.CallOption) (rsp *ResumeTaskResponse, err error)
+	// SmokeTest 冒烟测试：单成员走完 launch → login → betorder → betbonus，返回原始报文与游戏判定结果
+	SmokeTest(ctx context.Context, req *SmokeTestRequest, opts ...http.CallOption) (rsp *SmokeTestResponse, err error)
 	// TaskInfo 获取任务详情
 	TaskInfo(ctx context.Context, req *TaskInfoRequest, opts ...http.CallOption) (rsp *TaskInfoResponse, err error)
 }
@@ -361,6 +697,20 @@ func (c *StressServiceHTTPClientImpl) Cleanup(ctx context.Context, in *CleanupRe
 	return &out, nil
 }
 
+// CreateSchedule 创建定时任务
+func (c *StressServiceHTTPClientImpl) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...http.CallOption) (*CreateScheduleResponse, error) {
+	var out CreateScheduleResponse
+	pattern := "/stress/CreateSchedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceCreateSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateTask 创建压测任务
 func (c *StressServiceHTTPClientImpl) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...http.CallOption) (*CreateTaskResponse, error) {
 	var out CreateTaskResponse
@@ -375,6 +725,20 @@ func (c *StressServiceHTTPClientImpl) CreateTask(ctx context.Context, in *Create
 	return &out, nil
 }
 
+// DeleteSchedule 删除定时任务
+func (c *StressServiceHTTPClientImpl) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...http.CallOption) (*DeleteScheduleResponse, error) {
+	var out DeleteScheduleResponse
+	pattern := "/stress/DeleteSchedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceDeleteSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DeleteTask 删除任务
 func (c *StressServiceHTTPClientImpl) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
@@ -417,6 +781,48 @@ func (c *StressServiceHTTPClientImpl) ListGames(ctx context.Context, in *ListGam
 	return &out, nil
 }
 
+// ListQueue 查看调度队列（排队位置 + 预计开始时间）
+func (c *StressServiceHTTPClientImpl) ListQueue(ctx context.Context, in *ListQueueRequest, opts ...http.CallOption) (*ListQueueResponse, error) {
+	var out ListQueueResponse
+	pattern := "/stress/ListQueue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceListQueue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSchedules 获取定时任务列表
+func (c *StressServiceHTTPClientImpl) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...http.CallOption) (*ListSchedulesResponse, error) {
+	var out ListSchedulesResponse
+	pattern := "/stress/ListSchedules"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceListSchedules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSessions 查看任务内各成员会话（状态、进度、重试、最近错误）
+func (c *StressServiceHTTPClientImpl) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...http.CallOption) (*ListSessionsResponse, error) {
+	var out ListSessionsResponse
+	pattern := "/stress/ListSessions"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListTasks 获取任务列表
 func (c *StressServiceHTTPClientImpl) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...http.CallOption) (*ListTasksResponse, error) {
 	var out ListTasksResponse
@@ -431,6 +837,48 @@ func (c *StressServiceHTTPClientImpl) ListTasks(ctx context.Context, in *ListTas
 	return &out, nil
 }
 
+// MoveTask 调整排队任务位置（移到队首/队尾）
+func (c *StressServiceHTTPClientImpl) MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...http.CallOption) (*MoveTaskResponse, error) {
+	var out MoveTaskResponse
+	pattern := "/stress/MoveTask"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceMoveTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseQueue 暂停调度（运行中任务不受影响）
+func (c *StressServiceHTTPClientImpl) PauseQueue(ctx context.Context, in *PauseQueueRequest, opts ...http.CallOption) (*QueueStateResponse, error) {
+	var out QueueStateResponse
+	pattern := "/stress/PauseQueue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServicePauseQueue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseTask 暂停运行中的任务
+func (c *StressServiceHTTPClientImpl) PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...http.CallOption) (*PauseTaskResponse, error) {
+	var out PauseTaskResponse
+	pattern := "/stress/PauseTask"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServicePauseTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // PingReq Sends a greeting
 func (c *StressServiceHTTPClientImpl) PingReq(ctx context.Context, in *PingRequest, opts ...http.CallOption) (*PingReply, error) {
 	var out PingReply
@@ -445,6 +893,62 @@ func (c *StressServiceHTTPClientImpl) PingReq(ctx context.Context, in *PingReque
 	return &out, nil
 }
 
+// ReloadGames 重新加载声明式游戏定义（stress.game_specs），全部校验通过才生效
+func (c *StressServiceHTTPClientImpl) ReloadGames(ctx context.Context, in *ReloadGamesRequest, opts ...http.CallOption) (*ReloadGamesResponse, error) {
+	var out ReloadGamesResponse
+	pattern := "/stress/ReloadGames"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceReloadGames))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeQueue 恢复调度
+func (c *StressServiceHTTPClientImpl) ResumeQueue(ctx context.Context, in *ResumeQueueRequest, opts ...http.CallOption) (*QueueStateResponse, error) {
+	var out QueueStateResponse
+	pattern := "/stress/ResumeQueue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceResumeQueue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeTask 恢复已暂停的任务
+func (c *StressServiceHTTPClientImpl) ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...http.CallOption) (*ResumeTaskResponse, error) {
+	var out ResumeTaskResponse
+	pattern := "/stress/ResumeTask"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceResumeTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SmokeTest 冒烟测试：单成员走完 launch → login → betorder → betbonus，返回原始报文与游戏判定结果
+func (c *StressServiceHTTPClientImpl) SmokeTest(ctx context.Context, in *SmokeTestRequest, opts ...http.CallOption) (*SmokeTestResponse, error) {
+	var out SmokeTestResponse
+	pattern := "/stress/SmokeTest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStressServiceSmokeTest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // TaskInfo 获取任务详情
 func (c *StressServiceHTTPClientImpl) TaskInfo(ctx context.Context, in *TaskInfoRequest, opts ...http.CallOption) (*TaskInfoResponse, error) {
 	var out TaskInfoResponse
@@ -0,0 +1,81 @@
+// mockplatform 启动模拟游戏平台，供本地演示与 CI 使用：
+// 将压测配置的 stress.launch.launch_url / api_url 指向本服务即可端到端运行任务，
+// websocket 传输时 stress.launch.ws_url 指向 ws://<addr>/ws/game。
+//
+//	go run ./cmd/mockplatform -addr :8825 -conf configs/mockplatform.yaml
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"stress/internal/mockplatform"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const statsInterval = 10 * time.Second
+
+var (
+	flagAddr string
+	flagConf string
+)
+
+func init() {
+	flag.StringVar(&flagAddr, "addr", ":8825", "listen address")
+	flag.StringVar(&flagConf, "conf", "", "mock platform config (yaml/json), empty for defaults")
+}
+
+func main() {
+	flag.Parse()
+	logger := log.NewHelper(log.With(log.DefaultLogger, "app", "mockplatform"))
+
+	var cfg mockplatform.Config
+	if flagConf != "" {
+		c := config.New(config.WithSource(file.NewSource(flagConf)))
+		if err := c.Load(); err != nil {
+			logger.Fatalf("load config: %v", err)
+		}
+		if err := c.Scan(&cfg); err != nil {
+			logger.Fatalf("scan config: %v", err)
+		}
+		defer c.Close()
+	}
+	mock, err := mockplatform.New(cfg)
+	if err != nil {
+		logger.Fatalf("invalid config: %v", err)
+	}
+
+	srv := &http.Server{Addr: flagAddr, Handler: mock}
+	go func() {
+		logger.Infof("mock platform listening on %s", flagAddr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatalf("listen: %v", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ticker := time.NewTicker(statsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			st := mock.Stats()
+			logger.Infof("requests=%v injected=%v orders=%d bet=%.2f win=%.2f", st.Requests, st.Injected, st.Orders, st.TotalBet, st.TotalWin)
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = srv.Shutdown(shutdownCtx)
+			return
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// replay 将录制文件（gzip JSONL，见 TaskConfig.recording）离线回放到游戏插件：
+// 对每条成功的 login / betorder / betbonus 响应重新执行 IGame 判定，
+// 并与录制中同一成员下一条请求的状态对比，输出不一致的记录。
+//
+//	go run ./cmd/replay -file recordings/<task_id>.jsonl.gz [-member m1] [-op betorder] [-redecode] [-v]
+//
+// -specs 指定声明式游戏定义时，同 ID 的定义优先于 Go 实现，可用于验证由插件迁移而来的定义
+// （服务加载 game_specs 时同 ID 视为冲突，只有回放允许覆盖）。
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game"
+	"stress/internal/biz/game/base"
+	"stress/internal/biz/task"
+)
+
+var (
+	flagFile     string
+	flagGame     int64
+	flagMember   string
+	flagOp       string
+	flagRedecode bool
+	flagVerbose  bool
+	flagSpecs    string
+)
+
+func init() {
+	flag.StringVar(&flagFile, "file", "", "recording file path or http(s) URL")
+	flag.Int64Var(&flagGame, "game", 0, "override game id (default: from records)")
+	flag.StringVar(&flagMember, "member", "", "only replay this member")
+	flag.StringVar(&flagOp, "op", "", "only print this op (launch / login / betorder / betbonus)")
+	flag.BoolVar(&flagRedecode, "redecode", false, "decode raw responses again instead of using recorded decoded data")
+	flag.BoolVar(&flagVerbose, "v", false, "print every record, not only mismatches")
+	flag.StringVar(&flagSpecs, "specs", "", "game spec file or directory, specs take precedence over plugins")
+}
+
+// pending 成员上一条记录按回放判定得出的下一状态
+type pending struct {
+	rec  *task.Record
+	next string
+}
+
+type summary struct {
+	records    int
+	replayed   int
+	mismatches int
+	decodeErrs int
+	fired      map[string]int // 判定名 -> 为 true 的次数
+}
+
+func main() {
+	flag.Parse()
+	if flagFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	rd, err := open(flagFile)
+	if err != nil {
+		fail(err)
+	}
+	defer rd.Close()
+
+	sum := &summary{fired: make(map[string]int)}
+	last := make(map[string]*pending)
+	games, err := loadSpecs(flagSpecs)
+	if err != nil {
+		fail(err)
+	}
+
+	err = task.ReadRecords(rd, func(rec *task.Record) error {
+		if flagMember != "" && rec.Member != flagMember {
+			return nil
+		}
+		sum.records++
+
+		// 与上一条的判定对比：下一请求的状态应与判定出的状态一致
+		if p, ok := last[rec.Member]; ok && p.next != "" && p.next != rec.State {
+			sum.mismatches++
+			fmt.Printf("MISMATCH %s: after %s replay expects %s, recorded %s\n",
+				rec.Member, describe(p.rec), p.next, rec.State)
+		}
+		delete(last, rec.Member)
+
+		gameID := rec.GameID
+		if flagGame > 0 {
+			gameID = flagGame
+		}
+		g, ok := games[gameID]
+		if !ok {
+			if g, ok = game.Lookup(gameID); !ok {
+				return fmt.Errorf("game %d not registered", gameID)
+			}
+			games[gameID] = g
+		}
+
+		preds, next, err := replay(g, rec)
+		if err != nil {
+			sum.decodeErrs++
+			fmt.Printf("DECODE %s: %v\n", describe(rec), err)
+			return nil
+		}
+		if preds == nil {
+			return nil
+		}
+		sum.replayed++
+		for _, p := range preds {
+			if p.Fired {
+				sum.fired[p.Name]++
+			}
+		}
+		last[rec.Member] = &pending{rec: rec, next: next}
+		if flagVerbose && (flagOp == "" || flagOp == rec.Op) {
+			fmt.Printf("%s %s\n", describe(rec), formatPredicates(preds))
+		}
+		return nil
+	})
+	if err != nil {
+		fail(err)
+	}
+
+	fmt.Printf("records=%d replayed=%d mismatches=%d decode_errors=%d\n", sum.records, sum.replayed, sum.mismatches, sum.decodeErrs)
+	names := make([]string, 0, len(sum.fired))
+	for name := range sum.fired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("  %s fired %d times\n", name, sum.fired[name])
+	}
+	if sum.mismatches > 0 {
+		os.Exit(1)
+	}
+}
+
+// replay 对成功的响应重新执行判定，返回判定结果与会话应进入的下一状态；
+// 失败的请求（下一状态由重试策略决定）与 launch 返回 nil
+func replay(g base.IGame, rec *task.Record) ([]*v1.Predicate, string, error) {
+	if rec.Error != "" || rec.Op == task.OpLaunch {
+		return nil, "", nil
+	}
+	data := rec.Decoded
+	if flagRedecode {
+		var err error
+		if data, err = task.DecodeResponse(g, rec.Op, []byte(rec.Response)); err != nil {
+			return nil, "", err
+		}
+	}
+
+	betting, bonus := v1.SessionState_SESSION_BETTING.String(), v1.SessionState_SESSION_BONUS_SELECT.String()
+	switch rec.Op {
+	case task.OpLogin:
+		need := g.NeedBetBonus(data)
+		return []*v1.Predicate{{Name: task.PredicateNeedBetBonus, Fired: need}}, pick(need, bonus, betting), nil
+	case task.OpBetOrder:
+		// 实现了 base.SpinParser 的游戏与线上一致，按类型化结果判定
+		spin, err := task.DecodeSpin(g, []byte(rec.Response))
+		if err != nil {
+			return nil, "", err
+		}
+		over, need := g.IsSpinOver(data), g.NeedBetBonus(data)
+		if spin != nil {
+			over, need = spin.RoundOver, spin.Bonus
+		}
+		preds := []*v1.Predicate{
+			{Name: task.PredicateIsSpinOver, Fired: over},
+			{Name: task.PredicateNeedBetBonus, Fired: need},
+		}
+		// 局数达标的会话直接结束，不会再有下一条记录，此时不做对比
+		return preds, pick(need, bonus, betting), nil
+	case task.OpBetBonus:
+		more := g.BonusNextState(data)
+		return []*v1.Predicate{{Name: task.PredicateBonusNextState, Fired: more}}, pick(more, bonus, betting), nil
+	}
+	return nil, "", nil
+}
+
+func pick(cond bool, yes, no string) string {
+	if cond {
+		return yes
+	}
+	return no
+}
+
+func describe(rec *task.Record) string {
+	return fmt.Sprintf("[%d] %s %s/%s", rec.Time, rec.Member, rec.State, rec.Op)
+}
+
+func formatPredicates(preds []*v1.Predicate) string {
+	parts := make([]string, len(preds))
+	for i, p := range preds {
+		parts[i] = fmt.Sprintf("%s=%t", p.Name, p.Fired)
+	}
+	return strings.Join(parts, " ")
+}
+
+func open(name string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(name, "http://") && !strings.HasPrefix(name, "https://") {
+		return os.Open(name)
+	}
+	resp, err := http.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", name, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "replay:", err)
+	os.Exit(1)
+}
+
+// loadSpecs 构建声明式定义的游戏（path 为空时返回空表）
+func loadSpecs(path string) (map[int64]base.IGame, error) {
+	games := make(map[int64]base.IGame)
+	if path == "" {
+		return games, nil
+	}
+	specs, err := game.LoadSpecFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range specs {
+		g, err := s.Build()
+		if err != nil {
+			return nil, fmt.Errorf("game spec %d: %w", s.ID, err)
+		}
+		games[s.ID] = g
+	}
+	return games, nil
+}
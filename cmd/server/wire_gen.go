@@ -26,36 +26,14 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, stress *conf.Stress, logger log.Logger) (*kratos.App, func(), error) {
-	engine, cleanup, err := data.NewMysql(confData, logger)
+	dataRepo, cleanup, err := data.NewDataRepo(confData, stress, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	universalClient, cleanup2, err := data.NewRedis(confData, logger)
-	if err != nil {
-		cleanup()
-		return nil, nil, err
-	}
-	s3Bucket, cleanup3, err := data.NewS3Bucket(confData, logger)
-	if err != nil {
-		cleanup2()
-		cleanup()
-		return nil, nil, err
-	}
-	dataData, cleanup4, err := data.NewData(confData, logger, engine, universalClient, s3Bucket)
-	if err != nil {
-		cleanup3()
-		cleanup2()
-		cleanup()
-		return nil, nil, err
-	}
-	dataRepo := data.NewDataRepo(dataData, logger)
 	notifier := notify.NewFeishu(stress)
 	iGenerator := chart.NewGenerator()
-	useCase, cleanup5, err := biz.NewUseCase(dataRepo, logger, stress, notifier, iGenerator)
+	useCase, cleanup2, err := biz.NewUseCase(dataRepo, logger, stress, notifier, iGenerator)
 	if err != nil {
-		cleanup4()
-		cleanup3()
-		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
@@ -64,9 +42,6 @@ func wireApp(confServer *conf.Server, confData *conf.Data, stress *conf.Stress,
 	httpServer := server.NewHTTPServer(confServer, stressService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
-		cleanup5()
-		cleanup4()
-		cleanup3()
 		cleanup2()
 		cleanup()
 	}, nil
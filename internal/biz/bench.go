@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Bench 批量创建压测任务（每个游戏一个任务），返回成功的任务 ID 与失败信息 ["gameID:error", ...]
+func (uc *UseCase) Bench(ctx context.Context, in *v1.BenchRequest) (taskIDs []string, fails []string, err error) {
+	targets, err := uc.benchTargets(in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mu sync.Mutex
+	taskIDs = make([]string, 0, len(targets))
+	fails = make([]string, 0)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for _, g := range targets {
+		g := g
+
+		eg.Go(func() error {
+			if err := uc.EnsureBetSize(egCtx, g.GameID()); err != nil {
+				uc.log.Warnf("Bench EnsureBetSize failed: game_id=%d, err=%v", g.GameID(), err)
+				mu.Lock()
+				fails = append(fails, fmt.Sprintf("%d:%s", g.GameID(), err.Error()))
+				mu.Unlock()
+				return nil
+			}
+
+			t, err := uc.CreateTask(egCtx, g, benchConfig(g, in))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				fails = append(fails, fmt.Sprintf("%d:%s", g.GameID(), err.Error()))
+				return nil
+			}
+			taskIDs = append(taskIDs, t.GetID())
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+
+	return taskIDs, fails, nil
+}
+
+// benchTargets 按 game_ids 过滤出压测的游戏（为空为全部游戏）
+func (uc *UseCase) benchTargets(in *v1.BenchRequest) ([]base.IGame, error) {
+	games := uc.ListGames()
+	if len(games) == 0 {
+		return nil, fmt.Errorf("no games available")
+	}
+
+	// 过滤目标游戏
+	targets := games
+	if len(in.GameIds) > 0 {
+		gameMap := make(map[int64]base.IGame, len(games))
+		for _, g := range games {
+			gameMap[g.GameID()] = g
+		}
+
+		targets = make([]base.IGame, 0, len(in.GameIds))
+		for _, id := range in.GameIds {
+			if g, ok := gameMap[id]; ok {
+				targets = append(targets, g)
+			}
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no matching games")
+	}
+	return targets, nil
+}
+
+// benchConfig 单个游戏的压测任务配置
+func benchConfig(g base.IGame, in *v1.BenchRequest) *v1.TaskConfig {
+	return &v1.TaskConfig{
+		GameId:         g.GameID(),
+		Description:    "bench",
+		MemberCount:    in.MemberCount,
+		TimesPerMember: in.TimesPerMember,
+		BetOrder: &v1.BetOrderConfig{
+			BaseMoney: pickBaseMoney(g.BetSize()),
+			Multiple:  1,
+		},
+	}
+}
+
+func pickBaseMoney(sizes []float64) float64 {
+	if len(sizes) > 1 {
+		return sizes[1]
+	}
+	if len(sizes) > 0 {
+		return sizes[0]
+	}
+	return 0.1
+}
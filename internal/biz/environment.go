@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+	"stress/internal/biz/task"
+	"stress/internal/conf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// environment 任务的目标环境：启动配置（已应用商户覆盖）、订单库 / Redis 仓储与签名密钥
+type environment struct {
+	launch *conf.Stress_Launch
+	repo   task.Repo
+	secret base.SecretProvider
+}
+
+// resolveEnvironment 解析 TaskConfig.environment / merchant；name 为空时使用 stress.launch 与 data 中的默认环境。
+// 签名密钥按商户从 secrets 获取，环境配置了 sign_secret 时以其为准
+func (uc *UseCase) resolveEnvironment(name, merchant string) (*environment, error) {
+	launch, secret := uc.conf.Launch, base.SecretProvider(nil)
+	if uc.secrets != nil {
+		secret = uc.secrets.Get
+	}
+	if name != "" {
+		c, ok := uc.conf.Environments[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown environment: %s", name)
+		}
+		if c.Launch == nil {
+			return nil, fmt.Errorf("environment %s: launch is required", name)
+		}
+		launch = c.Launch
+		if c.SignSecret != "" {
+			secret = staticSecret(c.SignSecret)
+		}
+	}
+	if merchant != "" && merchant != launch.GetMerchant() {
+		launch = proto.Clone(launch).(*conf.Stress_Launch)
+		launch.Merchant = merchant
+	}
+
+	repo, err := uc.repo.Environment(name)
+	if err != nil {
+		return nil, fmt.Errorf("environment %q: %w", name, err)
+	}
+	return &environment{launch: launch, repo: repo, secret: secret}, nil
+}
+
+// validateTarget 校验任务的目标环境与传输方式
+func (uc *UseCase) validateTarget(cfg *v1.TaskConfig) error {
+	env, err := uc.resolveEnvironment(cfg.Environment, cfg.Merchant)
+	if err != nil {
+		return err
+	}
+	_, err = task.ResolveTransport(cfg, env.launch.GetTransports(), env.launch.GetWsUrl())
+	return err
+}
+
+// needsSecrets 默认环境或未配置 sign_secret 的命名环境要求签名时才需要商户密钥
+func needsSecrets(c *conf.Stress) bool {
+	if c.GetLaunch().GetSignRequired() {
+		return true
+	}
+	for _, e := range c.GetEnvironments() {
+		if e.GetLaunch().GetSignRequired() && e.GetSignSecret() == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// staticSecret 环境内所有商户使用同一签名密钥
+func staticSecret(secret string) base.SecretProvider {
+	return func(string) (string, bool) { return secret, true }
+}
+
+// storeKey 环境实际使用的订单库 / Redis 标识
+func (uc *UseCase) storeKey(name string, r task.Resource) string {
+	orders, redis := uc.repo.EnvironmentStores(name)
+	if r == task.ResourceRedis {
+		return redis
+	}
+	return orders
+}
+
+// isIdleFunc 按存储判断独占：只有与 name 共用同一订单库 / Redis 的环境中的运行任务才算占用
+func (uc *UseCase) isIdleFunc(name string) func(taskID string, r task.Resource) bool {
+	return func(taskID string, r task.Resource) bool {
+		key := uc.storeKey(name, r)
+		return uc.taskPool.IsIdle(taskID, func(env string) bool { return uc.storeKey(env, r) == key })
+	}
+}
+
+// redisSites 与 name 共用同一 Redis 的各环境的站点（去重），由最后结束的任务统一清理
+func (uc *UseCase) redisSites(name string) []string {
+	key := uc.storeKey(name, task.ResourceRedis)
+	var sites []string
+	add := func(launch *conf.Stress_Launch) {
+		for _, s := range launch.GetSites() {
+			if !slices.Contains(sites, s) {
+				sites = append(sites, s)
+			}
+		}
+	}
+	if uc.storeKey("", task.ResourceRedis) == key {
+		add(uc.conf.Launch)
+	}
+	for _, env := range slices.Sorted(maps.Keys(uc.conf.Environments)) {
+		if uc.storeKey(env, task.ResourceRedis) == key {
+			add(uc.conf.Environments[env].GetLaunch())
+		}
+	}
+	return sites
+}
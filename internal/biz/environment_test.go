@@ -0,0 +1,217 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+	"stress/internal/biz/member"
+	"stress/internal/biz/secret"
+	"stress/internal/biz/task"
+	"stress/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// envRepo 按名称返回预置的环境仓储与存储标识
+type envRepo struct {
+	DataRepo
+	repos  map[string]task.Repo
+	stores map[string][2]string // name -> 订单库、Redis 标识
+}
+
+func (r envRepo) EnvironmentStores(name string) (orders, redis string) {
+	st := r.stores[name]
+	return st[0], st[1]
+}
+
+func (r envRepo) Environment(name string) (task.Repo, error) {
+	repo, ok := r.repos[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown environment: %s", name)
+	}
+	return repo, nil
+}
+
+func TestNeedsSecrets(t *testing.T) {
+	signed := &conf.Stress_Launch{SignRequired: true}
+	tests := []struct {
+		name string
+		c    *conf.Stress
+		want bool
+	}{
+		{name: "no signing", c: &conf.Stress{Launch: &conf.Stress_Launch{}}, want: false},
+		{name: "default signs", c: &conf.Stress{Launch: signed}, want: true},
+		{name: "environment signs", c: &conf.Stress{Environments: map[string]*conf.Stress_Environment{"uat": {Launch: signed}}}, want: true},
+		{name: "environment with sign_secret", c: &conf.Stress{Environments: map[string]*conf.Stress_Environment{"uat": {Launch: signed, SignSecret: "s"}}}, want: false},
+	}
+	for _, tt := range tests {
+		if got := needsSecrets(tt.c); got != tt.want {
+			t.Errorf("%s: needsSecrets = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// namedRepo 以名称区分的仓储
+type namedRepo struct {
+	task.Repo
+	name string
+}
+
+func TestResolveEnvironment(t *testing.T) {
+	secrets, err := secret.NewProvider(&conf.Stress_Secrets{Source: secret.SourceConfig, Merchants: map[string]string{"m1": "s1", "m2": "s2"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := secrets.Refresh(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	defLaunch := &conf.Stress_Launch{ApiUrl: "http://default", Merchant: "m1"}
+	uc := &UseCase{
+		conf: &conf.Stress{
+			Launch: defLaunch,
+			Environments: map[string]*conf.Stress_Environment{
+				"staging":  {Launch: &conf.Stress_Launch{ApiUrl: "http://staging", Merchant: "m2"}},
+				"signed":   {Launch: &conf.Stress_Launch{ApiUrl: "http://signed", Merchant: "m1"}, SignSecret: "override"},
+				"nolaunch": {},
+			},
+		},
+		repo: envRepo{repos: map[string]task.Repo{
+			"":        namedRepo{name: "default"},
+			"staging": namedRepo{name: "staging"},
+			"signed":  namedRepo{name: "signed"},
+		}},
+		secrets: secrets,
+	}
+
+	tests := []struct {
+		name     string
+		env      string
+		merchant string
+		apiURL   string
+		wantMer  string
+		repo     string
+		secret   string // 商户 wantMer 的签名密钥
+		err      string
+	}{
+		{name: "empty name uses default", apiURL: "http://default", wantMer: "m1", repo: "default", secret: "s1"},
+		{name: "merchant override on default", merchant: "m2", apiURL: "http://default", wantMer: "m2", repo: "default", secret: "s2"},
+		{name: "named environment", env: "staging", apiURL: "http://staging", wantMer: "m2", repo: "staging", secret: "s2"},
+		{name: "merchant override on named", env: "staging", merchant: "m1", apiURL: "http://staging", wantMer: "m1", repo: "staging", secret: "s1"},
+		{name: "sign_secret takes precedence", env: "signed", apiURL: "http://signed", wantMer: "m1", repo: "signed", secret: "override"},
+		{name: "sign_secret for overridden merchant", env: "signed", merchant: "m2", apiURL: "http://signed", wantMer: "m2", repo: "signed", secret: "override"},
+		{name: "unknown environment", env: "prod", err: "unknown environment: prod"},
+		{name: "launch required", env: "nolaunch", err: "launch is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, err := uc.resolveEnvironment(tt.env, tt.merchant)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if env.launch.GetApiUrl() != tt.apiURL || env.launch.GetMerchant() != tt.wantMer {
+				t.Fatalf("launch = %s / %s, want %s / %s", env.launch.GetApiUrl(), env.launch.GetMerchant(), tt.apiURL, tt.wantMer)
+			}
+			if r := env.repo.(namedRepo); r.name != tt.repo {
+				t.Fatalf("repo = %s, want %s", r.name, tt.repo)
+			}
+			if s, ok := env.secret(tt.wantMer); !ok || s != tt.secret {
+				t.Fatalf("secret = %q, %v, want %q", s, ok, tt.secret)
+			}
+		})
+	}
+
+	// 商户覆盖不修改共享配置
+	if defLaunch.Merchant != "m1" || uc.conf.Environments["staging"].Launch.Merchant != "m2" {
+		t.Fatal("merchant override mutated the configured launch")
+	}
+}
+
+// TestEnvironmentIdle 独占按存储判断：其他环境的运行任务只有共用同一订单库 / Redis 时才算占用
+func TestEnvironmentIdle(t *testing.T) {
+	uc := &UseCase{
+		conf: &conf.Stress{
+			Launch: &conf.Stress_Launch{Sites: []string{"s0"}},
+			Environments: map[string]*conf.Stress_Environment{
+				"iso":       {Launch: &conf.Stress_Launch{Sites: []string{"s1"}}},
+				"shared":    {Launch: &conf.Stress_Launch{Sites: []string{"s2", "s0"}}},
+				"redisonly": {Launch: &conf.Stress_Launch{Sites: []string{"s3"}}},
+			},
+		},
+		repo: envRepo{stores: map[string][2]string{
+			"iso":       {"iso", "iso"},
+			"redisonly": {"", "redisonly"},
+		}},
+		taskPool: task.NewTaskPool(nil),
+	}
+	uc.taskPool.MarkRunning("t-default", 1, "", 1)
+	uc.taskPool.MarkRunning("t-iso", 1, "iso", 1)
+
+	tests := []struct {
+		task, env     string
+		orders, redis bool
+		sites         []string
+	}{
+		{task: "t-iso", env: "iso", orders: true, redis: true, sites: []string{"s1"}},
+		{task: "t-default", env: "", orders: true, redis: true, sites: []string{"s0", "s2"}},
+		{task: "t-shared", env: "shared", orders: false, redis: false, sites: []string{"s0", "s2"}},
+		{task: "t-redis", env: "redisonly", orders: false, redis: true, sites: []string{"s3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.task, func(t *testing.T) {
+			idle := uc.isIdleFunc(tt.env)
+			if got := idle(tt.task, task.ResourceOrders); got != tt.orders {
+				t.Fatalf("orders idle = %v, want %v", got, tt.orders)
+			}
+			if got := idle(tt.task, task.ResourceRedis); got != tt.redis {
+				t.Fatalf("redis idle = %v, want %v", got, tt.redis)
+			}
+			if got := uc.redisSites(tt.env); !slices.Equal(got, tt.sites) {
+				t.Fatalf("sites = %v, want %v", got, tt.sites)
+			}
+		})
+	}
+}
+
+// TestRunTaskEnvironmentError 执行前目标环境不可用：任务以失败结束，记录原因与结束时间，可被过期清理
+func TestRunTaskEnvironmentError(t *testing.T) {
+	uc := &UseCase{
+		ctx:        context.Background(),
+		conf:       &conf.Stress{Launch: &conf.Stress_Launch{}},
+		repo:       envRepo{repos: map[string]task.Repo{}},
+		taskPool:   task.NewTaskPool(nil),
+		memberPool: member.NewMemberPool(),
+		scheduleCh: make(chan struct{}, 1),
+	}
+	tk, err := task.NewTask(context.Background(), "t1", base.NewBaseGame(1, "g"), &v1.TaskConfig{GameId: 1, MemberCount: 1, TimesPerMember: 1}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc.taskPool.Add(tk)
+	uc.taskPool.MarkRunning("t1", 1, "", 1)
+	uc.runTask(tk, nil)
+
+	if tk.GetStatus() != v1.TaskStatus_TASK_FAILED || !strings.Contains(tk.GetReason(), "resolve environment") {
+		t.Fatalf("status=%v reason=%q", tk.GetStatus(), tk.GetReason())
+	}
+	if tk.GetFinishedAt().IsZero() {
+		t.Fatal("finish time should be set")
+	}
+	if n, _ := uc.taskPool.RunningStats(); n != 0 {
+		t.Fatalf("running = %d, want 0", n)
+	}
+	if n := uc.taskPool.CleanupExpiredTasks(-time.Second); n != 1 {
+		t.Fatalf("cleaned %d tasks, want 1", n)
+	}
+}
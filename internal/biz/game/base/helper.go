@@ -1,23 +1,105 @@
 package base
 
 import (
+	"encoding/base64"
 	"fmt"
+	"strconv"
 
-	jsoniter "github.com/json-iterator/go"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// NewProtobufConverter 按消息类型生成 protobuf → map 转换器，游戏只需声明响应消息类型：
+//
+//	var converter = base.NewProtobufConverter[*pb.Jqt_BetOrderResponse]()
+func NewProtobufConverter[T proto.Message]() ProtobufConverter {
+	var zero T
+	return ProtoToMapConverter(zero)
+}
+
 // ProtoToMapConverter 生成通用的 protobuf → map 转换器，消除游戏间的模板代码
 func ProtoToMapConverter(prototype proto.Message) ProtobufConverter {
 	msgType := prototype.ProtoReflect().Type()
 	return func(b []byte) (map[string]any, error) {
-		msg := msgType.New().Interface()
-		if err := proto.Unmarshal(b, msg); err != nil {
+		msg := msgType.New()
+		if err := proto.Unmarshal(b, msg.Interface()); err != nil {
 			return nil, fmt.Errorf("unmarshal protobuf: %w", err)
 		}
-		raw, _ := jsoniter.Marshal(msg)
-		var m map[string]any
-		_ = jsoniter.Unmarshal(raw, &m)
-		return m, nil
+		return ProtoToMap(msg), nil
+	}
+}
+
+// ProtoToMap 通过反射将消息转为 map，结构与 JSON 响应解码结果一致，便于游戏判定共用：
+//   - key 为字段的 JSON 名（lowerCamelCase 或 json_name）
+//   - 嵌套消息为 map[string]any，repeated 为 []any，map 字段为 map[string]any（key 转为字符串）
+//   - 数值（含 64 位整数与枚举）为 float64，bytes 为 base64 字符串
+//   - 有 presence 的字段（optional、oneof、消息）未设置时不输出；其余标量始终输出（含零值）；
+//     空的 repeated / map 不输出
+func ProtoToMap(msg protoreflect.Message) map[string]any {
+	fields := msg.Descriptor().Fields()
+	out := make(map[string]any, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case fd.IsList():
+			if list := msg.Get(fd).List(); list.Len() > 0 {
+				out[fd.JSONName()] = listToAny(fd, list)
+			}
+		case fd.IsMap():
+			if m := msg.Get(fd).Map(); m.Len() > 0 {
+				out[fd.JSONName()] = mapToAny(fd, m)
+			}
+		case fd.HasPresence() && !msg.Has(fd):
+		default:
+			out[fd.JSONName()] = valueToAny(fd, msg.Get(fd))
+		}
+	}
+	return out
+}
+
+func listToAny(fd protoreflect.FieldDescriptor, list protoreflect.List) []any {
+	out := make([]any, list.Len())
+	for i := range out {
+		out[i] = valueToAny(fd, list.Get(i))
+	}
+	return out
+}
+
+func mapToAny(fd protoreflect.FieldDescriptor, m protoreflect.Map) map[string]any {
+	out := make(map[string]any, m.Len())
+	vd := fd.MapValue()
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		out[k.String()] = valueToAny(vd, v)
+		return true
+	})
+	return out
+}
+
+// valueToAny 单个值（repeated 的元素、map 的 value）
+func valueToAny(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return ProtoToMap(v.Message())
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	case protoreflect.EnumKind:
+		return float64(v.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return float64(v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(v.Uint())
+	case protoreflect.FloatKind:
+		// 按 float32 的最短表示转换，避免 0.1 变为 0.10000000149011612
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(v.Float(), 'g', -1, 32), 64)
+		return f
+	case protoreflect.DoubleKind:
+		return v.Float()
+	default:
+		return v.Interface()
 	}
 }
@@ -0,0 +1,46 @@
+package base
+
+import (
+	"reflect"
+	"testing"
+
+	"stress/api/common/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProtobufConverter(t *testing.T) {
+	in := &pb.Jqn_BetOrderResponse{
+		OrderSN:  proto.String("SN1"),
+		Balance:  proto.Float64(99.5),
+		SpinOver: proto.Bool(false),
+		WinInfo: &pb.Jqn_WinInfo{
+			FullMulti: proto.Int64(3),
+			WinGird:   &pb.Board{Elements: []int64{1, 2}},
+		},
+	}
+	b, err := proto.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewProtobufConverter[*pb.Jqn_BetOrderResponse]()(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"orderSN":  "SN1",
+		"balance":  99.5,
+		"spinOver": false,
+		"winInfo": map[string]any{
+			"fullMulti": float64(3),
+			"winGird":   map[string]any{"elements": []any{float64(1), float64(2)}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err := NewProtobufConverter[*pb.Jqn_BetOrderResponse]()([]byte{0xff}); err == nil {
+		t.Fatal("expected unmarshal error")
+	}
+}
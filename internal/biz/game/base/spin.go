@@ -0,0 +1,18 @@
+package base
+
+// SpinResult betorder 响应中引擎关心的通用字段
+type SpinResult struct {
+	Bet       float64 // 本次扣除的下注额（免费游戏为 0）
+	Win       float64 // 本次赢分
+	FreeSpins int64   // 剩余免费次数
+	Bonus     bool    // 需进入 bonus 选择
+	RoundOver bool    // 一局结束
+	Balance   float64 // 下注后余额
+}
+
+// SpinParser 可选接口：游戏将 betorder 响应一次解析为 SpinResult。
+// 实现后引擎以其判定一局结束与是否进入 bonus（不再调用 IsSpinOver / NeedBetBonus），并据此统计客户端 RTP。
+// payload 为 JSON 响应的 data；配置了 protobuf 转换器的游戏为 base64 解码后的 protobuf 字节
+type SpinParser interface {
+	ParseSpin(payload []byte) (*SpinResult, error)
+}
@@ -1,12 +1,18 @@
 package g18904
 
 import (
+	"stress/api/common/pb"
 	"stress/internal/biz/game/base"
+
+	"google.golang.org/protobuf/proto"
 )
 
 const ID = 18904
 const Name = "法老归来"
 
+// converter betorder 响应的 protobuf 消息类型
+var converter = base.NewProtobufConverter[*pb.WinDetailsResponse]()
+
 type Game struct {
 	*base.Default
 }
@@ -30,5 +36,23 @@ func (*Game) IsSpinOver(data map[string]any) bool {
 
 // GetProtobufConverter 实现protobuf转换器
 func (g *Game) GetProtobufConverter() base.ProtobufConverter {
-	return ConvertProtobufToMap
+	return converter
+}
+
+// ParseSpin 实现 base.SpinParser
+func (*Game) ParseSpin(payload []byte) (*base.SpinResult, error) {
+	out := new(pb.WinDetailsResponse)
+	if err := proto.Unmarshal(payload, out); err != nil {
+		return nil, err
+	}
+	spin := &base.SpinResult{
+		Win:       out.GetCurWin(),
+		FreeSpins: out.GetRemainingFreeTimes(),
+		RoundOver: out.GetIsSpinOver(),
+		Balance:   out.GetCurrentBalance(),
+	}
+	if !out.GetIsFree() {
+		spin.Bet = out.GetBetAmount()
+	}
+	return spin, nil
 }
@@ -0,0 +1,54 @@
+package g18904
+
+import (
+	"testing"
+
+	"stress/api/common/pb"
+	"stress/internal/biz/game/base"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseSpin(t *testing.T) {
+	g := New()
+	tests := []struct {
+		name string
+		in   *pb.WinDetailsResponse
+		want base.SpinResult
+	}{
+		{
+			name: "paid spin, round over",
+			in: &pb.WinDetailsResponse{BetAmount: proto.Float64(5), CurWin: proto.Float64(2.5), CurrentBalance: proto.Float64(80),
+				IsFree: proto.Bool(false), IsSpinOver: true},
+			want: base.SpinResult{Bet: 5, Win: 2.5, Balance: 80, RoundOver: true},
+		},
+		{
+			name: "free spin costs nothing",
+			in: &pb.WinDetailsResponse{BetAmount: proto.Float64(5), CurWin: proto.Float64(10), IsFree: proto.Bool(true),
+				RemainingFreeTimes: proto.Int64(7)},
+			want: base.SpinResult{Win: 10, FreeSpins: 7},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := proto.Marshal(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			spin, err := g.(base.SpinParser).ParseSpin(b)
+			if err != nil {
+				t.Fatalf("ParseSpin: %v", err)
+			}
+			if *spin != tt.want {
+				t.Fatalf("ParseSpin = %+v, want %+v", *spin, tt.want)
+			}
+			data, err := g.GetProtobufConverter()(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if over := g.IsSpinOver(data); over != spin.RoundOver {
+				t.Fatalf("IsSpinOver = %v, ParseSpin.RoundOver = %v", over, spin.RoundOver)
+			}
+		})
+	}
+}
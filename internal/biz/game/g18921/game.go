@@ -8,6 +8,9 @@ import (
 const ID = 18921
 const Name = "三国志"
 
+// converter betorder 响应的 protobuf 消息类型
+var converter = base.NewProtobufConverter[*pb.Sgz_BetOrderResponse]()
+
 type Game struct {
 	*base.Default
 }
@@ -25,6 +28,7 @@ func (*Game) IsSpinOver(data map[string]any) bool {
 	return ok && over
 }
 
+// GetProtobufConverter 实现protobuf转换器
 func (g *Game) GetProtobufConverter() base.ProtobufConverter {
-	return base.ProtoToMapConverter(&pb.Sgz_BetOrderResponse{})
+	return converter
 }
@@ -1,16 +1,16 @@
 package g18922
 
 import (
-	"fmt"
 	"stress/api/common/pb"
 	"stress/internal/biz/game/base"
-
-	"google.golang.org/protobuf/proto"
 )
 
 const ID = 18922
 const Name = "金钱兔"
 
+// converter betorder 响应的 protobuf 消息类型
+var converter = base.NewProtobufConverter[*pb.Jqt_BetOrderResponse]()
+
 type Game struct {
 	*base.Default
 }
@@ -20,7 +20,11 @@ func New() base.IGame {
 }
 
 func (*Game) IsSpinOver(data map[string]any) bool {
-	next, exists := data["next"]
+	winInfo, ok := data["winInfo"].(map[string]any)
+	if !ok {
+		return false
+	}
+	next, exists := winInfo["next"]
 	if !exists {
 		return false
 	}
@@ -33,11 +37,5 @@ func (*Game) IsSpinOver(data map[string]any) bool {
 
 // GetProtobufConverter 实现protobuf转换器
 func (g *Game) GetProtobufConverter() base.ProtobufConverter {
-	return func(protoBytes []byte) (map[string]any, error) {
-		jqtResponse := new(pb.Jqt_BetOrderResponse)
-		if err := proto.Unmarshal(protoBytes, jqtResponse); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal protobuf: %v", err)
-		}
-		return map[string]any{"next": jqtResponse.WinInfo.Next}, nil
-	}
+	return converter
 }
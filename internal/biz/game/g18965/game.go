@@ -36,9 +36,10 @@ func (*Game) IsSpinOver(data map[string]any) bool {
 		// FreeNum 字段不存在
 		return false
 	}
-	freeNum := freeNumRaw.(float64)
+	// 按 %v 比较，类型不符（如字符串）时不会 panic
+	freeNum := fmt.Sprintf("%v", freeNumRaw)
 	win := fmt.Sprintf("%v", data["currentWin"])
-	if win == "0" && freeNum == 0 {
+	if win == "0" && freeNum == "0" {
 		return true
 	}
 
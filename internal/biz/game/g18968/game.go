@@ -5,14 +5,14 @@ import (
 
 	"stress/api/common/pb"
 	"stress/internal/biz/game/base"
-
-	jsoniter "github.com/json-iterator/go"
-	"google.golang.org/protobuf/proto"
 )
 
 const ID = 18968
 const Name = "玛雅迷城"
 
+// converter betorder 响应的 protobuf 消息类型
+var converter = base.NewProtobufConverter[*pb.Mymc_BetOrderResponse]()
+
 type Game struct {
 	*base.Default
 }
@@ -31,15 +31,5 @@ func (*Game) IsSpinOver(data map[string]any) bool {
 
 // GetProtobufConverter 实现protobuf转换器
 func (g *Game) GetProtobufConverter() base.ProtobufConverter {
-	return func(protoBytes []byte) (map[string]any, error) {
-		out := new(pb.Mymc_BetOrderResponse)
-		if err := proto.Unmarshal(protoBytes, out); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal protobuf: %v", err)
-		}
-		b, _ := jsoniter.Marshal(out)
-		mp := make(map[string]any)
-
-		_ = jsoniter.Unmarshal(b, &mp)
-		return mp, nil
-	}
+	return converter
 }
@@ -1,16 +1,16 @@
 package g18986
 
 import (
-	"fmt"
 	"stress/api/common/pb"
 	"stress/internal/biz/game/base"
-
-	"google.golang.org/protobuf/proto"
 )
 
 const ID = 18986
 const Name = "欢乐钓鱼佬"
 
+// converter betorder 响应的 protobuf 消息类型
+var converter = base.NewProtobufConverter[*pb.Hldyl_BetOrderResponse]()
+
 type Game struct {
 	*base.Default
 }
@@ -20,7 +20,11 @@ func New() base.IGame {
 }
 
 func (*Game) IsSpinOver(data map[string]any) bool {
-	next, exists := data["next"]
+	winInfo, ok := data["winInfo"].(map[string]any)
+	if !ok {
+		return false
+	}
+	next, exists := winInfo["next"]
 	if !exists {
 		return false
 	}
@@ -33,12 +37,5 @@ func (*Game) IsSpinOver(data map[string]any) bool {
 
 // GetProtobufConverter 实现protobuf转换器
 func (g *Game) GetProtobufConverter() base.ProtobufConverter {
-	//return hldyl.ConvertProtobufToMap
-	return func(bytes []byte) (map[string]any, error) {
-		out := new(pb.Hldyl_BetOrderResponse)
-		if err := proto.Unmarshal(bytes, out); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal protobuf: %v", err)
-		}
-		return map[string]any{"next": out.WinInfo.Next}, nil
-	}
+	return converter
 }
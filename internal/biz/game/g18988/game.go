@@ -6,13 +6,15 @@ import (
 	"stress/api/common/pb"
 	"stress/internal/biz/game/base"
 
-	jsoniter "github.com/json-iterator/go"
 	"google.golang.org/protobuf/proto"
 )
 
 const ID = 18988
 const Name = "金钱牛"
 
+// converter betorder 响应的 protobuf 消息类型
+var converter = base.NewProtobufConverter[*pb.Jqn_BetOrderResponse]()
+
 type Game struct {
 	*base.Default
 }
@@ -31,15 +33,22 @@ func (*Game) IsSpinOver(data map[string]any) bool {
 
 // GetProtobufConverter 实现protobuf转换器
 func (g *Game) GetProtobufConverter() base.ProtobufConverter {
-	return func(bytes []byte) (map[string]any, error) {
-		out := new(pb.Jqn_BetOrderResponse)
-		if err := proto.Unmarshal(bytes, out); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal protobuf: %v", err)
-		}
-		b, _ := jsoniter.Marshal(out)
-		mp := make(map[string]any)
-
-		_ = jsoniter.Unmarshal(b, &mp)
-		return mp, nil
+	return converter
+}
+
+// ParseSpin 实现 base.SpinParser
+func (*Game) ParseSpin(payload []byte) (*base.SpinResult, error) {
+	out := new(pb.Jqn_BetOrderResponse)
+	if err := proto.Unmarshal(payload, out); err != nil {
+		return nil, err
+	}
+	spin := &base.SpinResult{
+		Win:       out.GetCurrentWin(),
+		RoundOver: out.GetSpinOver(),
+		Balance:   out.GetBalance(),
+	}
+	if !out.GetIsFree() {
+		spin.Bet = out.GetBetAmount()
 	}
+	return spin, nil
 }
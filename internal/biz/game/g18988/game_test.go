@@ -0,0 +1,57 @@
+package g18988
+
+import (
+	"testing"
+
+	"stress/api/common/pb"
+	"stress/internal/biz/game/base"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseSpin(t *testing.T) {
+	g := New()
+	tests := []struct {
+		name string
+		in   *pb.Jqn_BetOrderResponse
+		want base.SpinResult
+	}{
+		{
+			name: "paid spin, round over",
+			in:   &pb.Jqn_BetOrderResponse{BetAmount: proto.Float64(2), CurrentWin: proto.Float64(1.5), Balance: proto.Float64(99), SpinOver: proto.Bool(true)},
+			want: base.SpinResult{Bet: 2, Win: 1.5, Balance: 99, RoundOver: true},
+		},
+		{
+			name: "free spin costs nothing",
+			in:   &pb.Jqn_BetOrderResponse{BetAmount: proto.Float64(2), CurrentWin: proto.Float64(3), IsFree: proto.Bool(true), SpinOver: proto.Bool(false)},
+			want: base.SpinResult{Win: 3},
+		},
+		{
+			name: "spinOver unset",
+			in:   &pb.Jqn_BetOrderResponse{BetAmount: proto.Float64(2)},
+			want: base.SpinResult{Bet: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := proto.Marshal(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			spin, err := g.(base.SpinParser).ParseSpin(b)
+			if err != nil {
+				t.Fatalf("ParseSpin: %v", err)
+			}
+			if *spin != tt.want {
+				t.Fatalf("ParseSpin = %+v, want %+v", *spin, tt.want)
+			}
+			data, err := g.GetProtobufConverter()(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if over := g.IsSpinOver(data); over != spin.RoundOver {
+				t.Fatalf("IsSpinOver = %v, ParseSpin.RoundOver = %v", over, spin.RoundOver)
+			}
+		})
+	}
+}
@@ -13,7 +13,8 @@ type Pool struct {
 	mu          sync.RWMutex
 	list        []base.IGame
 	registry    map[int64]base.IGame
-	betSizeFunc BetSizeFunc // 保存获取 betsize 的函数，用于动态获取
+	specs       map[int64]struct{} // 由声明式定义加载的游戏
+	betSizeFunc BetSizeFunc        // 保存获取 betsize 的函数，用于动态获取
 }
 
 type BetSizeFunc func(ctx context.Context, gameIDs []int64) (map[int64][]float64, error)
@@ -21,6 +22,7 @@ type BetSizeFunc func(ctx context.Context, gameIDs []int64) (map[int64][]float64
 func NewPool(fn BetSizeFunc) *Pool {
 	p := &Pool{
 		registry:    make(map[int64]base.IGame),
+		specs:       make(map[int64]struct{}),
 		list:        make([]base.IGame, 0, len(registry)),
 		betSizeFunc: fn,
 	}
@@ -89,3 +91,61 @@ func (p *Pool) List() []base.IGame {
 	cpy := append([]base.IGame{}, p.list...)
 	return cpy
 }
+
+// LoadSpecs 用声明式定义替换当前已加载的 spec 游戏（全部校验通过才生效）；
+// 与 Go 实现同 ID 的定义视为冲突（仅 cmd/replay -specs 允许覆盖）。已加载过的游戏沿用下注档位，新游戏从 DB 获取
+func (p *Pool) LoadSpecs(ctx context.Context, specs []*Spec) (int, error) {
+	games := make(map[int64]base.IGame, len(specs))
+	for _, s := range specs {
+		if _, ok := registry[s.ID]; ok {
+			return 0, fmt.Errorf("game spec %d: conflicts with plugin %s", s.ID, registry[s.ID].Name())
+		}
+		if _, ok := games[s.ID]; ok {
+			return 0, fmt.Errorf("game spec %d: duplicate id", s.ID)
+		}
+		g, err := s.Build()
+		if err != nil {
+			return 0, fmt.Errorf("game spec %d: %w", s.ID, err)
+		}
+		games[s.ID] = g
+	}
+
+	var missing []int64
+	p.mu.RLock()
+	for id, g := range games {
+		if old, ok := p.registry[id]; ok && len(old.BetSize()) > 0 {
+			g.SetBetSize(old.BetSize())
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	p.mu.RUnlock()
+	if len(missing) > 0 {
+		m, err := p.betSizeFunc(ctx, missing)
+		if err != nil {
+			return 0, fmt.Errorf("load betsize: %w", err)
+		}
+		for _, id := range missing {
+			games[id].SetBetSize(m[id])
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for id := range p.specs {
+		delete(p.registry, id)
+	}
+	p.specs = make(map[int64]struct{}, len(games))
+	for id, g := range games {
+		p.registry[id] = g
+		p.specs[id] = struct{}{}
+	}
+	p.list = p.list[:0]
+	for _, g := range p.registry {
+		p.list = append(p.list, g)
+	}
+	sort.Slice(p.list, func(i, j int) bool {
+		return p.list[i].GameID() < p.list[j].GameID()
+	})
+	return len(games), nil
+}
@@ -104,3 +104,9 @@ var registry = map[int64]base.IGame{
 	g18964.ID: g18964.New(), // 马行大运
 
 }
+
+// Lookup 按 gameID 查找已注册的游戏（不含 betsize，供离线工具使用）
+func Lookup(gameID int64) (base.IGame, bool) {
+	g, ok := registry[gameID]
+	return g, ok
+}
@@ -0,0 +1,377 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"stress/internal/biz/game/base"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// 游戏来源
+const (
+	SourcePlugin = "plugin" // Go 实现（registry）
+	SourceSpec   = "spec"   // 声明式定义
+)
+
+// 条件运算符
+const (
+	OpEq      = "eq" // 默认，按 %v 格式化后比较（与 Go 实现中的 fmt.Sprintf("%v") 一致）
+	OpNe      = "ne"
+	OpGt      = "gt"
+	OpGte     = "gte"
+	OpLt      = "lt"
+	OpLte     = "lte"
+	OpIn      = "in" // value 为列表，任一相等
+	OpExists  = "exists"
+	OpMissing = "missing"
+)
+
+// bonus 编号选择策略
+const (
+	PickFixed    = "fixed"    // 默认，固定 value
+	PickRandom   = "random"   // [min, max] 均匀随机
+	PickSequence = "sequence" // 依次循环 values
+)
+
+// Spec 声明式游戏定义，替代只判断响应字段的 Go 实现：
+//
+//	games:
+//	  - id: 19001
+//	    name: "示例"
+//	    spin_over: {path: "winInfo.over", value: true}
+//	    need_bonus:
+//	      any:
+//	        - {path: "nextState", value: 11}
+//	        - all: [{path: "state", value: 11}, {path: "nextState", value: 0}]
+//	    bonus_pick: {strategy: random, min: 1, max: 3}
+//	    protobuf: "mdsdbz.mdsdbz_BetOrderResponse"
+type Spec struct {
+	ID            int64      `json:"id"`
+	Name          string     `json:"name"`
+	SpinOver      *Condition `json:"spin_over"`      // 一局结束（为空时每次下注即结束一局）
+	NeedBonus     *Condition `json:"need_bonus"`     // 需进入 bonus 选择（betorder 响应 / 登录 freeData）
+	BonusContinue *Condition `json:"bonus_continue"` // betbonus 后仍需继续选择
+	BonusPick     *BonusPick `json:"bonus_pick"`     // bonus 编号（为空为 -1）
+	Protobuf      string     `json:"protobuf"`       // betorder 响应的 protobuf 消息全名（为空按 JSON 解析）
+}
+
+// Condition 判定条件：叶子（path + op + value）或组合（all / any，可嵌套），二者不能同时配置
+type Condition struct {
+	Path  string       `json:"path"`  // 字段路径，"." 分隔，数组用下标（如 winInfo.lines.0.win）
+	Op    string       `json:"op"`    // 运算符，默认 eq
+	Value any          `json:"value"` // 期望值
+	All   []*Condition `json:"all"`   // 全部满足
+	Any   []*Condition `json:"any"`   // 任一满足
+}
+
+// BonusPick bonus 编号选择策略；sequence 的位置按游戏维护，并发会话交替取值，单个会话取到的编号不保证连续
+type BonusPick struct {
+	Strategy string  `json:"strategy"` // fixed（默认）/ random / sequence
+	Value    int64   `json:"value"`    // fixed
+	Min      int64   `json:"min"`      // random 下限（含）
+	Max      int64   `json:"max"`      // random 上限（含）
+	Values   []int64 `json:"values"`   // sequence
+}
+
+// specFile 定义文件结构
+type specFile struct {
+	Games []*Spec `json:"games"`
+}
+
+// LoadSpecFiles 读取定义文件；path 为目录时按文件名顺序读取其中的 *.yaml / *.yml / *.json
+func LoadSpecFiles(path string) ([]*Spec, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		files = files[:0]
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".yaml", ".yml", ".json":
+				if !e.IsDir() {
+					files = append(files, filepath.Join(path, e.Name()))
+				}
+			}
+		}
+	}
+
+	var specs []*Spec
+	for _, f := range files {
+		c := config.New(config.WithSource(file.NewSource(f)))
+		err := c.Load()
+		var sf specFile
+		if err == nil {
+			err = c.Scan(&sf)
+		}
+		_ = c.Close()
+		if err != nil {
+			return nil, fmt.Errorf("load game specs %s: %w", f, err)
+		}
+		specs = append(specs, sf.Games...)
+	}
+	return specs, nil
+}
+
+// Build 校验定义并生成游戏
+func (s *Spec) Build() (base.IGame, error) {
+	if s.ID <= 0 {
+		return nil, errors.New("id is required")
+	}
+	if s.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	for name, c := range map[string]*Condition{"spin_over": s.SpinOver, "need_bonus": s.NeedBonus, "bonus_continue": s.BonusContinue} {
+		if err := c.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	pick, err := s.BonusPick.picker()
+	if err != nil {
+		return nil, fmt.Errorf("bonus_pick: %w", err)
+	}
+	g := &specGame{
+		Default:       base.NewBaseGame(s.ID, s.Name),
+		spinOver:      s.SpinOver,
+		needBonus:     s.NeedBonus,
+		bonusContinue: s.BonusContinue,
+		pick:          pick,
+	}
+	if s.Protobuf != "" {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(s.Protobuf))
+		if err != nil {
+			return nil, fmt.Errorf("protobuf %s: %w", s.Protobuf, err)
+		}
+		g.converter = base.ProtoToMapConverter(mt.New().Interface())
+	}
+	return g, nil
+}
+
+// specGame 由 Spec 生成的游戏，创建后只读
+type specGame struct {
+	*base.Default
+	spinOver      *Condition
+	needBonus     *Condition
+	bonusContinue *Condition
+	pick          func() int64
+	converter     base.ProtobufConverter
+}
+
+func (g *specGame) IsSpinOver(data map[string]any) bool {
+	return g.spinOver == nil || g.spinOver.Match(data)
+}
+
+func (g *specGame) NeedBetBonus(data map[string]any) bool {
+	return g.needBonus != nil && g.needBonus.Match(data)
+}
+
+func (g *specGame) BonusNextState(data map[string]any) bool {
+	return g.bonusContinue != nil && g.bonusContinue.Match(data)
+}
+
+func (g *specGame) PickBonusNum() int64 {
+	return g.pick()
+}
+
+func (g *specGame) GetProtobufConverter() base.ProtobufConverter {
+	return g.converter
+}
+
+// SourceOf 游戏来源：spec 或 plugin
+func SourceOf(g base.IGame) string {
+	if _, ok := g.(*specGame); ok {
+		return SourceSpec
+	}
+	return SourcePlugin
+}
+
+func (c *Condition) validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Path == "" {
+		if len(c.All) == 0 && len(c.Any) == 0 {
+			return errors.New("path or all/any is required")
+		}
+		for _, sub := range slices.Concat(c.All, c.Any) {
+			if sub == nil {
+				return errors.New("empty condition")
+			}
+			if err := sub.validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(c.All) > 0 || len(c.Any) > 0 {
+		return fmt.Errorf("%s: path and all/any are exclusive", c.Path)
+	}
+	switch c.Op {
+	case "", OpEq, OpNe, OpExists, OpMissing:
+	case OpGt, OpGte, OpLt, OpLte:
+		if _, ok := toFloat(c.Value); !ok {
+			return fmt.Errorf("%s: op %s requires a numeric value", c.Path, c.Op)
+		}
+	case OpIn:
+		if _, ok := c.Value.([]any); !ok {
+			return fmt.Errorf("%s: op in requires a list value", c.Path)
+		}
+	default:
+		return fmt.Errorf("%s: unknown op %q", c.Path, c.Op)
+	}
+	return nil
+}
+
+// Match 判定 data 是否满足条件；字段不存在时除 missing 外均不满足
+func (c *Condition) Match(data map[string]any) bool {
+	if c.Path == "" {
+		for _, sub := range c.All {
+			if !sub.Match(data) {
+				return false
+			}
+		}
+		if len(c.Any) == 0 {
+			return true
+		}
+		for _, sub := range c.Any {
+			if sub.Match(data) {
+				return true
+			}
+		}
+		return false
+	}
+
+	v, ok := lookup(data, c.Path)
+	if c.Op == OpMissing {
+		return !ok
+	}
+	if !ok {
+		return false
+	}
+	switch c.Op {
+	case OpExists:
+		return true
+	case OpNe:
+		return !equal(v, c.Value)
+	case OpIn:
+		for _, want := range c.Value.([]any) {
+			if equal(v, want) {
+				return true
+			}
+		}
+		return false
+	case OpGt, OpGte, OpLt, OpLte:
+		got, ok := toFloat(v)
+		if !ok {
+			return false
+		}
+		want, _ := toFloat(c.Value)
+		switch c.Op {
+		case OpGt:
+			return got > want
+		case OpGte:
+			return got >= want
+		case OpLt:
+			return got < want
+		default:
+			return got <= want
+		}
+	default:
+		return equal(v, c.Value)
+	}
+}
+
+// lookup 按路径取值，支持嵌套 map 与数组下标
+func lookup(data map[string]any, path string) (any, bool) {
+	var cur any = data
+	for _, key := range strings.Split(path, ".") {
+		switch node := cur.(type) {
+		case map[string]any:
+			v, ok := node[key]
+			if !ok {
+				return nil, false
+			}
+			cur = v
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			cur = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func equal(got, want any) bool {
+	return fmt.Sprintf("%v", got) == fmt.Sprintf("%v", want)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func (p *BonusPick) picker() (func() int64, error) {
+	if p == nil {
+		return func() int64 { return -1 }, nil
+	}
+	switch p.Strategy {
+	case "", PickFixed:
+		v := p.Value
+		return func() int64 { return v }, nil
+	case PickRandom:
+		if p.Max < p.Min {
+			return nil, fmt.Errorf("max %d < min %d", p.Max, p.Min)
+		}
+		lo, n := p.Min, p.Max-p.Min+1
+		return func() int64 { return lo + rand.Int64N(n) }, nil
+	case PickSequence:
+		if len(p.Values) == 0 {
+			return nil, errors.New("values is required for sequence")
+		}
+		values := slices.Clone(p.Values)
+		var next atomic.Uint64
+		return func() int64 { return values[(next.Add(1)-1)%uint64(len(values))] }, nil
+	default:
+		return nil, fmt.Errorf("unknown strategy %q", p.Strategy)
+	}
+}
@@ -0,0 +1,139 @@
+package game
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"stress/internal/biz/game/g18902"
+	"stress/internal/biz/game/g18920"
+)
+
+// 与 g18902 / g18920 的 Go 实现等价的定义
+const specYAML = `
+games:
+  - id: 90902
+    name: "波塞冬之力"
+    spin_over: {path: "isSpinOver", value: true}
+    need_bonus:
+      any:
+        - {path: "nextState", value: 11}
+        - all: [{path: "state", value: 11}, {path: "nextState", value: 0}]
+    bonus_continue:
+      all: [{path: "state", value: 11}, {path: "nextState", value: 0}]
+    bonus_pick: {value: 1}
+  - id: 90920
+    name: "战神雅典娜"
+    spin_over:
+      all:
+        - {path: "bonusState", op: ne, value: 1}
+        - {path: "freeNum", value: 0}
+        - {path: "win", value: 0}
+    need_bonus:
+      all: [{path: "treasureNum", op: gte, value: 3}, {path: "bonusState", value: "1"}]
+    bonus_pick: {strategy: sequence, values: [1, 2, 3]}
+`
+
+func writeSpecs(t *testing.T, content string) string {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "games.yaml"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestSpecMatchesPlugins(t *testing.T) {
+	specs, err := LoadSpecFiles(writeSpecs(t, specYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(specs) != 2 {
+		t.Fatalf("loaded %d specs", len(specs))
+	}
+	s18902, err := specs[0].Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s18920, err := specs[1].Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p18902, p18920 := g18902.New(), g18920.New()
+	samples := []map[string]any{
+		{},
+		{"isSpinOver": true, "nextState": float64(11)},
+		{"isSpinOver": false, "state": float64(11), "nextState": float64(0)},
+		{"state": float64(11), "nextState": float64(1)},
+		{"bonusState": float64(1), "treasureNum": float64(3), "freeNum": float64(0), "win": float64(0)},
+		{"bonusState": float64(0), "treasureNum": float64(5), "freeNum": float64(0), "win": float64(0)},
+		{"bonusState": float64(0), "freeNum": float64(2), "win": float64(0)},
+		{"bonusState": float64(0), "freeNum": float64(0), "win": 1.5},
+	}
+	for _, d := range samples {
+		if s18902.IsSpinOver(d) != p18902.IsSpinOver(d) || s18902.NeedBetBonus(d) != p18902.NeedBetBonus(d) ||
+			s18902.BonusNextState(d) != p18902.BonusNextState(d) {
+			t.Fatalf("18902 mismatch on %v", d)
+		}
+		if s18920.IsSpinOver(d) != p18920.IsSpinOver(d) || s18920.NeedBetBonus(d) != p18920.NeedBetBonus(d) {
+			t.Fatalf("18920 mismatch on %v", d)
+		}
+	}
+	if s18902.PickBonusNum() != 1 {
+		t.Fatal("fixed pick")
+	}
+	for i, want := range []int64{1, 2, 3, 1} {
+		if got := s18920.PickBonusNum(); got != want {
+			t.Fatalf("sequence pick %d = %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestPoolLoadSpecs(t *testing.T) {
+	calls := 0
+	p := NewPool(func(_ context.Context, ids []int64) (map[int64][]float64, error) {
+		calls++
+		m := make(map[int64][]float64, len(ids))
+		for _, id := range ids {
+			m[id] = []float64{1, 2}
+		}
+		return m, nil
+	})
+	specs, err := LoadSpecFiles(writeSpecs(t, specYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := p.LoadSpecs(context.Background(), specs); err != nil || n != 2 {
+		t.Fatalf("LoadSpecs = %d, %v", n, err)
+	}
+	g, ok := p.Get(90920)
+	if !ok || SourceOf(g) != SourceSpec || len(g.BetSize()) != 2 {
+		t.Fatalf("90920 = %v, %v", g, ok)
+	}
+
+	// 重新加载：移除的定义下线，保留的沿用下注档位
+	before := calls
+	if _, err := p.LoadSpecs(context.Background(), specs[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.Get(90902); ok {
+		t.Fatal("90902 should be removed")
+	}
+	if calls != before {
+		t.Fatalf("betsize fetched again for kept specs")
+	}
+
+	// 与 Go 实现冲突或校验失败时整体不生效
+	bad := []*Spec{{ID: 90930, Name: "new"}, {ID: g18902.ID, Name: "conflict"}}
+	if _, err := p.LoadSpecs(context.Background(), bad); err == nil {
+		t.Fatal("expected conflict error")
+	}
+	bad = []*Spec{{ID: 90930, Name: "new", SpinOver: &Condition{Path: "x", Op: "gt", Value: "abc"}}}
+	if _, err := p.LoadSpecs(context.Background(), bad); err == nil {
+		t.Fatal("expected validation error")
+	}
+	if _, ok := p.Get(90920); !ok {
+		t.Fatal("failed reload should keep current specs")
+	}
+}
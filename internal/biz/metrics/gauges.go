@@ -8,8 +8,11 @@ import (
 )
 
 const (
-	labelTaskID = "task_id"
-	labelGameID = "game_id"
+	labelTaskID   = "task_id"
+	labelGameID   = "game_id"
+	labelOp       = "op"
+	labelQuantile = "quantile"
+	labelCause    = "cause"
 )
 
 // 指标名规范：stress_task_<name>，标签 task_id、game_id
@@ -25,6 +28,16 @@ var (
 	_metric_total_win      = newGauge("stress_task_total_win", "总赢(×1e4)")
 	_metric_rtp_pct        = newGauge("stress_task_rtp_pct", "RTP %")
 	_metric_order_count    = newGauge("stress_task_order_count", "订单数")
+
+	_metric_latency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stress_task_latency_ms",
+		Help: "请求延迟分位值(ms)，quantile=avg/0.5/0.9/0.95/0.99/0.999/max",
+	}, []string{labelTaskID, labelGameID, labelOp, labelQuantile})
+
+	_metric_errors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "stress_task_errors_total",
+		Help: "按请求类型与原因分类的失败请求数，cause=http_<status>/timeout/transport/canceled/code_<code>/decode/other",
+	}, []string{labelTaskID, labelGameID, labelOp, labelCause})
 )
 
 func newGauge(name, help string) *prometheus.GaugeVec {
@@ -51,4 +64,7 @@ func CleanupTaskMetrics(taskID string, gameID int64) {
 	_metric_total_win.Delete(labels)
 	_metric_rtp_pct.Delete(labels)
 	_metric_order_count.Delete(labels)
+	_metric_latency.DeletePartialMatch(labels)
+	_metric_errors.DeletePartialMatch(labels)
+	forgetErrors(taskID)
 }
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"strconv"
+	"sync"
 
 	v1 "stress/api/stress/v1"
 
@@ -29,4 +30,62 @@ func ReportTask(r *v1.TaskCompletionReport) {
 	set(_metric_total_win, labels, float64(r.TotalWin))
 	set(_metric_rtp_pct, labels, r.RtpPct)
 	set(_metric_order_count, labels, float64(r.OrderCount))
+
+	for _, l := range r.Latencies {
+		reportLatency(r.TaskId, labels[labelGameID], l)
+	}
+	reportErrors(r.TaskId, labels[labelGameID], r.Errors)
+}
+
+var (
+	errorsMu       sync.Mutex
+	errorsReported = map[string]map[string]int64{} // task_id -> op/cause -> 已上报次数
+)
+
+// reportErrors 报告中为累计值，计数器只累加与上次上报的差值
+func reportErrors(taskID, gameID string, classes []*v1.ErrorClass) {
+	errorsMu.Lock()
+	defer errorsMu.Unlock()
+
+	seen := errorsReported[taskID]
+	if seen == nil {
+		seen = make(map[string]int64, len(classes))
+		errorsReported[taskID] = seen
+	}
+	for _, c := range classes {
+		key := c.Op + "/" + c.Cause
+		if delta := c.Count - seen[key]; delta > 0 {
+			_metric_errors.With(prometheus.Labels{
+				labelTaskID: taskID,
+				labelGameID: gameID,
+				labelOp:     c.Op,
+				labelCause:  c.Cause,
+			}).Add(float64(delta))
+			seen[key] = c.Count
+		}
+	}
+}
+
+func forgetErrors(taskID string) {
+	errorsMu.Lock()
+	delete(errorsReported, taskID)
+	errorsMu.Unlock()
+}
+
+func reportLatency(taskID, gameID string, l *v1.LatencyStats) {
+	quantiles := []struct {
+		name string
+		v    float64
+	}{
+		{"avg", l.Avg}, {"0.5", l.P50}, {"0.9", l.P90}, {"0.95", l.P95},
+		{"0.99", l.P99}, {"0.999", l.P999}, {"max", l.Max},
+	}
+	for _, q := range quantiles {
+		set(_metric_latency, prometheus.Labels{
+			labelTaskID:   taskID,
+			labelGameID:   gameID,
+			labelOp:       l.Op,
+			labelQuantile: q.name,
+		}, q.v)
+	}
 }
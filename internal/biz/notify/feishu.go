@@ -21,6 +21,11 @@ import (
 
 var ProviderSet = wire.NewSet(NewFeishu)
 
+const (
+	maxErrorClasses   = 5   // 通知中最多列出的错误分类数
+	maxErrorSampleLen = 200 // 错误样本截断长度（按字符）
+)
+
 type Feishu struct {
 	WebhookURL    string
 	SigningSecret string
@@ -136,6 +141,41 @@ func BuildTaskCompletionMessage(r *v1.TaskCompletionReport) *Message {
 		fmt.Sprintf("**失败成员**：%d", r.Failed),
 		fmt.Sprintf("**失败请求**：%d", r.FailedReqs),
 	}
+	if r.ClientBet > 0 {
+		lines = append(lines, fmt.Sprintf("**客户端RTP**：%.2f%%（下注 %.2f / 赢 %.2f）",
+			r.ClientRtpPct, float64(r.ClientBet)/1e4, float64(r.ClientWin)/1e4))
+	}
+	for _, l := range r.Latencies {
+		lines = append(lines, fmt.Sprintf("**延迟(%s)**：p50 %.1fms / p90 %.1fms / p95 %.1fms / p99 %.1fms / p99.9 %.1fms / max %.1fms",
+			l.Op, l.P50, l.P90, l.P95, l.P99, l.P999, l.Max))
+	}
+	for i, e := range r.Errors {
+		if i == maxErrorClasses {
+			lines = append(lines, fmt.Sprintf("**错误(其他)**：%d 类未列出", len(r.Errors)-i))
+			break
+		}
+		line := fmt.Sprintf("**错误(%s/%s)**：%d 次", e.Op, e.Cause, e.Count)
+		if n := len(e.Samples); n > 0 {
+			line += "，最近：" + truncate(e.Samples[n-1], maxErrorSampleLen)
+		}
+		lines = append(lines, line)
+	}
+	if len(r.Slo) > 0 {
+		verdict := "通过"
+		if !r.SloPassed {
+			verdict = "未通过"
+		}
+		lines = append(lines, fmt.Sprintf("**SLO**：%s", verdict))
+		for _, a := range r.Slo {
+			lines = append(lines, fmt.Sprintf("- %s %s", sloMark(a), sloLine(a)))
+		}
+	}
+	if c := r.Chaos; c != nil {
+		lines = append(lines, fmt.Sprintf("**故障注入**：%d 会话，断开 %d / 放弃 bonus %d / 空闲 %d（拒绝 %d）",
+			c.Sessions, c.Dropped, c.Abandoned, c.Idled, c.IdleRejected))
+		lines = append(lines, fmt.Sprintf("**服务端未拦截**：失效 token %d / %d，重复下注 %d / %d，额外订单 %d",
+			c.StaleAccepted, c.StaleSent, c.DuplicateAccepted, c.DuplicateSent, c.ExtraOrders))
+	}
 	if r.OrderWarning != "" {
 		lines = append(lines, fmt.Sprintf("**订单警告**：%s", r.OrderWarning))
 	}
@@ -144,3 +184,31 @@ func BuildTaskCompletionMessage(r *v1.TaskCompletionReport) *Message {
 	}
 	return &Message{Title: "压测任务结束", Content: strings.Join(lines, "\n")}
 }
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n]) + "…"
+	}
+	return s
+}
+
+func sloMark(a *v1.AssertionResult) string {
+	switch {
+	case a.NoData:
+		return "⚪"
+	case a.Passed:
+		return "✅"
+	}
+	return "❌"
+}
+
+func sloLine(a *v1.AssertionResult) string {
+	line := a.Name
+	if a.Hard {
+		line += "（硬性）"
+	}
+	if a.NoData {
+		return line + "：无数据"
+	}
+	return fmt.Sprintf("%s：实际 %.2f", line, a.Actual)
+}
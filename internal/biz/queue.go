@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+// QueueState 调度队列快照
+type QueueState struct {
+	Paused  bool
+	Running int
+	Entries []*v1.QueueEntry
+}
+
+// ListQueue 返回排队任务及预计开始时间。
+// ETA 按 max_running_tasks 个并发槽位模拟：运行中任务占用槽位至其剩余时长，
+// 排队任务依次落入最早空闲的槽位，时长按 每成员局数 / 单成员吞吐 估算；
+// 单成员吞吐取当前内存中已运行任务的平均值，忽略同游戏互斥与成员上限，仅供参考
+func (uc *UseCase) ListQueue() *QueueState {
+	now := time.Now()
+	pending := uc.taskPool.PendingTasks()
+	running, _ := uc.taskPool.RunningStats()
+
+	state := &QueueState{
+		Paused:  uc.taskPool.IsPaused(),
+		Running: running,
+		Entries: make([]*v1.QueueEntry, 0, len(pending)),
+	}
+
+	rate := uc.memberThroughput(now)
+	slots := uc.runningSlots(now, rate)
+
+	for i, t := range pending {
+		cfg := t.GetConfig()
+		e := &v1.QueueEntry{
+			Position:    int32(i + 1),
+			TaskId:      t.GetID(),
+			GameId:      cfg.GetGameId(),
+			Priority:    cfg.GetPriority(),
+			MemberCount: cfg.GetMemberCount(),
+			Description: cfg.GetDescription(),
+			CreatedAt:   t.GetCreatedAt().Format(time.DateTime),
+			EtaSec:      -1,
+		}
+		state.Entries = append(state.Entries, e)
+
+		if slots == nil || state.Paused {
+			continue
+		}
+		// 最早空闲的槽位
+		sort.Slice(slots, func(a, b int) bool { return slots[a] < slots[b] })
+		e.EtaSec = int64(slots[0].Seconds())
+		slots[0] += estimateDuration(cfg, rate)
+	}
+	return state
+}
+
+// memberThroughput 内存中已运行任务的平均单成员吞吐（局/秒），无样本返回 0
+func (uc *UseCase) memberThroughput(now time.Time) float64 {
+	var sum float64
+	var n int
+	for _, t := range uc.taskPool.List() {
+		if r := t.MemberThroughput(now); r > 0 {
+			sum += r
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
+
+// runningSlots 各并发槽位距离空闲的时长；无法估算时返回 nil
+func (uc *UseCase) runningSlots(now time.Time, rate float64) []time.Duration {
+	if rate <= 0 {
+		return nil
+	}
+	slots := make([]time.Duration, uc.schedulerLimits().maxRunning)
+	i := 0
+	for _, t := range uc.taskPool.List() {
+		if s := t.GetStatus(); (s != v1.TaskStatus_TASK_RUNNING && s != v1.TaskStatus_TASK_PAUSED) || i >= len(slots) {
+			continue
+		}
+		remaining := t.Remaining(now)
+		if remaining <= 0 {
+			remaining = estimateDuration(t.GetConfig(), rate)
+		}
+		slots[i] = remaining
+		i++
+	}
+	return slots
+}
+
+// estimateDuration 按单成员吞吐估算任务时长（成员并行执行，时长与成员数无关），不超过 duration 上限
+func estimateDuration(cfg *v1.TaskConfig, rate float64) time.Duration {
+	limit, _ := time.ParseDuration(cfg.GetDuration())
+	if cfg.GetTimesPerMember() <= 0 || rate <= 0 {
+		return limit
+	}
+	d := time.Duration(float64(cfg.GetTimesPerMember()) / rate * float64(time.Second))
+	if limit > 0 && limit < d {
+		return limit
+	}
+	return d
+}
+
+// MoveTask 将排队任务移到队首或队尾
+func (uc *UseCase) MoveTask(id string, front bool) error {
+	t, ok := uc.taskPool.Get(id)
+	if !ok {
+		return fmt.Errorf("task %s not found", id)
+	}
+	if t.GetStatus() != v1.TaskStatus_TASK_PENDING || !uc.taskPool.MovePending(id, front) {
+		return fmt.Errorf("task %s is not pending", id)
+	}
+	uc.WakeScheduler()
+	return nil
+}
+
+// PauseQueue 暂停调度，运行中任务不受影响
+func (uc *UseCase) PauseQueue() {
+	uc.taskPool.SetPaused(true)
+	uc.log.Infof("task queue paused")
+}
+
+// ResumeQueue 恢复调度并立即触发一次
+func (uc *UseCase) ResumeQueue() {
+	uc.taskPool.SetPaused(false)
+	uc.log.Infof("task queue resumed")
+	uc.WakeScheduler()
+}
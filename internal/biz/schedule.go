@@ -0,0 +1,248 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/schedule"
+)
+
+const scheduleRunTimeout = 5 * time.Minute // 单次触发（创建任务）超时
+
+// cronEntry 已加载的定时任务
+type cronEntry struct {
+	spec   *schedule.Cron
+	info   *v1.Schedule // 受 cronMu 保护
+	firing bool         // 正在触发，避免同一分钟重复进入
+}
+
+// loadSchedules 启动时从 DB 加载定时任务，表达式非法的跳过
+func (uc *UseCase) loadSchedules(ctx context.Context) {
+	list, err := uc.repo.ListSchedules(ctx)
+	if err != nil {
+		uc.log.Warnf("load schedules: %v", err)
+		return
+	}
+
+	uc.cronMu.Lock()
+	defer uc.cronMu.Unlock()
+	for _, s := range list {
+		spec, err := schedule.Parse(s.Cron)
+		if err != nil {
+			uc.log.Warnf("skip schedule %d: %v", s.ScheduleId, err)
+			continue
+		}
+		uc.crons[s.ScheduleId] = &cronEntry{spec: spec, info: s}
+	}
+	uc.log.Infof("loaded %d schedules", len(uc.crons))
+}
+
+// cronLoop 每到整分钟检查一次定时任务
+func (uc *UseCase) cronLoop() {
+	uc.loadSchedules(uc.ctx)
+
+	for {
+		now := time.Now()
+		next := now.Truncate(time.Minute).Add(time.Minute)
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-uc.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		uc.fireSchedules(next)
+	}
+}
+
+// fireSchedules 触发命中 at 的定时任务
+func (uc *UseCase) fireSchedules(at time.Time) {
+	uc.cronMu.Lock()
+	defer uc.cronMu.Unlock()
+	for _, e := range uc.crons {
+		if e.firing || !e.spec.Match(at) {
+			continue
+		}
+		e.firing = true
+		go uc.runSchedule(e, at)
+	}
+}
+
+// runSchedule 按模板创建任务；上次触发的任务仍未结束时跳过本次
+func (uc *UseCase) runSchedule(e *cronEntry, at time.Time) {
+	uc.cronMu.Lock()
+	info := e.info
+	uc.cronMu.Unlock()
+
+	defer func() {
+		uc.cronMu.Lock()
+		e.firing = false
+		uc.cronMu.Unlock()
+	}()
+
+	if id, busy := uc.activeTask(info.LastTaskIds); busy {
+		uc.log.Warnf("schedule %d(%s) skipped: previous run %s still in progress", info.ScheduleId, info.Name, id)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(uc.ctx, scheduleRunTimeout)
+	defer cancel()
+
+	var taskIDs []string
+	switch tmpl := info.Template.(type) {
+	case *v1.Schedule_Task:
+		t, err := uc.SubmitTask(ctx, tmpl.Task)
+		if err != nil {
+			uc.log.Errorf("schedule %d(%s) create task: %v", info.ScheduleId, info.Name, err)
+			return
+		}
+		taskIDs = []string{t.GetID()}
+	case *v1.Schedule_Bench:
+		ids, fails, err := uc.Bench(ctx, tmpl.Bench)
+		if err != nil {
+			uc.log.Errorf("schedule %d(%s) bench: %v", info.ScheduleId, info.Name, err)
+			return
+		}
+		if len(fails) > 0 {
+			uc.log.Warnf("schedule %d(%s) bench fails: %v", info.ScheduleId, info.Name, fails)
+		}
+		taskIDs = ids
+	default:
+		uc.log.Errorf("schedule %d(%s) has no template", info.ScheduleId, info.Name)
+		return
+	}
+
+	uc.log.Infof("schedule %d(%s) fired at %s, tasks=%v", info.ScheduleId, info.Name, at.Format(time.DateTime), taskIDs)
+
+	// 更新副本，避免与 ListSchedules 读取竞争
+	updated := cloneSchedule(info)
+	updated.LastRunAt = at.Format(time.DateTime)
+	updated.LastTaskIds = taskIDs
+	uc.cronMu.Lock()
+	e.info = updated
+	uc.cronMu.Unlock()
+
+	if err := uc.repo.UpdateScheduleRun(ctx, info.ScheduleId, at, taskIDs); err != nil {
+		uc.log.Warnf("schedule %d update last run: %v", info.ScheduleId, err)
+	}
+}
+
+// activeTask 返回仍未结束（排队/运行/暂停/生成报告）的任务
+func (uc *UseCase) activeTask(ids []string) (string, bool) {
+	for _, id := range ids {
+		t, ok := uc.taskPool.Get(id)
+		if !ok {
+			continue
+		}
+		switch t.GetStatus() {
+		case v1.TaskStatus_TASK_PENDING, v1.TaskStatus_TASK_RUNNING, v1.TaskStatus_TASK_PAUSED, v1.TaskStatus_TASK_PROCESSING:
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// CreateSchedule 校验并保存定时任务
+func (uc *UseCase) CreateSchedule(ctx context.Context, s *v1.Schedule) (*v1.Schedule, error) {
+	spec, err := schedule.Parse(s.Cron)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("cron %q never fires", s.Cron)
+	}
+
+	switch tmpl := s.Template.(type) {
+	case *v1.Schedule_Task:
+		if tmpl.Task.GetBetOrder() == nil {
+			return nil, fmt.Errorf("task template: bet_order is required")
+		}
+		if _, ok := uc.GetGame(tmpl.Task.GameId); !ok {
+			return nil, fmt.Errorf("task template: game not found: %d", tmpl.Task.GameId)
+		}
+		if err := uc.validateTask(tmpl.Task); err != nil {
+			return nil, fmt.Errorf("task template: %w", err)
+		}
+	case *v1.Schedule_Bench:
+		// 与 Bench 接口一致：请求字段规则、目标游戏与每个游戏的任务配置
+		if tmpl.Bench == nil {
+			return nil, fmt.Errorf("bench template is required")
+		}
+		if err := tmpl.Bench.Validate(); err != nil {
+			return nil, fmt.Errorf("bench template: %w", err)
+		}
+		targets, err := uc.benchTargets(tmpl.Bench)
+		if err != nil {
+			return nil, fmt.Errorf("bench template: %w", err)
+		}
+		for _, g := range targets {
+			if err := uc.validateTask(benchConfig(g, tmpl.Bench)); err != nil {
+				return nil, fmt.Errorf("bench template: game %d: %w", g.GameID(), err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("template is required")
+	}
+
+	info := cloneSchedule(s)
+	id, err := uc.repo.CreateSchedule(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	info.ScheduleId = id
+	info.CreatedAt = time.Now().Format(time.DateTime)
+
+	uc.cronMu.Lock()
+	uc.crons[id] = &cronEntry{spec: spec, info: info}
+	uc.cronMu.Unlock()
+
+	uc.log.Infof("schedule %d(%s) created, cron=%q", id, info.Name, info.Cron)
+	return withNextRun(info, spec), nil
+}
+
+// ListSchedules 返回全部定时任务（按 ID 升序，含下次触发时间）
+func (uc *UseCase) ListSchedules() []*v1.Schedule {
+	uc.cronMu.Lock()
+	out := make([]*v1.Schedule, 0, len(uc.crons))
+	for _, e := range uc.crons {
+		out = append(out, withNextRun(e.info, e.spec))
+	}
+	uc.cronMu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ScheduleId < out[j].ScheduleId })
+	return out
+}
+
+// DeleteSchedule 删除定时任务（已创建的任务不受影响）
+func (uc *UseCase) DeleteSchedule(ctx context.Context, id int64) error {
+	if err := uc.repo.DeleteSchedule(ctx, id); err != nil {
+		return err
+	}
+	uc.cronMu.Lock()
+	delete(uc.crons, id)
+	uc.cronMu.Unlock()
+	return nil
+}
+
+func cloneSchedule(s *v1.Schedule) *v1.Schedule {
+	return &v1.Schedule{
+		ScheduleId:  s.ScheduleId,
+		Name:        s.Name,
+		Cron:        s.Cron,
+		Template:    s.Template,
+		CreatedAt:   s.CreatedAt,
+		LastRunAt:   s.LastRunAt,
+		LastTaskIds: s.LastTaskIds,
+	}
+}
+
+func withNextRun(s *v1.Schedule, spec *schedule.Cron) *v1.Schedule {
+	c := cloneSchedule(s)
+	if next := spec.Next(time.Now()); !next.IsZero() {
+		c.NextRunAt = next.Format(time.DateTime)
+	}
+	return c
+}
@@ -0,0 +1,245 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron 标准 5 段 cron 表达式：分 时 日 月 周
+//
+//	字段      取值          说明
+//	minute   0-59
+//	hour     0-23
+//	dom      1-31
+//	month    1-12 / JAN-DEC
+//	dow      0-7 / SUN-SAT  0 和 7 都表示周日
+//
+// 每段支持 *、a、a-b、*/n、a-b/n、a/n（a 到最大值）及逗号列表；另支持 @hourly/@daily/@weekly/@monthly/@yearly。
+// 日与周同时受限时按 Vixie cron 语义取并集（任一匹配即触发），以 * 开头（含 */n）视为不受限。
+// 夏令时：跳过的本地时刻当天不触发；回拨重复的时段中，固定小时的表达式只在第一次出现时触发，小时为 * 的照常按每次出现触发
+type Cron struct {
+	expr    string
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	hourAny bool // hour 为 * 或 */n
+	domAny  bool // dom 为 * 或 */n
+	dowAny  bool // dow 为 * 或 */n
+}
+
+type field struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{min: 0, max: 59}
+	hourField   = field{min: 0, max: 23}
+	domField    = field{min: 1, max: 31}
+	monthField  = field{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = field{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	aliases = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+const (
+	maxSearchYears = 5             // Next 的最大搜索范围（如 2 月 30 日这类永不触发的表达式）
+	maxDSTShift    = 2 * time.Hour // 夏令时切换的最大偏移
+)
+
+// Parse 解析 cron 表达式
+func Parse(expr string) (*Cron, error) {
+	spec := strings.TrimSpace(expr)
+	if a, ok := aliases[strings.ToLower(spec)]; ok {
+		spec = a
+	}
+	parts := strings.Fields(spec)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("cron %q: expected 5 fields, got %d", expr, len(parts))
+	}
+
+	c := &Cron{expr: strings.TrimSpace(expr)}
+	var err error
+	if c.minute, err = minuteField.parse(parts[0]); err != nil {
+		return nil, fmt.Errorf("cron %q minute: %w", expr, err)
+	}
+	if c.hour, err = hourField.parse(parts[1]); err != nil {
+		return nil, fmt.Errorf("cron %q hour: %w", expr, err)
+	}
+	if c.dom, err = domField.parse(parts[2]); err != nil {
+		return nil, fmt.Errorf("cron %q day-of-month: %w", expr, err)
+	}
+	if c.month, err = monthField.parse(parts[3]); err != nil {
+		return nil, fmt.Errorf("cron %q month: %w", expr, err)
+	}
+	if c.dow, err = dowField.parse(parts[4]); err != nil {
+		return nil, fmt.Errorf("cron %q day-of-week: %w", expr, err)
+	}
+	// 7 与 0 同为周日
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+	c.hourAny = isAny(parts[1])
+	c.domAny = isAny(parts[2])
+	c.dowAny = isAny(parts[4])
+	return c, nil
+}
+
+func isAny(s string) bool {
+	return s == "*" || strings.HasPrefix(s, "*/")
+}
+
+// String 原始表达式
+func (c *Cron) String() string { return c.expr }
+
+// Match 判断时间（精确到分钟）是否命中
+func (c *Cron) Match(t time.Time) bool {
+	return c.minute&(1<<uint(t.Minute())) != 0 &&
+		c.hour&(1<<uint(t.Hour())) != 0 &&
+		c.month&(1<<uint(t.Month())) != 0 &&
+		c.matchDay(t) &&
+		!c.repeated(t)
+}
+
+// repeated 固定小时的表达式在夏令时回拨后第二次出现的本地时刻（同一本地时刻只触发一次）
+func (c *Cron) repeated(t time.Time) bool {
+	if c.hourAny {
+		return false
+	}
+	_, off := t.Zone()
+	_, before := t.Add(-maxDSTShift).Zone()
+	if before <= off {
+		return false
+	}
+	prev := t.Add(-time.Duration(before-off) * time.Second)
+	return prev.Hour() == t.Hour() && prev.Minute() == t.Minute()
+}
+
+func (c *Cron) matchDay(t time.Time) bool {
+	domOK := c.dom&(1<<uint(t.Day())) != 0
+	dowOK := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return domOK && dowOK
+	}
+	return domOK || dowOK
+}
+
+// Next 返回严格晚于 t 的下一次触发时间（分钟精度，沿用 t 的时区）；永不触发返回零值
+func (c *Cron) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			// 按本地分钟推进到下一个整点：Truncate 以 UTC 为基准，time.Date 在回拨重复的时段可能取到第二次出现
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 || c.repeated(t) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// parse 解析单个字段为位图
+func (f field) parse(s string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(s, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func (f field) parsePart(s string) (uint64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty expression")
+	}
+
+	rangeExpr, step, stepped := s, 1, false
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		n, err := strconv.Atoi(s[i+1:])
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid step %q", s[i+1:])
+		}
+		rangeExpr, step, stepped = s[:i], n, true
+	}
+
+	var lo, hi int
+	switch {
+	case rangeExpr == "*":
+		lo, hi = f.min, f.max
+	case strings.Contains(rangeExpr, "-"):
+		bounds := strings.SplitN(rangeExpr, "-", 2)
+		var err error
+		if lo, err = f.value(bounds[0]); err != nil {
+			return 0, err
+		}
+		if hi, err = f.value(bounds[1]); err != nil {
+			return 0, err
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("invalid range %q", rangeExpr)
+		}
+	default:
+		v, err := f.value(rangeExpr)
+		if err != nil {
+			return 0, err
+		}
+		lo, hi = v, v
+		if stepped { // a/n 表示从 a 开始到最大值
+			hi = f.max
+		}
+	}
+
+	var bits uint64
+	for v := lo; v <= hi; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+func (f field) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d,%d]", v, f.min, f.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,243 @@
+package schedule
+
+import (
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata" // 夏令时用例不依赖系统时区库
+)
+
+func TestParseInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"*/ * * * *",
+		"5- * * * *",
+		"-5 * * * *",
+		"1-2-3 * * * *",
+		"0-60 * * * *",
+		"1,,2 * * * *",
+		"mon * * * *",
+		"* * * * SAT-SUN",
+		"* * * JAN-FEB-MAR *",
+		"0 0 * * * *",
+	} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) should fail", expr)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	loc := time.UTC
+	base := time.Date(2024, 1, 31, 22, 17, 30, 0, loc) // 周三
+
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 31, 22, 18, 0, 0, loc)},
+		{"*/15 * * * *", time.Date(2024, 1, 31, 22, 30, 0, 0, loc)},
+		{"0 20 * * *", time.Date(2024, 2, 1, 20, 0, 0, 0, loc)},
+		{"30 21 * * 1-5", time.Date(2024, 2, 1, 21, 30, 0, 0, loc)},
+		{"0 9 * * SAT,sun", time.Date(2024, 2, 3, 9, 0, 0, 0, loc)},
+		{"0 0 * * 7", time.Date(2024, 2, 4, 0, 0, 0, 0, loc)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, loc)},
+		{"0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, loc)},
+		{"@daily", time.Date(2024, 2, 1, 0, 0, 0, 0, loc)},
+		{"5/20 22 * * *", time.Date(2024, 1, 31, 22, 25, 0, 0, loc)},
+		// 日与周同时受限：任一命中即可（2 月 1 日是周四，先于 15 日）
+		{"0 0 15 * 4", time.Date(2024, 2, 1, 0, 0, 0, 0, loc)},
+	}
+	for _, c := range cases {
+		cr, err := Parse(c.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.expr, err)
+		}
+		if got := cr.Next(base); !got.Equal(c.want) {
+			t.Errorf("%q.Next = %v, want %v", c.expr, got, c.want)
+		}
+		if !cr.Match(c.want) {
+			t.Errorf("%q should match %v", c.expr, c.want)
+		}
+	}
+}
+
+// TestNextNonHourOffset 非整点偏移时区（+05:30）下按本地整点推进
+func TestNextNonHourOffset(t *testing.T) {
+	loc := time.FixedZone("IST", 5*3600+30*60)
+	cases := []struct {
+		expr string
+		base time.Time
+		want time.Time
+	}{
+		{"0 11 * * *", time.Date(2024, 3, 1, 9, 40, 0, 0, loc), time.Date(2024, 3, 1, 11, 0, 0, 0, loc)},
+		{"0 11 * * *", time.Date(2024, 3, 1, 11, 0, 0, 0, loc), time.Date(2024, 3, 2, 11, 0, 0, 0, loc)},
+		{"15 * * * *", time.Date(2024, 3, 1, 9, 20, 0, 0, loc), time.Date(2024, 3, 1, 10, 15, 0, 0, loc)},
+		{"0 0 * * *", time.Date(2024, 3, 1, 23, 59, 0, 0, loc), time.Date(2024, 3, 2, 0, 0, 0, 0, loc)},
+	}
+	for _, c := range cases {
+		cr, err := Parse(c.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.expr, err)
+		}
+		if got := cr.Next(c.base); !got.Equal(c.want) {
+			t.Errorf("%q.Next(%v) = %v, want %v", c.expr, c.base, got, c.want)
+		}
+	}
+}
+
+func TestNextNever(t *testing.T) {
+	cr, err := Parse("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if next := cr.Next(time.Now()); !next.IsZero() {
+		t.Fatalf("Feb 30 should never fire, got %v", next)
+	}
+}
+
+// nexts 从 base 起连续取 n 次触发时间
+func nexts(t *testing.T, expr string, base time.Time, n int) []time.Time {
+	t.Helper()
+	cr, err := Parse(expr)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", expr, err)
+	}
+	var out []time.Time
+	for range n {
+		base = cr.Next(base)
+		out = append(out, base)
+	}
+	return out
+}
+
+func checkNexts(t *testing.T, expr string, base time.Time, want ...time.Time) {
+	t.Helper()
+	got := nexts(t, expr, base, len(want))
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("%q from %v: got %v, want %v", expr, base, got, want)
+			return
+		}
+	}
+}
+
+// bits 字段位图展开为取值列表
+func bits(b uint64) []int {
+	var out []int
+	for v := 0; v < 64; v++ {
+		if b&(1<<uint(v)) != 0 {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func TestFieldStepsAndRanges(t *testing.T) {
+	cases := []struct {
+		f    field
+		expr string
+		want []int
+	}{
+		{minuteField, "*/15", []int{0, 15, 30, 45}},
+		{minuteField, "5/20", []int{5, 25, 45}},
+		{minuteField, "10-20/5", []int{10, 15, 20}},
+		{minuteField, "10-21/5", []int{10, 15, 20}},
+		{minuteField, "58-59,0-1", []int{0, 1, 58, 59}},
+		{minuteField, "59/30", []int{59}},
+		{minuteField, "*/60", []int{0}},
+		{minuteField, "7,7,7", []int{7}},
+		{hourField, "20/1", []int{20, 21, 22, 23}},
+		{hourField, "*/7", []int{0, 7, 14, 21}},
+		{domField, "*/10", []int{1, 11, 21, 31}},
+		{domField, "31", []int{31}},
+		{monthField, "nov-DEC", []int{11, 12}},
+		{monthField, "*/4", []int{1, 5, 9}},
+		{dowField, "MON-FRI/2", []int{1, 3, 5}},
+		{dowField, "5-7", []int{5, 6, 7}},
+	}
+	for _, c := range cases {
+		b, err := c.f.parse(c.expr)
+		if err != nil {
+			t.Fatalf("parse(%q): %v", c.expr, err)
+		}
+		if got := bits(b); !slices.Equal(got, c.want) {
+			t.Errorf("parse(%q) = %v, want %v", c.expr, got, c.want)
+		}
+	}
+
+	// 7 归一为周日
+	cr, err := Parse("0 0 * * 5-7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bits(cr.dow); !slices.Equal(got, []int{0, 5, 6}) {
+		t.Fatalf("dow 5-7 = %v, want [0 5 6]", got)
+	}
+}
+
+// TestDayOfMonthOrWeek 日与周都受限时取并集；任一以 * 开头时取交集
+func TestDayOfMonthOrWeek(t *testing.T) {
+	base := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC) // 周三
+	day := func(m time.Month, d int) time.Time { return time.Date(2024, m, d, 0, 0, 0, 0, time.UTC) }
+
+	checkNexts(t, "0 0 13 * 5", base, day(2, 2), day(2, 9), day(2, 13), day(2, 16))
+	checkNexts(t, "0 0 13 * *", base, day(2, 13), day(3, 13))
+	checkNexts(t, "0 0 * * 5", base, day(2, 2), day(2, 9))
+	// */10 视为不受限：1/11/21/31 日中的周一
+	checkNexts(t, "0 0 */10 * 1", base, day(3, 11), day(4, 1))
+	// 周为 */2：每月 1 日且为周日/二/四/六
+	checkNexts(t, "0 0 1 * */2", base, day(2, 1), day(6, 1))
+	// 31 日只在大月触发
+	checkNexts(t, "0 0 31 * *", base, day(3, 31), day(5, 31), day(7, 31))
+	// 2 月 29 日跨年到下一个闰年
+	checkNexts(t, "0 0 29 2 *", day(3, 1), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC))
+}
+
+// TestNextDST 夏令时：跳过的本地时刻当天不触发，回拨重复的时段固定小时只触发一次
+func TestNextDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(loc *time.Location, m time.Month, d, h, min int) time.Time {
+		return time.Date(2024, m, d, h, min, 0, 0, loc)
+	}
+	edt, est := time.FixedZone("EDT", -4*3600), time.FixedZone("EST", -5*3600)
+
+	// 纽约 2024-03-10 02:00 跳到 03:00
+	checkNexts(t, "30 2 * * *", at(ny, 3, 9, 12, 0), at(ny, 3, 11, 2, 30))
+	checkNexts(t, "0 3 * * *", at(ny, 3, 10, 1, 0), at(ny, 3, 10, 3, 0))
+	checkNexts(t, "*/30 * * * *", at(ny, 3, 10, 1, 15), at(ny, 3, 10, 1, 30), at(ny, 3, 10, 3, 0))
+
+	// 纽约 2024-11-03 02:00 EDT 回拨到 01:00 EST
+	checkNexts(t, "30 1 * * *", at(ny, 11, 3, 0, 0), at(edt, 11, 3, 1, 30), at(ny, 11, 4, 1, 30))
+	checkNexts(t, "0 2 * * *", at(ny, 11, 3, 0, 0), at(ny, 11, 3, 2, 0), at(ny, 11, 4, 2, 0))
+	// 小时为 * 时重复的时段照常触发
+	checkNexts(t, "15 * * * *", at(edt, 11, 3, 0, 30), at(edt, 11, 3, 1, 15), at(est, 11, 3, 1, 15), at(ny, 11, 3, 2, 15))
+
+	// 柏林 2024-10-27 03:00 CEST 回拨到 02:00 CET：须取第一次出现的 02:30（CEST）
+	cest := time.FixedZone("CEST", 2*3600)
+	checkNexts(t, "30 2 * * *", at(berlin, 10, 27, 0, 0), at(cest, 10, 27, 2, 30), at(berlin, 10, 28, 2, 30))
+
+	cr, err := Parse("30 1 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cr.Match(at(edt, 11, 3, 1, 30).In(ny)) || cr.Match(at(est, 11, 3, 1, 30).In(ny)) {
+		t.Fatal("repeated 01:30 should match only the first occurrence")
+	}
+}
@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game"
+	"stress/internal/biz/game/base"
+	"stress/internal/biz/schedule"
+	"stress/internal/biz/task"
+	"stress/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// logRecorder 记录日志内容
+type logRecorder struct {
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (l *logRecorder) Log(_ log.Level, keyvals ...any) error {
+	l.mu.Lock()
+	l.msgs = append(l.msgs, fmt.Sprint(keyvals...))
+	l.mu.Unlock()
+	return nil
+}
+
+func (l *logRecorder) contains(s string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, m := range l.msgs {
+		if strings.Contains(m, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestRunScheduleSkipsActivePrevious 上次触发的任务未结束时跳过本次，已结束则照常创建任务
+func TestRunScheduleSkipsActivePrevious(t *testing.T) {
+	spec, err := schedule.Parse("* * * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		status  v1.TaskStatus
+		skipped bool
+	}{
+		{v1.TaskStatus_TASK_PENDING, true},
+		{v1.TaskStatus_TASK_RUNNING, true},
+		{v1.TaskStatus_TASK_PAUSED, true},
+		{v1.TaskStatus_TASK_PROCESSING, true},
+		{v1.TaskStatus_TASK_COMPLETED, false},
+		{v1.TaskStatus_TASK_FAILED, false},
+		{v1.TaskStatus_TASK_CANCELLED, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			logs := &logRecorder{}
+			uc := &UseCase{
+				ctx:      context.Background(),
+				log:      log.NewHelper(logs),
+				gamePool: game.NewPool(func(context.Context, []int64) (map[int64][]float64, error) { return nil, nil }),
+				taskPool: task.NewTaskPool(nil),
+			}
+			prev, err := task.NewTask(context.Background(), "prev", base.NewBaseGame(99999, "prev"), &v1.TaskConfig{GameId: 99999, MemberCount: 1, TimesPerMember: 1}, log.DefaultLogger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prev.SetStatus(tt.status)
+			uc.taskPool.Add(prev)
+
+			info := &v1.Schedule{
+				ScheduleId:  1,
+				Name:        "nightly",
+				LastTaskIds: []string{"prev"},
+				Template:    &v1.Schedule_Task{Task: &v1.TaskConfig{GameId: 99999}},
+			}
+			e := &cronEntry{spec: spec, info: info, firing: true}
+			uc.runSchedule(e, time.Now())
+
+			if e.firing {
+				t.Fatal("firing should be reset")
+			}
+			if e.info != info {
+				t.Fatal("schedule info should stay unchanged")
+			}
+			if got := logs.contains("previous run prev still in progress"); got != tt.skipped {
+				t.Fatalf("skipped = %v, want %v", got, tt.skipped)
+			}
+			// 未跳过时进入创建任务（测试池中无该游戏）
+			if got := logs.contains("create task: game not found"); got == tt.skipped {
+				t.Fatalf("create attempted = %v, want %v", got, !tt.skipped)
+			}
+		})
+	}
+}
+
+// scheduleRepo 只实现定时任务的保存
+type scheduleRepo struct{ DataRepo }
+
+func (scheduleRepo) CreateSchedule(context.Context, *v1.Schedule) (int64, error) { return 1, nil }
+
+// TestCreateScheduleValidatesTemplate 模板按创建任务与 Bench 接口的规则校验，非法配置在创建时拒绝
+func TestCreateScheduleValidatesTemplate(t *testing.T) {
+	uc := &UseCase{
+		log:      log.NewHelper(log.DefaultLogger),
+		conf:     &conf.Stress{Launch: &conf.Stress_Launch{}, Member: &conf.Stress_Member{MaxLoadTotal: 100}},
+		repo:     envRepo{DataRepo: scheduleRepo{}, repos: map[string]task.Repo{"": nil}},
+		gamePool: game.NewPool(func(context.Context, []int64) (map[int64][]float64, error) { return nil, nil }),
+		crons:    make(map[int64]*cronEntry),
+	}
+	games := uc.ListGames()
+	if len(games) == 0 {
+		t.Skip("no registered games")
+	}
+	gameID := games[0].GameID()
+	taskTmpl := func(mod func(c *v1.TaskConfig)) *v1.Schedule_Task {
+		c := &v1.TaskConfig{GameId: gameID, MemberCount: 1, TimesPerMember: 1, BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1}}
+		mod(c)
+		return &v1.Schedule_Task{Task: c}
+	}
+
+	tests := []struct {
+		name string
+		s    *v1.Schedule
+		err  string
+	}{
+		{name: "valid task", s: &v1.Schedule{Template: taskTmpl(func(*v1.TaskConfig) {})}},
+		{name: "bad load profile", s: &v1.Schedule{Template: taskTmpl(func(c *v1.TaskConfig) { c.Load = &v1.LoadProfile{Rate: 10, RampUp: "abc"} })}, err: "ramp"},
+		{name: "negative duration", s: &v1.Schedule{Template: taskTmpl(func(c *v1.TaskConfig) { c.TimesPerMember, c.Duration = 0, "-1m" })}, err: "must not be negative"},
+		{name: "no target", s: &v1.Schedule{Template: taskTmpl(func(c *v1.TaskConfig) { c.TimesPerMember = 0 })}, err: "times_per_member or duration is required"},
+		{name: "member limit", s: &v1.Schedule{Template: taskTmpl(func(c *v1.TaskConfig) { c.MemberCount = 101 })}, err: "exceeds limit"},
+		{name: "unknown environment", s: &v1.Schedule{Template: taskTmpl(func(c *v1.TaskConfig) { c.Environment = "prod" })}, err: "unknown environment"},
+		{name: "valid bench", s: &v1.Schedule{Template: &v1.Schedule_Bench{Bench: &v1.BenchRequest{GameIds: []int64{gameID}, MemberCount: 1, TimesPerMember: 1}}}},
+		{name: "empty bench", s: &v1.Schedule{Template: &v1.Schedule_Bench{}}, err: "bench template is required"},
+		{name: "bench member count", s: &v1.Schedule{Template: &v1.Schedule_Bench{Bench: &v1.BenchRequest{MemberCount: 0, TimesPerMember: 1}}}, err: "MemberCount"},
+		{name: "bench unknown game", s: &v1.Schedule{Template: &v1.Schedule_Bench{Bench: &v1.BenchRequest{GameIds: []int64{-1}, MemberCount: 1, TimesPerMember: 1}}}, err: "no matching games"},
+		{name: "bench member limit", s: &v1.Schedule{Template: &v1.Schedule_Bench{Bench: &v1.BenchRequest{MemberCount: 101, TimesPerMember: 1}}}, err: "exceeds limit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.s.Name, tt.s.Cron = tt.name, "0 3 * * *"
+			_, err := uc.CreateSchedule(t.Context(), tt.s)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
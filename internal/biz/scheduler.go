@@ -21,44 +21,77 @@ func (uc *UseCase) scheduleLoop() {
 	}
 }
 
-// doSchedule 执行实际调度逻辑
+// schedulerLimits 调度限制（由 stress.scheduler 配置解析）
+type schedulerLimits struct {
+	maxRunning    int  // 最大并发任务数
+	gameExclusive bool // 同游戏互斥
+	maxMembers    int  // 运行中成员总数上限，0 不限制
+}
+
+func (uc *UseCase) schedulerLimits() schedulerLimits {
+	l := schedulerLimits{maxRunning: 1, gameExclusive: true}
+	c := uc.conf.GetScheduler()
+	if c == nil {
+		return l
+	}
+	if c.MaxRunningTasks > 0 {
+		l.maxRunning = int(c.MaxRunningTasks)
+	}
+	l.gameExclusive = c.GameExclusive
+	l.maxMembers = int(c.MaxInflightMembers)
+	return l
+}
+
+// doSchedule 执行实际调度逻辑：按排队顺序启动任务，直到并发数或成员资源不足。
+// 同游戏互斥时被占用游戏的任务顺延（不阻塞其他游戏）；成员不足时停止，避免大任务被小任务饿死
 func (uc *UseCase) doSchedule() {
-	for {
+	if uc.taskPool.IsPaused() {
+		return
+	}
+	limits := uc.schedulerLimits()
+
+	for _, t := range uc.taskPool.PendingTasks() {
 		select {
 		case <-uc.ctx.Done():
 			return
 		default:
 		}
 
-		// 单线程，控制宿主机cpu+内存; 控制一个任务在跑
-		if uc.taskPool.IsRateLimited(1) {
-			break
+		taskID := t.GetID()
+		config := t.GetConfig()
+		if t.GetStatus() != v1.TaskStatus_TASK_PENDING || config == nil {
+			uc.taskPool.DropPending(taskID)
+			continue
 		}
 
-		taskID, t, ok := uc.taskPool.PeekPending()
-		if !ok {
-			break
+		running, inflight := uc.taskPool.RunningStats()
+		if running >= limits.maxRunning {
+			return
 		}
-		if t == nil || t.GetStatus() != v1.TaskStatus_TASK_PENDING || t.GetConfig() == nil {
-			uc.taskPool.DropPendingHead()
+		if limits.gameExclusive && uc.taskPool.IsGameRunning(config.GameId) {
 			continue
 		}
-		config := t.GetConfig()
-		if !uc.memberPool.CanAllocate(int(config.MemberCount)) {
-			break
+		count := int(config.MemberCount)
+		if limits.maxMembers > 0 && inflight+count > limits.maxMembers {
+			return
 		}
-		if !uc.taskPool.DequeuePending(taskID) {
+		if !uc.memberPool.CanAllocate(count) {
+			return
+		}
+		if !uc.taskPool.DropPending(taskID) {
 			continue
 		}
-		allocated := uc.memberPool.Allocate(taskID, int(config.MemberCount))
+		allocated := uc.memberPool.Allocate(taskID, count)
 		if allocated == nil {
 			uc.taskPool.RequeueAtHead(taskID)
-			break
+			return
 		}
 		if !t.CompareAndSetStatus(v1.TaskStatus_TASK_PENDING, v1.TaskStatus_TASK_RUNNING) {
 			uc.memberPool.Release(taskID)
 			continue
 		}
+		// 同步登记，保证本轮后续判断能看到该任务的占用
+		uc.taskPool.MarkRunning(taskID, config.GameId, config.GetEnvironment(), count)
 		go uc.runTask(t, allocated)
 	}
 }
@@ -73,29 +106,70 @@ func (uc *UseCase) WakeScheduler() {
 
 // runTask 执行任务，cleanup 后通过回调唤醒调度
 func (uc *UseCase) runTask(t *task.Task, allocated []task.MemberInfo) {
-	// 任务开始运行，增加计数器
-	uc.taskPool.IncreaseRunningCount()
-
-	// 确保任务结束时减少计数器，即使 Execute 提前返回或 panic
+	// 确保任务结束时注销运行登记，即使 Execute 提前返回或 panic
 	defer func() {
-		uc.taskPool.DecreaseRunningCount()
+		uc.taskPool.MarkDone(t.GetID())
 		uc.WakeScheduler()
 	}()
 
+	env, err := uc.resolveEnvironment(t.GetConfig().GetEnvironment(), t.GetConfig().GetMerchant())
+	if err != nil {
+		t.Abort(fmt.Sprintf("resolve environment: %v", err))
+		uc.memberPool.Release(t.GetID())
+		return
+	}
 	deps := &task.ExecDeps{
-		Repo:          uc.repo,
+		Repo:          env.repo,
 		Conf:          uc.conf,
+		Launch:        env.launch,
+		Secret:        env.secret,
 		Notify:        uc.notify,
 		Chart:         uc.chart,
 		ReturnMembers: uc.memberPool.Release,
+		IsIdle:        uc.isIdleFunc(t.GetConfig().GetEnvironment()),
+		Sites:         uc.redisSites(t.GetConfig().GetEnvironment()),
 	}
 	t.Execute(allocated, deps)
 }
 
+// SubmitTask 校验配置（游戏存在、betsize、下注金额）后创建任务
+func (uc *UseCase) SubmitTask(ctx context.Context, config *v1.TaskConfig) (*task.Task, error) {
+	g, ok := uc.GetGame(config.GameId)
+	if !ok {
+		return nil, fmt.Errorf("game not found: %d", config.GameId)
+	}
+
+	// 确保 betsize 存在，如果没有则从数据库动态获取
+	if err := uc.EnsureBetSize(ctx, config.GameId); err != nil {
+		return nil, err
+	}
+
+	if !g.ValidBetMoney(config.GetBetOrder().GetBaseMoney()) {
+		return nil, fmt.Errorf("game_id=%d, invalid bet money: %.2f, betsize: %v",
+			config.GameId, config.GetBetOrder().GetBaseMoney(), g.BetSize())
+	}
+
+	return uc.CreateTask(ctx, g, config)
+}
+
+// validateTask 校验任务配置、成员数上限与目标环境（创建任务与定时任务模板共用）
+func (uc *UseCase) validateTask(config *v1.TaskConfig) error {
+	if config.MemberCount > uc.conf.Member.MaxLoadTotal {
+		return fmt.Errorf("member count %d exceeds limit %d", config.MemberCount, uc.conf.Member.MaxLoadTotal)
+	}
+	if limits := uc.schedulerLimits(); limits.maxMembers > 0 && int(config.MemberCount) > limits.maxMembers {
+		return fmt.Errorf("member count %d exceeds scheduler in-flight limit %d", config.MemberCount, limits.maxMembers)
+	}
+	if err := task.ValidateConfig(config); err != nil {
+		return err
+	}
+	return uc.validateTarget(config)
+}
+
 // CreateTask 创建并尝试运行
 func (uc *UseCase) CreateTask(ctx context.Context, g base.IGame, config *v1.TaskConfig) (*task.Task, error) {
-	if config.MemberCount > uc.conf.Member.MaxLoadTotal {
-		return nil, fmt.Errorf("member count %d exceeds limit %d", config.MemberCount, uc.conf.Member.MaxLoadTotal)
+	if err := uc.validateTask(config); err != nil {
+		return nil, err
 	}
 
 	taskID, err := uc.repo.NextTaskID(ctx, config.GameId)
@@ -113,17 +187,42 @@ func (uc *UseCase) CreateTask(ctx context.Context, g base.IGame, config *v1.Task
 	return t, nil
 }
 
-// DeleteTask 删除任务（异步，不等待 Execute 退出）
-func (uc *UseCase) DeleteTask(id string) error {
-	t, ok := uc.taskPool.Remove(id)
+// DeleteTask 删除任务及其历史记录（异步，不等待 Execute 退出）
+func (uc *UseCase) DeleteTask(ctx context.Context, id string) error {
+	if t, ok := uc.taskPool.Remove(id); ok {
+		// 停止任务上下文，触发 Execute 退出
+		t.Stop()
+		// 成员由 Execute.cleanup 释放，避免重复释放导致的数据混乱
+	}
+	return uc.repo.DeleteTaskHistory(ctx, id)
+}
+
+// PauseTask 暂停运行中的任务（成员与并发槽位保持占用）
+func (uc *UseCase) PauseTask(id string) error {
+	t, ok := uc.taskPool.Get(id)
 	if !ok {
-		return nil
+		return fmt.Errorf("task %s not found", id)
 	}
+	return t.Pause()
+}
 
-	// 停止任务上下文，触发 Execute 退出
-	t.Stop()
-	// 成员由 Execute.cleanup 释放，避免重复释放导致的数据混乱
-	return nil
+// ResumeTask 恢复已暂停的任务
+func (uc *UseCase) ResumeTask(id string) error {
+	t, ok := uc.taskPool.Get(id)
+	if !ok {
+		return fmt.Errorf("task %s not found", id)
+	}
+	return t.Resume()
+}
+
+// ListSessions 查看任务内各成员会话，任务需仍在内存中
+func (uc *UseCase) ListSessions(id string, f task.SessionFilter) ([]*v1.SessionInfo, int, error) {
+	t, ok := uc.taskPool.Get(id)
+	if !ok {
+		return nil, 0, fmt.Errorf("task %s not found", id)
+	}
+	list, total := t.Sessions(f)
+	return list, total, nil
 }
 
 // CancelTask 取消任务（异步，不等待 Execute 退出）
@@ -0,0 +1,136 @@
+// Package secret 商户 launch 签名密钥：从主库 merchant 表、配置或文件加载，缓存并定期刷新
+package secret
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"stress/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 密钥来源
+const (
+	SourceDB     = "db"
+	SourceConfig = "config"
+	SourceFile   = "file"
+)
+
+const defaultRefreshInterval = 5 * time.Minute
+
+// Repo 数据层接口
+type Repo interface {
+	// ListMerchantSecrets 从主库 merchant 表读取 merchant -> secret
+	ListMerchantSecrets(ctx context.Context) (map[string]string, error)
+}
+
+// Loader 加载全部商户密钥
+type Loader func(ctx context.Context) (map[string]string, error)
+
+// Provider 商户密钥缓存；Get 可直接作为 base.SecretProvider 使用，刷新失败时保留上次结果
+type Provider struct {
+	source   string
+	load     Loader
+	interval time.Duration
+
+	mu      sync.RWMutex
+	secrets map[string]string
+}
+
+// NewProvider 按配置选择来源；c 为空时从 merchant 表加载
+func NewProvider(c *conf.Stress_Secrets, repo Repo) (*Provider, error) {
+	p := &Provider{source: c.GetSource(), interval: c.GetRefreshInterval().AsDuration()}
+	if p.source == "" {
+		p.source = SourceDB
+	}
+	if p.interval <= 0 {
+		p.interval = defaultRefreshInterval
+	}
+
+	switch p.source {
+	case SourceDB:
+		p.load = repo.ListMerchantSecrets
+	case SourceConfig:
+		secrets := maps.Clone(c.GetMerchants())
+		p.load = func(context.Context) (map[string]string, error) { return secrets, nil }
+	case SourceFile:
+		if c.GetFile() == "" {
+			return nil, fmt.Errorf("secrets.file is required for source %q", SourceFile)
+		}
+		path := c.File
+		p.load = func(context.Context) (map[string]string, error) { return loadFile(path) }
+	default:
+		return nil, fmt.Errorf("unknown secrets source %q", p.source)
+	}
+	return p, nil
+}
+
+// loadFile 读取 YAML / JSON 文件（merchant: secret）
+func loadFile(path string) (map[string]string, error) {
+	c := config.New(config.WithSource(file.NewSource(path)))
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	var secrets map[string]string
+	if err := c.Scan(&secrets); err != nil {
+		return nil, fmt.Errorf("scan %s: %w", path, err)
+	}
+	return secrets, nil
+}
+
+// Get 返回商户密钥
+func (p *Provider) Get(merchant string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	s, ok := p.secrets[merchant]
+	return s, ok && s != ""
+}
+
+// Refresh 重新加载全部密钥
+func (p *Provider) Refresh(ctx context.Context) error {
+	secrets, err := p.load(ctx)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.secrets = secrets
+	p.mu.Unlock()
+	return nil
+}
+
+// Len 已缓存的商户数
+func (p *Provider) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.secrets)
+}
+
+// StartAutoRefresh 按间隔刷新（首次加载由调用方同步完成），ctx 取消后退出
+func (p *Provider) StartAutoRefresh(ctx context.Context, logger log.Logger) {
+	l := log.NewHelper(logger)
+	refresh := func() {
+		if err := p.Refresh(ctx); err != nil {
+			l.Warnf("refresh merchant secrets (source=%s): %v", p.source, err)
+			return
+		}
+		l.Debugf("merchant secrets refreshed (source=%s): %d merchants", p.source, p.Len())
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refresh()
+		}
+	}
+}
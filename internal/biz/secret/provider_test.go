@@ -0,0 +1,53 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"stress/internal/conf"
+)
+
+func TestProviderSources(t *testing.T) {
+	p, err := NewProvider(&conf.Stress_Secrets{Source: SourceConfig, Merchants: map[string]string{"m1": "s1", "m2": ""}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Refresh(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := p.Get("m1"); !ok || s != "s1" {
+		t.Fatalf("m1 = %q, %v", s, ok)
+	}
+	if _, ok := p.Get("m2"); ok {
+		t.Fatal("empty secret should be missing")
+	}
+
+	path := filepath.Join(t.TempDir(), "secrets.yaml")
+	if err := os.WriteFile(path, []byte("m3: s3\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err = NewProvider(&conf.Stress_Secrets{Source: SourceFile, File: path}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Refresh(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := p.Get("m3"); !ok || s != "s3" {
+		t.Fatalf("m3 = %q, %v", s, ok)
+	}
+
+	// 刷新失败保留上次结果
+	_ = os.Remove(path)
+	if err := p.Refresh(t.Context()); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+	if _, ok := p.Get("m3"); !ok {
+		t.Fatal("previous secrets should be kept")
+	}
+
+	if _, err := NewProvider(&conf.Stress_Secrets{Source: "vault"}, nil); err == nil {
+		t.Fatal("expected error for unknown source")
+	}
+}
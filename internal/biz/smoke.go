@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/task"
+)
+
+const (
+	smokeTimeout      = 2 * time.Minute // 单次冒烟测试超时
+	defaultSmokeSpins = 1
+	smokeHolderPrefix = "smoke-" // 从成员池借用成员时的占用 ID 前缀
+)
+
+// SmokeTest 用单个成员对游戏做一次完整流程验证，返回实际使用的成员与每步记录；
+// 未指定成员时从成员池借用一个，结束后归还。不依赖调用方 ctx 的超时（HTTP 超时远小于流程耗时）
+func (uc *UseCase) SmokeTest(ctx context.Context, in *v1.SmokeTestRequest) (string, []*v1.SmokeStep, error) {
+	g, ok := uc.GetGame(in.GameId)
+	if !ok {
+		return "", nil, fmt.Errorf("game not found: %d", in.GameId)
+	}
+
+	env, err := uc.resolveEnvironment(in.Environment, in.Merchant)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), smokeTimeout)
+	defer cancel()
+
+	if err := uc.EnsureBetSize(ctx, in.GameId); err != nil {
+		return "", nil, err
+	}
+	bet := in.BetOrder
+	if bet == nil {
+		bet = &v1.BetOrderConfig{BaseMoney: pickBaseMoney(g.BetSize()), Multiple: 1}
+	}
+	if !g.ValidBetMoney(bet.GetBaseMoney()) {
+		return "", nil, fmt.Errorf("game_id=%d, invalid bet money: %.2f, betsize: %v", in.GameId, bet.GetBaseMoney(), g.BetSize())
+	}
+	spins := in.Spins
+	if spins <= 0 {
+		spins = defaultSmokeSpins
+	}
+
+	member := in.Member
+	if member == "" {
+		holder := smokeHolderPrefix + strconv.FormatInt(time.Now().UnixNano(), 36)
+		allocated := uc.memberPool.Allocate(holder, 1)
+		if len(allocated) == 0 {
+			return "", nil, fmt.Errorf("no idle member available")
+		}
+		defer uc.memberPool.Release(holder)
+		member = allocated[0].Name
+	}
+
+	cfg := &v1.TaskConfig{
+		GameId:         in.GameId,
+		MemberCount:    1,
+		TimesPerMember: spins,
+		BetOrder:       bet,
+		Environment:    in.Environment,
+		Merchant:       in.Merchant,
+		Transport:      in.Transport,
+	}
+	steps, err := task.SmokeTest(ctx, g, cfg, member, env.launch, env.secret, uc.log.Logger())
+	if err != nil {
+		uc.log.Warnf("smoke test game_id=%d member=%s failed after %d steps: %v", in.GameId, member, len(steps), err)
+	}
+	return member, steps, err
+}
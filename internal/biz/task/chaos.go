@@ -0,0 +1,202 @@
+package task
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http/httptrace"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+const defaultChaosIdle = 5 * time.Minute
+
+// chaos 客户端故障注入（nil 表示未配置）；概率在创建后只读，计数原子更新
+type chaos struct {
+	sessionRate float64
+	drop        float64
+	abandon     float64
+	stale       float64
+	duplicate   float64
+	idle        float64
+	idleFor     time.Duration
+
+	sessions          int64
+	dropped           int64
+	abandoned         int64
+	staleSent         int64
+	staleAccepted     int64
+	duplicateSent     int64
+	duplicateAccepted int64
+	idled             int64
+	idleRejected      int64
+}
+
+func newChaos(c *v1.Chaos) (*chaos, error) {
+	if c == nil || c.DropConnection+c.AbandonBonus+c.StaleToken+c.DuplicateBet+c.IdleExpiry == 0 {
+		return nil, nil
+	}
+	idleFor, err := parseDuration("chaos.idle_duration", c.IdleDuration)
+	if err != nil {
+		return nil, err
+	}
+	if idleFor <= 0 {
+		idleFor = defaultChaosIdle
+	}
+	return &chaos{
+		sessionRate: c.SessionRate,
+		drop:        c.DropConnection,
+		abandon:     c.AbandonBonus,
+		stale:       c.StaleToken,
+		duplicate:   c.DuplicateBet,
+		idle:        c.IdleExpiry,
+		idleFor:     idleFor,
+	}, nil
+}
+
+// sampleSession 决定会话是否参与注入
+func (c *chaos) sampleSession() bool {
+	if c == nil || (c.sessionRate > 0 && c.sessionRate < 1 && rand.Float64() >= c.sessionRate) {
+		return false
+	}
+	atomic.AddInt64(&c.sessions, 1)
+	return true
+}
+
+// fire 参与注入的会话按概率触发（调用方保证 c 非 nil）
+func (c *chaos) fire(s *Session, rate float64) bool {
+	return s.chaotic && rate > 0 && rand.Float64() < rate
+}
+
+// stats 统计快照；未配置时返回 nil
+func (c *chaos) stats() *v1.ChaosStats {
+	if c == nil {
+		return nil
+	}
+	return &v1.ChaosStats{
+		Sessions:          atomic.LoadInt64(&c.sessions),
+		Dropped:           atomic.LoadInt64(&c.dropped),
+		Abandoned:         atomic.LoadInt64(&c.abandoned),
+		StaleSent:         atomic.LoadInt64(&c.staleSent),
+		StaleAccepted:     atomic.LoadInt64(&c.staleAccepted),
+		DuplicateSent:     atomic.LoadInt64(&c.duplicateSent),
+		DuplicateAccepted: atomic.LoadInt64(&c.duplicateAccepted),
+		Idled:             atomic.LoadInt64(&c.idled),
+		IdleRejected:      atomic.LoadInt64(&c.idleRejected),
+	}
+}
+
+// setExtraOrders 订单统计可用时写入额外订单数
+func setExtraOrders(rpt *v1.TaskCompletionReport) {
+	if rpt.Chaos != nil && rpt.OrderCount > 0 {
+		rpt.Chaos.ExtraOrders = rpt.OrderCount - rpt.Step
+	}
+}
+
+// dropper 请求写出后立即取消，服务端已收到请求但客户端不读取响应
+type dropper struct {
+	cancel context.CancelFunc
+	wrote  atomic.Bool
+}
+
+// withDrop 按 drop_connection 概率为本次请求挂上断开钩子，未触发时返回 nil
+func (c *chaos) withDrop(ctx context.Context, s *Session) (context.Context, *dropper) {
+	if c == nil || !c.fire(s, c.drop) {
+		return ctx, nil
+	}
+	d := &dropper{}
+	ctx, d.cancel = context.WithCancel(ctx)
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			d.wrote.Store(true)
+			d.cancel()
+		},
+	}), d
+}
+
+// done 释放钩子；返回 true 表示请求已发出并被主动断开（err 不计入会话错误与重试次数）
+func (d *dropper) done(c *chaos, s *Session, err error) bool {
+	if d == nil {
+		return false
+	}
+	d.cancel()
+	if err == nil || !d.wrote.Load() {
+		return false
+	}
+	atomic.AddInt64(&c.dropped, 1)
+	atomic.AddInt32(&s.TryTimes, -1)
+	return true
+}
+
+// abandonBonus bonus 中按概率放弃会话：会话直接结束，服务端残留未完成的 bonus；
+// 以失败结束，不计入完成成员数
+func (c *chaos) abandonBonus(s *Session) bool {
+	if c == nil || !c.fire(s, c.abandon) {
+		return false
+	}
+	atomic.AddInt64(&c.abandoned, 1)
+	s.setState(SessionStateFailed)
+	s.setLastError("chaos: abandoned during bonus")
+	return true
+}
+
+// sendStale 按概率用失效 token 额外请求一次 betorder（不影响会话状态）
+func (c *chaos) sendStale(env *SessionEnv, tr GameTransport, s *Session) {
+	if c == nil || !c.fire(s, c.stale) {
+		return
+	}
+	token := s.getStaleToken()
+	if token == "" {
+		return
+	}
+	atomic.AddInt64(&c.staleSent, 1)
+	if _, err := tr.BetOrder(env.ctx, env.cfg, token); err == nil {
+		atomic.AddInt64(&c.staleAccepted, 1)
+	}
+}
+
+// duplicateBet 按概率并发发送一次相同的 betorder；返回的 wait 在主请求结束后调用，传入主请求的错误
+func (c *chaos) duplicateBet(env *SessionEnv, tr GameTransport, s *Session) (wait func(error)) {
+	if c == nil || !c.fire(s, c.duplicate) {
+		return func(error) {}
+	}
+	atomic.AddInt64(&c.duplicateSent, 1)
+	var (
+		wg     sync.WaitGroup
+		dupErr error
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, dupErr = tr.BetOrder(env.ctx, env.cfg, s.getToken())
+	}()
+	return func(err error) {
+		wg.Wait()
+		if err == nil && dupErr == nil {
+			atomic.AddInt64(&c.duplicateAccepted, 1)
+		}
+	}
+}
+
+// idleBeforeBet 按概率空闲 idleFor；返回 false 表示等待期间任务取消
+func (c *chaos) idleBeforeBet(env *SessionEnv, s *Session) bool {
+	if c == nil || !c.fire(s, c.idle) {
+		return true
+	}
+	atomic.AddInt64(&c.idled, 1)
+	s.idled = true
+	return s.sleepOrCancel(c.idleFor, env)
+}
+
+// afterBet 空闲后首次得到结果的 betorder（主动断开的不算）：记录服务端是否拒绝
+func (c *chaos) afterBet(s *Session, err error) {
+	if c == nil || !s.idled {
+		return
+	}
+	s.idled = false
+	if err != nil {
+		atomic.AddInt64(&c.idleRejected, 1)
+	}
+}
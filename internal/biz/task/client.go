@@ -40,16 +40,6 @@ var (
 	NoopSecretProvider base.SecretProvider = func(string) (string, bool) { return "", false }
 )
 
-type APIError struct {
-	Op   string
-	Code int
-	Msg  string
-}
-
-func (e *APIError) Error() string {
-	return fmt.Sprintf("error: [op=%q, code=%d， msg=%s]", e.Op, e.Code, e.Msg)
-}
-
 type APIClient struct {
 	http         *http.Client
 	secret       base.SecretProvider
@@ -59,15 +49,45 @@ type APIClient struct {
 	betBonusURL  string
 	merchant     string
 	signRequired bool
+	wsURL        string
+	wsTLS        *tls.Config      // nil 时校验证书
+	transports   map[int64]string // gameID -> 传输方式
+	transport    string           // 本任务的传输方式，BindSessionEnv 时确定
+
+	env      *SessionEnv
+	observer Observer // 非 nil 时记录每次请求的原始报文（冒烟测试用）
+}
 
-	env *SessionEnv
+// Exchange 一次 HTTP 请求的原始报文
+type Exchange struct {
+	Op       string
+	URL      string
+	Request  []byte
+	Status   int
+	Response []byte
+	Latency  time.Duration
+	Err      error
 }
 
+// Observer 请求观察者，同步调用
+type Observer func(*Exchange)
+
+// exchangeSlotKey ctx 中的报文槽位（录制用，按请求粒度开启）
+type exchangeSlotKey struct{}
+
+// withExchangeSlot 本次请求结束后将原始报文写入 ex
+func withExchangeSlot(ctx context.Context, ex *Exchange) context.Context {
+	return context.WithValue(ctx, exchangeSlotKey{}, ex)
+}
+
+const maxObservedBody = 4 << 20 // 观察/录制时响应体读取上限
+
 type SessionEnv struct {
 	ctx      context.Context
 	cfg      *v1.TaskConfig
 	game     base.IGame
 	task     *Task
+	pacer    *Pacer
 	protobuf base.ProtobufConverter
 }
 
@@ -111,7 +131,18 @@ func NewAPIClient(capacity int, secretProvider base.SecretProvider, launchCfg *c
 		betBonusURL:  baseApiURL + "/api/game/betbonus",
 		merchant:     launchCfg.Merchant,
 		signRequired: launchCfg.SignRequired,
+		wsURL:        launchCfg.GetWsUrl(),
+		wsTLS:        wsTLSConfig(launchCfg),
+		transports:   launchCfg.GetTransports(),
+	}
+}
+
+// wsTLSConfig wss 连接默认校验证书，ws_insecure_skip_verify 时跳过（自签名测试环境）
+func wsTLSConfig(launchCfg *conf.Stress_Launch) *tls.Config {
+	if !launchCfg.GetWsInsecureSkipVerify() {
+		return nil
 	}
+	return &tls.Config{InsecureSkipVerify: true}
 }
 
 func (c *APIClient) BindSessionEnv(t *Task) error {
@@ -122,12 +153,18 @@ func (c *APIClient) BindSessionEnv(t *Task) error {
 	if cfg == nil {
 		return errors.New("task config is nil")
 	}
+	transport, err := ResolveTransport(cfg, c.transports, c.wsURL)
+	if err != nil {
+		return err
+	}
+	c.transport = transport
 	g := t.GetGame()
 	env := &SessionEnv{
-		ctx:  t.Context(),
-		cfg:  cfg,
-		game: g,
-		task: t,
+		ctx:   t.Context(),
+		cfg:   cfg,
+		game:  g,
+		task:  t,
+		pacer: t.pacer,
 	}
 	if g != nil {
 		env.protobuf = g.GetProtobufConverter()
@@ -140,6 +177,19 @@ func (c *APIClient) Env() *SessionEnv {
 	return c.env
 }
 
+// SetObserver 设置请求观察者；开启后响应体会完整读入内存，不用于正式压测
+func (c *APIClient) SetObserver(o Observer) {
+	c.observer = o
+}
+
+// exchangeSink 本次请求的报文接收方：ctx 绑定的槽位优先，其次为观察者；均无时返回 nil
+func (c *APIClient) exchangeSink(ctx context.Context) Observer {
+	if slot, ok := ctx.Value(exchangeSlotKey{}).(*Exchange); ok {
+		return func(ex *Exchange) { *slot = *ex }
+	}
+	return c.observer
+}
+
 type launchParams struct {
 	GameID    int64  `json:"gameId"`
 	Merchant  string `json:"merchant"`
@@ -162,8 +212,10 @@ type apiResponse struct {
 	Type  int                 `json:"type,omitempty"`
 }
 
-func (c *APIClient) request(ctx context.Context, method, apiURL string, body any, token string, sign bool) (*apiResponse, error) {
+func (c *APIClient) request(ctx context.Context, op, method, apiURL string, body any, token string, sign bool) (*apiResponse, error) {
+	sink := c.exchangeSink(ctx)
 	var bodyReader io.Reader
+	var reqBody []byte // 仅观察/录制时保留
 	if body != nil {
 		buf := jsonBufferPool.Get().(*bytes.Buffer)
 		defer func() {
@@ -175,6 +227,9 @@ func (c *APIClient) request(ctx context.Context, method, apiURL string, body any
 			return nil, err
 		}
 		bodyReader = bytes.NewReader(buf.Bytes())
+		if sink != nil {
+			reqBody = bytes.Clone(buf.Bytes())
+		}
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, apiURL, bodyReader)
@@ -200,20 +255,53 @@ func (c *APIClient) request(ctx context.Context, method, apiURL string, body any
 		}
 	}
 
+	start := time.Now()
 	resp, err := c.http.Do(req)
 	if err != nil {
+		err = &TransportError{Op: op, Err: err}
+		if sink != nil {
+			sink(&Exchange{Op: op, URL: apiURL, Request: reqBody, Latency: time.Since(start), Err: err})
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if sink != nil {
+		return observeResponse(sink, op, apiURL, reqBody, resp, start)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		io.CopyN(io.Discard, resp.Body, 1024) // 只丢弃前1KB，避免大响应体阻塞
-		return nil, fmt.Errorf("http status %d", resp.StatusCode)
+		return nil, &HTTPStatusError{Op: op, Status: resp.StatusCode}
 	}
 
 	var res apiResponse
 	if err := jsonAPI.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return nil, err
+		return nil, &DecodeError{Op: op, Err: err}
+	}
+	return &res, nil
+}
+
+// observeResponse 完整读取响应体后解析，并将报文交给 sink
+func observeResponse(sink Observer, op, apiURL string, reqBody []byte, resp *http.Response, start time.Time) (*apiResponse, error) {
+	ex := &Exchange{Op: op, URL: apiURL, Request: reqBody, Status: resp.StatusCode}
+	defer func() { sink(ex) }()
+
+	ex.Response, ex.Err = io.ReadAll(io.LimitReader(resp.Body, maxObservedBody))
+	ex.Latency = time.Since(start)
+	if ex.Err != nil {
+		ex.Err = &TransportError{Op: op, Err: ex.Err}
+		return nil, ex.Err
+	}
+	if resp.StatusCode != http.StatusOK {
+		ex.Err = &HTTPStatusError{Op: op, Status: resp.StatusCode}
+		return nil, ex.Err
+	}
+
+	var res apiResponse
+	if err := jsonAPI.Unmarshal(ex.Response, &res); err != nil {
+		ex.Err = &DecodeError{Op: op, Err: err}
+		return nil, ex.Err
 	}
 	return &res, nil
 }
@@ -226,40 +314,49 @@ func (c *APIClient) Launch(ctx context.Context, cfg *v1.TaskConfig, member strin
 		Timestamp: time.Now().Unix(),
 	}
 
-	res, err := c.request(ctx, http.MethodPost, c.launchURL, params, "", c.signRequired)
+	res, err := c.request(ctx, OpLaunch, http.MethodPost, c.launchURL, params, "", c.signRequired)
 	if err != nil {
 		return "", err
 	}
 	if res.Code != 0 {
-		return "", &APIError{Op: "launch", Code: res.Code, Msg: res.Msg}
+		return "", &APIError{Op: OpLaunch, Code: res.Code, Msg: res.Msg}
 	}
 
 	var data struct {
 		LaunchUrl string `json:"launchUrl"`
 	}
 	if err := jsonAPI.Unmarshal(res.Data, &data); err != nil {
-		return "", fmt.Errorf("failed to unmarshal launch response: %w", err)
+		return "", &DecodeError{Op: OpLaunch, Err: fmt.Errorf("unmarshal launch response: %w", err)}
 	}
 
 	path, _ := url.QueryUnescape(data.LaunchUrl)
 	parsed, err := url.Parse(path)
 	if err != nil {
-		return "", err
+		return "", &DecodeError{Op: OpLaunch, Err: err}
 	}
 	tk := parsed.Query().Get("token")
 	if tk == "" {
-		return "", errors.New("empty token")
+		return "", &DecodeError{Op: OpLaunch, Err: errors.New("empty token")}
 	}
 	return strings.ReplaceAll(tk, " ", "+"), nil
 }
 
 func (c *APIClient) Login(ctx context.Context, cfg *v1.TaskConfig, token string) (string, map[string]any, error) {
-	res, err := c.request(ctx, http.MethodPost, c.loginURL, map[string]any{"token": token}, "", false)
+	res, err := c.request(ctx, OpLogin, http.MethodPost, c.loginURL, loginParams(token), "", false)
 	if err != nil {
 		return "", nil, err
 	}
+	return parseLogin(res)
+}
+
+func loginParams(token string) map[string]any {
+	return map[string]any{"token": token}
+}
+
+// parseLogin 解析登录响应，返回会话 token 与 freeData
+func parseLogin(res *apiResponse) (string, map[string]any, error) {
 	if res.Code != 0 {
-		return "", nil, fmt.Errorf("login error: %s", res.Msg)
+		return "", nil, &APIError{Op: OpLogin, Code: res.Code, Msg: res.Msg}
 	}
 
 	var data struct {
@@ -267,90 +364,146 @@ func (c *APIClient) Login(ctx context.Context, cfg *v1.TaskConfig, token string)
 		FreeData map[string]any `json:"freeData"`
 	}
 	if err := jsonAPI.Unmarshal(res.Data, &data); err != nil {
-		return "", nil, fmt.Errorf("failed to unmarshal login response: %w", err)
+		return "", nil, &DecodeError{Op: OpLogin, Err: fmt.Errorf("unmarshal login response: %w", err)}
 	}
 	return strings.ReplaceAll(data.Token, " ", "+"), data.FreeData, nil
 }
 
-type BetOrderError struct {
-	Code          int
-	Msg           string
-	NeedRelogin   bool
-	NeedRelaunch  bool
-	SleepDuration time.Duration
-}
-
-func (e *BetOrderError) Error() string {
-	return fmt.Sprintf("betorder error: code=%d msg=%s", e.Code, e.Msg)
+func decodeProtobuf(conv base.ProtobufConverter, gameID int64, bytesData string) (map[string]any, error) {
+	if conv == nil {
+		return nil, fmt.Errorf("protobuf converter is nil for game %d", gameID)
+	}
+	protoBytes, err := decodeBytes(gameID, bytesData)
+	if err != nil {
+		return nil, err
+	}
+	result, err := conv(protoBytes)
+	if err != nil {
+		return nil, &DecodeError{Op: OpBetOrder, Err: err}
+	}
+	return result, nil
 }
 
-func (c *APIClient) decodeProtobuf(cfg *v1.TaskConfig, bytesData string) (map[string]any, error) {
+// decodeBytes 解码响应的 bytes 字段（base64 编码的 protobuf）
+func decodeBytes(gameID int64, bytesData string) ([]byte, error) {
 	bytesTrimmed := strings.TrimSpace(bytesData)
 	if bytesTrimmed == "" {
-		return nil, fmt.Errorf("betorder api response bytes is empty for game %d", cfg.GameId)
-	}
-
-	if c.env == nil || c.env.protobuf == nil {
-		return nil, fmt.Errorf("protobuf converter is nil for game %d", cfg.GameId)
+		return nil, &DecodeError{Op: OpBetOrder, Err: fmt.Errorf("response bytes is empty for game %d", gameID)}
 	}
 	protoBytes, err := base64.StdEncoding.DecodeString(bytesTrimmed)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode base64 bytes: %v", err)
-	}
-
-	result, err := c.env.protobuf(protoBytes)
-	if err != nil {
-		return nil, err
+		return nil, &DecodeError{Op: OpBetOrder, Err: fmt.Errorf("decode base64 bytes: %w", err)}
 	}
-	return result, nil
+	return protoBytes, nil
 }
 
-func (c *APIClient) BetOrder(ctx context.Context, cfg *v1.TaskConfig, token string) (map[string]any, error) {
+func betOrderParams(cfg *v1.TaskConfig) map[string]any {
 	params := map[string]any{"gameId": cfg.GameId}
 	if cfg.BetOrder != nil {
 		params["baseMoney"] = cfg.BetOrder.BaseMoney
 		params["multiple"] = cfg.BetOrder.Multiple
 		params["purchase"] = cfg.BetOrder.Purchase
 	}
+	return params
+}
 
+func (c *APIClient) BetOrder(ctx context.Context, cfg *v1.TaskConfig, token string) (*BetOrderResult, error) {
 	//apiURL := fmt.Sprintf("%s/api/game/betorder", strings.TrimRight(c.launchCfg.GetApiUrl(), "/"))
-	res, err := c.request(ctx, http.MethodPost, c.betOrderURL, params, token, false)
+	res, err := c.request(ctx, OpBetOrder, http.MethodPost, c.betOrderURL, betOrderParams(cfg), token, false)
 	if err != nil {
 		return nil, err
 	}
+	return c.parseBetOrder(cfg, res)
+}
 
-	if res.Code != 0 {
-		msg := strings.TrimSpace(res.Msg)
-		e := &BetOrderError{Code: res.Code, Msg: msg}
-		lmsg := strings.ToLower(msg)
-
-		relaunchKeywords := []string{"连接失效", "internal error", "invalid token", "token expired", "unauthorized"}
-		for _, kw := range relaunchKeywords {
-			if strings.Contains(lmsg, kw) {
-				e.NeedRelaunch = true
-				if kw == "internal error" {
-					e.SleepDuration = time.Second
-				}
-				return nil, e
-			}
-		}
+// BetOrderResult 下注结果；Spin 仅在游戏实现 base.SpinParser 时非空
+type BetOrderResult struct {
+	Data map[string]any
+	Spin *base.SpinResult
 
-		if strings.Contains(lmsg, "limit") {
-			e.NeedRelogin = true
-			e.SleepDuration = 3 * time.Second
-			return nil, e
-		}
+	decode func() (map[string]any, error) // 类型化结果的 Data 延迟解码（仅录制、冒烟测试需要）
+}
 
-		return nil, e
+// GetData 失败时 r 为 nil，返回 nil；类型化结果首次调用时解码
+func (r *BetOrderResult) GetData() map[string]any {
+	if r == nil {
+		return nil
+	}
+	if r.decode != nil {
+		r.Data, _ = r.decode()
+		r.decode = nil
 	}
+	return r.Data
+}
 
-	if c.env != nil && c.env.protobuf != nil {
-		return c.decodeProtobuf(cfg, res.Bytes)
+// SpinOver 一局是否结束：优先取类型化结果，否则由游戏判定 Data
+func (r *BetOrderResult) SpinOver(g base.IGame) bool {
+	if r.Spin != nil {
+		return r.Spin.RoundOver
 	}
+	return g.IsSpinOver(r.Data)
+}
 
-	var data map[string]any
-	err = jsonAPI.Unmarshal(res.Data, &data)
-	return data, err
+// NeedBonus 是否需进入 bonus 选择：优先取类型化结果，否则由游戏判定 Data
+func (r *BetOrderResult) NeedBonus(g base.IGame) bool {
+	if r.Spin != nil {
+		return r.Spin.Bonus
+	}
+	return g.NeedBetBonus(r.Data)
+}
+
+// parseBetOrder 解析下注响应
+func (c *APIClient) parseBetOrder(cfg *v1.TaskConfig, res *apiResponse) (*BetOrderResult, error) {
+	if res.Code != 0 {
+		return nil, &BetOrderError{Code: res.Code, Msg: strings.TrimSpace(res.Msg)}
+	}
+	var (
+		g    base.IGame
+		conv base.ProtobufConverter
+	)
+	if c.env != nil {
+		g, conv = c.env.game, c.env.protobuf
+	}
+	return decodeBetOrder(g, conv, cfg.GameId, res)
+}
+
+// decodeBetOrder 游戏配置了 protobuf 转换器时先解码 bytes 字段；
+// 实现了 base.SpinParser 时只解析一次类型化结果（Data 延迟解码），否则解码为 Data 供 IGame 判定
+func decodeBetOrder(g base.IGame, conv base.ProtobufConverter, gameID int64, res *apiResponse) (*BetOrderResult, error) {
+	payload := []byte(res.Data)
+	if conv != nil {
+		protoBytes, err := decodeBytes(gameID, res.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		payload = protoBytes
+	}
+	decode := func() (map[string]any, error) {
+		var data map[string]any
+		var err error
+		if conv != nil {
+			data, err = conv(payload)
+		} else {
+			err = jsonAPI.Unmarshal(payload, &data)
+		}
+		if err != nil {
+			return nil, &DecodeError{Op: OpBetOrder, Err: err}
+		}
+		return data, nil
+	}
+
+	if parser, ok := g.(base.SpinParser); ok {
+		spin, err := parser.ParseSpin(payload)
+		if err != nil {
+			return nil, &DecodeError{Op: OpBetOrder, Err: fmt.Errorf("parse spin: %w", err)}
+		}
+		return &BetOrderResult{Spin: spin, decode: decode}, nil
+	}
+	data, err := decode()
+	if err != nil {
+		return nil, err
+	}
+	return &BetOrderResult{Data: data}, nil
 }
 
 type BetBonusResult struct {
@@ -358,20 +511,36 @@ type BetBonusResult struct {
 	NeedContinue bool
 }
 
+// GetData 失败时 r 为 nil，返回 nil
+func (r *BetBonusResult) GetData() map[string]any {
+	if r == nil {
+		return nil
+	}
+	return r.Data
+}
+
+func betBonusParams(cfg *v1.TaskConfig, bonusNum int64) map[string]any {
+	return map[string]any{"gameId": cfg.GameId, "bonusNum": bonusNum}
+}
+
 func (c *APIClient) BetBonus(ctx context.Context, cfg *v1.TaskConfig, token string, bonusNum int64) (*BetBonusResult, error) {
-	params := map[string]any{"gameId": cfg.GameId, "bonusNum": bonusNum}
 	//apiURL := fmt.Sprintf("%s/api/game/betbonus", strings.TrimRight(c.launchCfg.GetApiUrl(), "/"))
-	res, err := c.request(ctx, http.MethodPost, c.betBonusURL, params, token, false)
+	res, err := c.request(ctx, OpBetBonus, http.MethodPost, c.betBonusURL, betBonusParams(cfg, bonusNum), token, false)
 	if err != nil {
 		return nil, err
 	}
+	return c.parseBetBonus(res)
+}
+
+// parseBetBonus 解析 bonus 响应，并由游戏判定是否继续 bonus
+func (c *APIClient) parseBetBonus(res *apiResponse) (*BetBonusResult, error) {
 	if res.Code != 0 {
-		return nil, fmt.Errorf("betbonus error: %d %s", res.Code, res.Msg)
+		return nil, &APIError{Op: OpBetBonus, Code: res.Code, Msg: res.Msg}
 	}
 
 	var data map[string]any
 	if err := jsonAPI.Unmarshal(res.Data, &data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal betbonus response: %w", err)
+		return nil, &DecodeError{Op: OpBetBonus, Err: fmt.Errorf("unmarshal betbonus response: %w", err)}
 	}
 	result := &BetBonusResult{Data: data}
 	if c.env != nil && c.env.game != nil {
@@ -0,0 +1,181 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	v1 "stress/api/stress/v1"
+)
+
+// 错误原因（与 op 组成错误分类）
+const (
+	CauseTimeout   = "timeout"   // 传输超时
+	CauseTransport = "transport" // 其他传输层错误（连接拒绝、重置等）
+	CauseCanceled  = "canceled"  // 任务取消导致的请求中断
+	CauseDecode    = "decode"    // 响应解析失败
+	CauseOther     = "other"     // 无法归类
+
+	causeHTTPPrefix = "http_" // http_<status>
+	causeCodePrefix = "code_" // code_<业务错误码>
+
+	opUnknown = "unknown"
+)
+
+const errorClassSamples = 5 // 每类错误保留的最近样本数
+
+// APIError 接口返回业务错误码（launch / login / betbonus）
+type APIError struct {
+	Op   string
+	Code int
+	Msg  string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("error: [op=%q, code=%d， msg=%s]", e.Op, e.Code, e.Msg)
+}
+
+// BetOrderError 下注接口返回业务错误码（处理动作由 RetryPolicy 决定）
+type BetOrderError struct {
+	Code int
+	Msg  string
+}
+
+func (e *BetOrderError) Error() string {
+	return fmt.Sprintf("betorder error: code=%d msg=%s", e.Code, e.Msg)
+}
+
+// HTTPStatusError 非 200 响应
+type HTTPStatusError struct {
+	Op     string
+	Status int
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s: http status %d", e.Op, e.Status)
+}
+
+// TransportError 请求未拿到响应（连接失败、超时、取消等）
+type TransportError struct {
+	Op  string
+	Err error
+}
+
+func (e *TransportError) Error() string { return fmt.Sprintf("%s: %v", e.Op, e.Err) }
+func (e *TransportError) Unwrap() error { return e.Err }
+
+// Timeout 是否为超时
+func (e *TransportError) Timeout() bool {
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		return true
+	}
+	var ne net.Error
+	return errors.As(e.Err, &ne) && ne.Timeout()
+}
+
+// DecodeError 响应解析失败
+type DecodeError struct {
+	Op  string
+	Err error
+}
+
+func (e *DecodeError) Error() string { return fmt.Sprintf("%s decode: %v", e.Op, e.Err) }
+func (e *DecodeError) Unwrap() error { return e.Err }
+
+// ClassifyError 按请求类型与原因对错误分类
+func ClassifyError(err error) (op, cause string) {
+	var (
+		statusErr    *HTTPStatusError
+		transportErr *TransportError
+		decodeErr    *DecodeError
+		apiErr       *APIError
+		betErr       *BetOrderError
+	)
+	switch {
+	case errors.As(err, &statusErr):
+		return statusErr.Op, causeHTTPPrefix + strconv.Itoa(statusErr.Status)
+	case errors.As(err, &transportErr):
+		switch {
+		case errors.Is(transportErr.Err, context.Canceled):
+			return transportErr.Op, CauseCanceled
+		case transportErr.Timeout():
+			return transportErr.Op, CauseTimeout
+		}
+		return transportErr.Op, CauseTransport
+	case errors.As(err, &decodeErr):
+		return decodeErr.Op, CauseDecode
+	case errors.As(err, &apiErr):
+		return apiErr.Op, causeCodePrefix + strconv.Itoa(apiErr.Code)
+	case errors.As(err, &betErr):
+		return OpBetOrder, causeCodePrefix + strconv.Itoa(betErr.Code)
+	}
+	return opUnknown, CauseOther
+}
+
+// errorClass 单类错误的计数与最近样本
+type errorClass struct {
+	op, cause string
+	count     int64
+	samples   [errorClassSamples]string
+}
+
+// errorStats 按 op/cause 分类的错误统计
+type errorStats struct {
+	mu      sync.Mutex
+	classes map[string]*errorClass
+}
+
+func (s *errorStats) add(op, cause, sample string) {
+	key := op + "/" + cause
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.classes == nil {
+		s.classes = make(map[string]*errorClass)
+	}
+	c, ok := s.classes[key]
+	if !ok {
+		c = &errorClass{op: op, cause: cause}
+		s.classes[key] = c
+	}
+	c.samples[c.count%errorClassSamples] = sample
+	c.count++
+}
+
+// snapshot 按次数倒序输出，样本按时间旧→新
+func (s *errorStats) snapshot() []*v1.ErrorClass {
+	s.mu.Lock()
+	out := make([]*v1.ErrorClass, 0, len(s.classes))
+	for _, c := range s.classes {
+		ec := &v1.ErrorClass{Op: c.op, Cause: c.cause, Count: c.count}
+		from := max(c.count-errorClassSamples, 0)
+		for i := from; i < c.count; i++ {
+			ec.Samples = append(ec.Samples, c.samples[i%errorClassSamples])
+		}
+		out = append(out, ec)
+	}
+	s.mu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		if out[i].Op != out[j].Op {
+			return out[i].Op < out[j].Op
+		}
+		return out[i].Cause < out[j].Cause
+	})
+	return out
+}
+
+// RecordError 记录一次请求失败：计数、分类统计、错误样本（WatchTask 推送）
+func (t *Task) RecordError(member string, err error) {
+	atomic.AddInt64(&t.stats.Errors, 1)
+	op, cause := ClassifyError(err)
+	t.errClasses.add(op, cause, member+": "+err.Error())
+	t.errors.add(member, err.Error())
+}
@@ -0,0 +1,45 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		err       error
+		op, cause string
+	}{
+		{&HTTPStatusError{Op: OpBetOrder, Status: 502}, OpBetOrder, "http_502"},
+		{&TransportError{Op: OpLogin, Err: context.DeadlineExceeded}, OpLogin, CauseTimeout},
+		{&TransportError{Op: OpLaunch, Err: fmt.Errorf("dial: %w", context.Canceled)}, OpLaunch, CauseCanceled},
+		{&TransportError{Op: OpLaunch, Err: errors.New("connection refused")}, OpLaunch, CauseTransport},
+		{&DecodeError{Op: OpBetBonus, Err: errors.New("bad json")}, OpBetBonus, CauseDecode},
+		{&APIError{Op: OpLogin, Code: 1001}, OpLogin, "code_1001"},
+		{fmt.Errorf("wrapped: %w", &BetOrderError{Code: 5003}), OpBetOrder, "code_5003"},
+		{errors.New("boom"), opUnknown, CauseOther},
+	}
+	for _, c := range cases {
+		if op, cause := ClassifyError(c.err); op != c.op || cause != c.cause {
+			t.Errorf("ClassifyError(%v) = %s/%s, want %s/%s", c.err, op, cause, c.op, c.cause)
+		}
+	}
+}
+
+func TestErrorStatsKeepsRecentSamples(t *testing.T) {
+	var s errorStats
+	for i := range 7 {
+		s.add(OpBetOrder, "http_502", fmt.Sprint(i))
+	}
+	s.add(OpLogin, CauseTimeout, "x")
+
+	got := s.snapshot()
+	if len(got) != 2 || got[0].Op != OpBetOrder || got[0].Count != 7 {
+		t.Fatalf("snapshot = %v", got)
+	}
+	if want := []string{"2", "3", "4", "5", "6"}; fmt.Sprint(got[0].Samples) != fmt.Sprint(want) {
+		t.Fatalf("samples = %v, want %v", got[0].Samples, want)
+	}
+}
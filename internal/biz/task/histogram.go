@@ -0,0 +1,135 @@
+package task
+
+import (
+	"math"
+	"math/bits"
+	"sync/atomic"
+	"time"
+)
+
+// 对数-线性分桶（HDR 风格）：
+//   - [0, histSubCount) 微秒逐个精确计数
+//   - 之后每个 2 的幂区间再等分 histHalfCount 份，相对误差 < 1/histHalfCount (~1.6%)
+const (
+	histSubBits   = 7
+	histSubCount  = 1 << histSubBits // 128
+	histHalfCount = histSubCount / 2 // 64
+	histMaxShift  = 30               // 覆盖到 ~2^37µs，远超请求超时
+	histBuckets   = histSubCount + histMaxShift*histHalfCount
+)
+
+// Histogram 无锁延迟直方图（微秒精度），Record 只做原子加，可被所有会话并发调用
+type Histogram struct {
+	counts [histBuckets]uint64
+	total  uint64
+	sum    uint64 // 微秒
+	max    uint64 // 微秒
+}
+
+// NewHistogram 创建空直方图
+func NewHistogram() *Histogram {
+	return &Histogram{}
+}
+
+// Record 记录一次耗时
+func (h *Histogram) Record(d time.Duration) {
+	if h == nil {
+		return
+	}
+	us := d.Microseconds()
+	if us < 0 {
+		us = 0
+	}
+	v := uint64(us)
+
+	atomic.AddUint64(&h.counts[histIndex(v)], 1)
+	atomic.AddUint64(&h.total, 1)
+	atomic.AddUint64(&h.sum, v)
+	for {
+		cur := atomic.LoadUint64(&h.max)
+		if v <= cur || atomic.CompareAndSwapUint64(&h.max, cur, v) {
+			break
+		}
+	}
+}
+
+// Count 记录总数
+func (h *Histogram) Count() uint64 {
+	if h == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&h.total)
+}
+
+// Max 最大耗时
+func (h *Histogram) Max() time.Duration {
+	if h == nil {
+		return 0
+	}
+	return time.Duration(atomic.LoadUint64(&h.max)) * time.Microsecond
+}
+
+// Mean 平均耗时
+func (h *Histogram) Mean() time.Duration {
+	n := h.Count()
+	if n == 0 {
+		return 0
+	}
+	return time.Duration(atomic.LoadUint64(&h.sum)/n) * time.Microsecond
+}
+
+// Quantile 返回分位值（q 取 0~1），结果为所在桶的中值，且不超过实际最大值
+func (h *Histogram) Quantile(q float64) time.Duration {
+	n := h.Count()
+	if n == 0 {
+		return 0
+	}
+	if q <= 0 {
+		q = 0
+	}
+	if q >= 1 {
+		return h.Max()
+	}
+
+	rank := uint64(math.Ceil(q * float64(n)))
+	if rank == 0 {
+		rank = 1
+	}
+	var cum uint64
+	for i := range h.counts {
+		cum += atomic.LoadUint64(&h.counts[i])
+		if cum >= rank {
+			v := histValue(i)
+			if m := atomic.LoadUint64(&h.max); v > m {
+				v = m
+			}
+			return time.Duration(v) * time.Microsecond
+		}
+	}
+	return h.Max()
+}
+
+// histIndex 计算值所在桶下标
+func histIndex(v uint64) int {
+	if v < histSubCount {
+		return int(v)
+	}
+	shift := bits.Len64(v) - histSubBits // v>>shift 落在 [64,128)
+	if shift > histMaxShift {
+		return histBuckets - 1
+	}
+	return histSubCount + (shift-1)*histHalfCount + int(v>>uint(shift)) - histHalfCount
+}
+
+// histValue 桶的代表值（区间中值）
+func histValue(idx int) uint64 {
+	if idx < histSubCount {
+		return uint64(idx)
+	}
+	off := idx - histSubCount
+	shift := uint(off/histHalfCount + 1)
+	m := uint64(off%histHalfCount + histHalfCount)
+	low := m << shift
+	high := ((m + 1) << shift) - 1
+	return (low + high) / 2
+}
@@ -0,0 +1,80 @@
+package task
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHistogramIndexRoundTrip(t *testing.T) {
+	for _, v := range []uint64{0, 1, 127, 128, 129, 255, 256, 1000, 12345, 1 << 20, 3_600_000_000} {
+		idx := histIndex(v)
+		if idx < 0 || idx >= histBuckets {
+			t.Fatalf("index out of range for %d: %d", v, idx)
+		}
+		got := histValue(idx)
+		if v < histSubCount {
+			if got != v {
+				t.Errorf("exact bucket %d -> %d", v, got)
+			}
+			continue
+		}
+		if rel := math.Abs(float64(got)-float64(v)) / float64(v); rel > 1.0/histHalfCount {
+			t.Errorf("value %d -> bucket value %d, relative error %.4f", v, got, rel)
+		}
+	}
+}
+
+func TestHistogramQuantiles(t *testing.T) {
+	h := NewHistogram()
+	// 1ms..1000ms 均匀分布
+	for i := 1; i <= 1000; i++ {
+		h.Record(time.Duration(i) * time.Millisecond)
+	}
+
+	if h.Count() != 1000 {
+		t.Fatalf("count = %d", h.Count())
+	}
+	if h.Max() != time.Second {
+		t.Fatalf("max = %v", h.Max())
+	}
+
+	check := func(q float64, want time.Duration) {
+		got := h.Quantile(q)
+		if diff := math.Abs(float64(got - want)); diff > float64(want)*0.02 {
+			t.Errorf("p%.1f = %v, want ~%v", q*100, got, want)
+		}
+	}
+	check(0.50, 500*time.Millisecond)
+	check(0.90, 900*time.Millisecond)
+	check(0.99, 990*time.Millisecond)
+	check(0.999, 999*time.Millisecond)
+
+	if mean := h.Mean(); mean < 500*time.Millisecond || mean > 501*time.Millisecond {
+		t.Errorf("mean = %v", mean)
+	}
+}
+
+func TestHistogramConcurrentRecord(t *testing.T) {
+	h := NewHistogram()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				h.Record(time.Duration(i) * time.Microsecond)
+			}
+		}()
+	}
+	wg.Wait()
+	if h.Count() != 8000 {
+		t.Fatalf("count = %d, want 8000", h.Count())
+	}
+	var nilHist *Histogram
+	nilHist.Record(time.Second)
+	if nilHist.Quantile(0.5) != 0 {
+		t.Fatal("nil histogram should be empty")
+	}
+}
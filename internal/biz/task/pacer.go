@@ -0,0 +1,227 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+const (
+	pacerMaxWait  = 100 * time.Millisecond // 单次等待上限，速率变化（爬坡/降速）时及时重新计算
+	pacerMinBurst = 1
+)
+
+// errPacerDone 负载模型已走完全部阶段（ramp_down 结束）
+var errPacerDone = errors.New("load profile finished")
+
+// Pacer 开环模式的共享令牌桶：所有会话在 BetOrder 前取令牌，
+// 速率按 ramp_up → steady → ramp_down 阶段随时间变化，与服务端响应速度无关
+type Pacer struct {
+	mu       sync.Mutex
+	rate     float64
+	rampUp   time.Duration
+	steady   time.Duration
+	rampDown time.Duration
+	burst    float64
+	tokens   float64
+	start    time.Time
+	last     time.Time
+	pausedAt time.Time // 任务暂停时刻，负载模型时间轴在暂停期间冻结
+}
+
+// NewPacer 根据负载模型创建令牌桶；未配置或 rate<=0 时返回 nil（闭环模式）
+func NewPacer(p *v1.LoadProfile) (*Pacer, error) {
+	if p == nil || p.Rate <= 0 {
+		return nil, nil
+	}
+	rampUp, err := parseDuration("load.ramp_up", p.RampUp)
+	if err != nil {
+		return nil, err
+	}
+	steady, err := parseDuration("load.steady", p.Steady)
+	if err != nil {
+		return nil, err
+	}
+	rampDown, err := parseDuration("load.ramp_down", p.RampDown)
+	if err != nil {
+		return nil, err
+	}
+	if rampDown > 0 && steady <= 0 {
+		return nil, fmt.Errorf("load.ramp_down requires load.steady")
+	}
+
+	burst := float64(p.Burst)
+	if burst <= 0 {
+		burst = math.Max(p.Rate/10, pacerMinBurst)
+	}
+	return &Pacer{
+		rate:     p.Rate,
+		rampUp:   rampUp,
+		steady:   steady,
+		rampDown: rampDown,
+		burst:    burst,
+	}, nil
+}
+
+// Start 记录负载模型起点（任务实际开始执行时调用）
+func (p *Pacer) Start(now time.Time) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.start.IsZero() {
+		p.start = now
+		p.last = now
+	}
+}
+
+// Pause 冻结负载模型时间轴（任务暂停）
+func (p *Pacer) Pause(now time.Time) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pausedAt.IsZero() {
+		p.pausedAt = now
+	}
+}
+
+// Resume 恢复时间轴：起点顺延暂停时长，暂停期间不累积令牌
+func (p *Pacer) Resume(now time.Time) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pausedAt.IsZero() {
+		return
+	}
+	if !p.start.IsZero() {
+		p.start = p.start.Add(now.Sub(p.pausedAt))
+		p.last = now
+	}
+	p.pausedAt = time.Time{}
+}
+
+// elapsedLocked 负载模型已进行的时长（不含暂停），调用方持有 p.mu
+func (p *Pacer) elapsedLocked(now time.Time) time.Duration {
+	if !p.pausedAt.IsZero() && now.After(p.pausedAt) {
+		now = p.pausedAt
+	}
+	return now.Sub(p.start)
+}
+
+// RateAt 计算起点之后 elapsed 时刻的目标速率；done 表示负载模型已结束
+func (p *Pacer) RateAt(elapsed time.Duration) (rate float64, done bool) {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if p.rampUp > 0 && elapsed < p.rampUp {
+		return p.rate * float64(elapsed) / float64(p.rampUp), false
+	}
+	elapsed -= p.rampUp
+	if p.steady <= 0 || elapsed < p.steady {
+		return p.rate, false
+	}
+	elapsed -= p.steady
+	if p.rampDown > 0 && elapsed < p.rampDown {
+		return p.rate * float64(p.rampDown-elapsed) / float64(p.rampDown), false
+	}
+	return 0, true
+}
+
+// CurrentRate 当前目标速率（供快照展示）
+func (p *Pacer) CurrentRate(now time.Time) float64 {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.start.IsZero() || !p.pausedAt.IsZero() {
+		return 0
+	}
+	rate, _ := p.RateAt(p.elapsedLocked(now))
+	return rate
+}
+
+// Wait 阻塞直到取得一个令牌；负载模型结束返回 errPacerDone，ctx 取消返回 ctx.Err()
+func (p *Pacer) Wait(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+	for {
+		wait, err := p.reserve(time.Now())
+		if err != nil || wait <= 0 {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve 补充令牌并尝试消费一个；返回需要继续等待的时长
+func (p *Pacer) reserve(now time.Time) (time.Duration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.start.IsZero() {
+		p.start, p.last = now, now
+	}
+	if !p.pausedAt.IsZero() {
+		p.last = now
+		return pacerMaxWait, nil
+	}
+	rate, done := p.RateAt(p.elapsedLocked(now))
+	if done {
+		return 0, errPacerDone
+	}
+
+	if dt := now.Sub(p.last).Seconds(); dt > 0 {
+		p.tokens = math.Min(p.tokens+rate*dt, p.burst)
+	}
+	p.last = now
+
+	if p.tokens >= 1 {
+		p.tokens--
+		return 0, nil
+	}
+	if rate <= 0 {
+		return pacerMaxWait, nil
+	}
+	wait := time.Duration((1 - p.tokens) / rate * float64(time.Second))
+	if wait > pacerMaxWait {
+		wait = pacerMaxWait
+	}
+	if wait <= 0 {
+		wait = time.Millisecond
+	}
+	return wait, nil
+}
+
+// parseDuration 解析配置中的时长字符串，空串视为 0
+func parseDuration(field, s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", field, s, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must not be negative", field, s)
+	}
+	return d, nil
+}
@@ -0,0 +1,119 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+func TestPacerRateAt(t *testing.T) {
+	p, err := NewPacer(&v1.LoadProfile{Rate: 100, RampUp: "10s", Steady: "20s", RampDown: "10s"})
+	if err != nil {
+		t.Fatalf("NewPacer: %v", err)
+	}
+
+	cases := []struct {
+		elapsed time.Duration
+		rate    float64
+		done    bool
+	}{
+		{0, 0, false},
+		{5 * time.Second, 50, false},
+		{10 * time.Second, 100, false},
+		{29 * time.Second, 100, false},
+		{35 * time.Second, 50, false},
+		{40 * time.Second, 0, true},
+	}
+	for _, c := range cases {
+		rate, done := p.RateAt(c.elapsed)
+		if math.Abs(rate-c.rate) > 1e-9 || done != c.done {
+			t.Errorf("RateAt(%v) = (%.2f, %v), want (%.2f, %v)", c.elapsed, rate, done, c.rate, c.done)
+		}
+	}
+}
+
+func TestPacerDisabledAndInvalid(t *testing.T) {
+	if p, err := NewPacer(nil); p != nil || err != nil {
+		t.Fatalf("nil profile should disable pacer, got %v %v", p, err)
+	}
+	if p, err := NewPacer(&v1.LoadProfile{Rate: 0}); p != nil || err != nil {
+		t.Fatalf("zero rate should disable pacer, got %v %v", p, err)
+	}
+	if _, err := NewPacer(&v1.LoadProfile{Rate: 10, RampDown: "1m"}); err == nil {
+		t.Fatal("ramp_down without steady should fail")
+	}
+	if _, err := NewPacer(&v1.LoadProfile{Rate: 10, RampUp: "abc"}); err == nil {
+		t.Fatal("invalid duration should fail")
+	}
+	// nil pacer 不限速
+	var p *Pacer
+	if err := p.Wait(context.Background()); err != nil {
+		t.Fatalf("nil pacer Wait: %v", err)
+	}
+}
+
+func TestPacerWaitThroughput(t *testing.T) {
+	p, err := NewPacer(&v1.LoadProfile{Rate: 200, Burst: 1})
+	if err != nil {
+		t.Fatalf("NewPacer: %v", err)
+	}
+	p.Start(time.Now())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	var n int
+	for p.Wait(ctx) == nil {
+		n++
+	}
+	// 0.5s * 200/s ≈ 100，容忍调度抖动
+	if n < 70 || n > 110 {
+		t.Fatalf("expected ~100 tokens in 500ms, got %d", n)
+	}
+}
+
+func TestPacerDone(t *testing.T) {
+	p, err := NewPacer(&v1.LoadProfile{Rate: 1000, Steady: "50ms"})
+	if err != nil {
+		t.Fatalf("NewPacer: %v", err)
+	}
+	p.Start(time.Now())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var err2 error
+	for err2 == nil {
+		err2 = p.Wait(ctx)
+	}
+	if !errors.Is(err2, errPacerDone) {
+		t.Fatalf("expected errPacerDone, got %v", err2)
+	}
+}
+
+func TestPacerPauseFreezesTimeline(t *testing.T) {
+	p, err := NewPacer(&v1.LoadProfile{Rate: 100, RampUp: "10s"})
+	if err != nil {
+		t.Fatalf("NewPacer: %v", err)
+	}
+	start := time.Now().Add(-5 * time.Second)
+	p.Start(start)
+
+	p.Pause(start.Add(5 * time.Second))
+	if r := p.CurrentRate(start.Add(time.Minute)); r != 0 {
+		t.Fatalf("paused pacer should report 0 rate, got %.2f", r)
+	}
+	if wait, err := p.reserve(start.Add(time.Minute)); err != nil || wait != pacerMaxWait {
+		t.Fatalf("paused pacer should not grant tokens, got wait=%v err=%v", wait, err)
+	}
+
+	// 暂停 55s 后恢复：爬坡从 5s 处继续，而不是直接到满速
+	p.Resume(start.Add(time.Minute))
+	if r := p.CurrentRate(start.Add(time.Minute + 2*time.Second)); math.Abs(r-70) > 1e-6 {
+		t.Fatalf("rate after resume = %.2f, want 70", r)
+	}
+}
@@ -0,0 +1,80 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+// Pause 暂停运行中的任务：会话在下一次 betorder/bonus 前阻塞，token 与进度保留
+func (t *Task) Pause() error {
+	now := time.Now()
+	t.mu.Lock()
+	if t.status != v1.TaskStatus_TASK_RUNNING {
+		t.mu.Unlock()
+		return fmt.Errorf("TASK_NOT_RUNNING. task_id: %s, status: %s", t.id, t.status)
+	}
+	t.status = v1.TaskStatus_TASK_PAUSED
+	t.pausedAt = now
+	t.resumeCh = make(chan struct{})
+	t.mu.Unlock()
+
+	t.pacer.Pause(now)
+	t.log.Infof("[%s] task paused", t.id)
+	t.onStatusChange()
+	return nil
+}
+
+// Resume 恢复已暂停的任务，暂停时长累计到 pausedTotal（不计入 QPS/ETA）
+func (t *Task) Resume() error {
+	now := time.Now()
+	t.mu.Lock()
+	if t.status != v1.TaskStatus_TASK_PAUSED {
+		t.mu.Unlock()
+		return fmt.Errorf("TASK_NOT_PAUSED. task_id: %s, status: %s", t.id, t.status)
+	}
+	t.status = v1.TaskStatus_TASK_RUNNING
+	paused := now.Sub(t.pausedAt)
+	t.pausedTotal += paused
+	t.pausedAt = time.Time{}
+	close(t.resumeCh)
+	t.resumeCh = nil
+	t.mu.Unlock()
+
+	t.pacer.Resume(now)
+	t.log.Infof("[%s] task resumed, paused %v", t.id, paused.Round(time.Second))
+	t.onStatusChange()
+	return nil
+}
+
+// WaitRunning 暂停期间阻塞，恢复或 ctx 取消后返回
+func (t *Task) WaitRunning(ctx context.Context) error {
+	t.mu.RLock()
+	ch := t.resumeCh
+	t.mu.RUnlock()
+	if ch == nil {
+		return nil
+	}
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsPaused 是否处于暂停状态
+func (t *Task) IsPaused() bool {
+	return t.GetStatus() == v1.TaskStatus_TASK_PAUSED
+}
+
+// pausedDuration 截至 end 的累计暂停时长（调用方持有 t.mu 读锁）
+func (t *Task) pausedDuration(end time.Time) time.Duration {
+	d := t.pausedTotal
+	if !t.pausedAt.IsZero() && end.After(t.pausedAt) {
+		d += end.Sub(t.pausedAt)
+	}
+	return d
+}
@@ -0,0 +1,298 @@
+package task
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+)
+
+const (
+	defaultRecordDir  = "./recordings"
+	defaultMaxRecords = 100000
+	recordQueueSize   = 4096 // 写入队列长度，满时丢弃（不拖慢压测）
+)
+
+// Record 录制的一次请求/响应（gzip JSONL 的一行）
+type Record struct {
+	Time      int64          `json:"time"` // unix 毫秒
+	GameID    int64          `json:"gameId"`
+	Member    string         `json:"member"`
+	State     string         `json:"state"` // 发起请求时的会话状态
+	Op        string         `json:"op"`
+	URL       string         `json:"url,omitempty"`
+	Status    int            `json:"status,omitempty"`
+	LatencyMs float64        `json:"latencyMs"`
+	Request   string         `json:"request,omitempty"`
+	Response  string         `json:"response,omitempty"`
+	Decoded   map[string]any `json:"decoded,omitempty"` // 客户端解码结果（IGame 判定的输入）
+	Error     string         `json:"error,omitempty"`
+}
+
+// recorder 任务级录制器：会话按成员采样，记录经队列由单协程写入 gzip 文件
+type recorder struct {
+	gameID  int64
+	path    string
+	upload  bool
+	rate    float64
+	max     int64
+	queue   chan *Record
+	done    chan struct{}
+	taken   int64 // 已接收条数（含丢弃）
+	written int64
+	dropped int64
+	err     error // 写入错误，done 关闭后可读
+}
+
+// newRecorder 未配置录制时返回 nil
+func newRecorder(c *v1.Recording, dir, taskID string, gameID int64) (*recorder, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if dir == "" {
+		dir = defaultRecordDir
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, taskID+".jsonl.gz")
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &recorder{
+		gameID: gameID,
+		path:   path,
+		upload: c.UploadS3,
+		rate:   c.SampleRate,
+		max:    c.MaxRecords,
+		queue:  make(chan *Record, recordQueueSize),
+		done:   make(chan struct{}),
+	}
+	if r.max <= 0 {
+		r.max = defaultMaxRecords
+	}
+	go r.run(f)
+	return r, nil
+}
+
+func (r *recorder) run(f *os.File) {
+	defer close(r.done)
+	bw := bufio.NewWriter(f)
+	gz := gzip.NewWriter(bw)
+	enc := json.NewEncoder(gz)
+	for rec := range r.queue {
+		if r.err != nil {
+			continue // 写入失败后只消费队列
+		}
+		if r.err = enc.Encode(rec); r.err == nil {
+			r.written++
+		}
+	}
+	r.err = errors.Join(r.err, gz.Close(), bw.Flush(), f.Close())
+}
+
+// sampleSession 决定成员会话是否录制（采中的会话录制全部请求，便于回放完整状态流转）
+func (r *recorder) sampleSession() bool {
+	return r != nil && (r.rate <= 0 || r.rate >= 1 || rand.Float64() < r.rate)
+}
+
+// capture 为本次请求绑定报文槽位；s 未被采样时原样返回 ctx 与 nil
+func (r *recorder) capture(ctx context.Context, s *Session) (context.Context, *Exchange) {
+	if r == nil || !s.recorded {
+		return ctx, nil
+	}
+	ex := &Exchange{}
+	return withExchangeSlot(ctx, ex), ex
+}
+
+// add 记录一次请求；ex 为 nil（未采样）时忽略。err 为客户端返回的错误（含业务错误码）
+func (r *recorder) add(member string, state SessionState, ex *Exchange, decoded map[string]any, err error) {
+	if r == nil || ex == nil {
+		return
+	}
+	if atomic.AddInt64(&r.taken, 1) > r.max {
+		return
+	}
+	rec := &Record{
+		Time:      time.Now().UnixMilli(),
+		GameID:    r.gameID,
+		Member:    member,
+		State:     v1.SessionState(state).String(),
+		Op:        ex.Op,
+		URL:       ex.URL,
+		Status:    ex.Status,
+		LatencyMs: toMs(ex.Latency),
+		Request:   string(ex.Request),
+		Response:  string(ex.Response),
+		Decoded:   decoded,
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	select {
+	case r.queue <- rec:
+	default:
+		atomic.AddInt64(&r.dropped, 1)
+	}
+}
+
+// count 已写入条数（写入协程结束前为已入队条数）
+func (r *recorder) count() int64 {
+	if r == nil {
+		return 0
+	}
+	return min(atomic.LoadInt64(&r.taken), r.max) - atomic.LoadInt64(&r.dropped)
+}
+
+// close 所有会话结束后调用：等待写入完成
+func (r *recorder) close() error {
+	if r == nil {
+		return nil
+	}
+	close(r.queue)
+	<-r.done
+	return r.err
+}
+
+// startRecording 按配置创建录制器；失败只记录日志，不影响压测
+func (t *Task) startRecording(deps *ExecDeps) {
+	var dir string
+	if deps.Conf.Recording != nil {
+		dir = deps.Conf.Recording.Dir
+	}
+	r, err := newRecorder(t.config.GetRecording(), dir, t.id, t.config.GetGameId())
+	if err != nil {
+		t.log.Warnf("[%s] recording disabled: %v", t.id, err)
+		return
+	}
+	t.mu.Lock() // 快照可能并发读取
+	t.recorder = r
+	t.mu.Unlock()
+}
+
+// stopRecording 会话全部结束后落盘
+func (t *Task) stopRecording() {
+	if err := t.recorder.close(); err != nil {
+		t.log.Warnf("[%s] write recording %s: %v", t.id, t.recorder.path, err)
+	}
+}
+
+// uploadRecording 写入录制信息到报告，配置了 upload_s3 时上传并替换为 S3 地址
+func (t *Task) uploadRecording(deps *ExecDeps, ctx context.Context, report *v1.TaskCompletionReport) {
+	r := t.recorder
+	if r == nil {
+		return
+	}
+	report.Recorded = r.written
+	report.RecordingUrl = r.path
+	if n := atomic.LoadInt64(&r.dropped); n > 0 {
+		t.log.Warnf("[%s] recording dropped %d records (queue full)", t.id, n)
+	}
+	if !r.upload {
+		return
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		t.log.Errorf("[%s] read recording: %v", t.id, err)
+		return
+	}
+	url, err := deps.Repo.UploadBytes(ctx, "", "recordings/"+filepath.Base(r.path), "application/gzip", data)
+	if err != nil {
+		t.log.Errorf("[%s] upload recording to S3: %v", t.id, err)
+		return
+	}
+	report.RecordingUrl = url
+	if err := os.Remove(r.path); err != nil {
+		t.log.Warnf("[%s] remove local recording: %v", t.id, err)
+	}
+}
+
+// ReadRecords 逐条读取录制文件（gzip JSONL）
+func ReadRecords(rd io.Reader, fn func(*Record) error) error {
+	gz, err := gzip.NewReader(rd)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(gz)
+	for {
+		var rec Record
+		if err := dec.Decode(&rec); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(&rec); err != nil {
+			return err
+		}
+	}
+}
+
+// DecodeSpin 按线上客户端相同的逻辑从 betorder 原始响应体解析类型化结果（离线回放用）；
+// 游戏未实现 base.SpinParser 时返回 nil
+func DecodeSpin(g base.IGame, raw []byte) (*base.SpinResult, error) {
+	if _, ok := g.(base.SpinParser); !ok {
+		return nil, nil
+	}
+	var res apiResponse
+	if err := jsonAPI.Unmarshal(raw, &res); err != nil {
+		return nil, &DecodeError{Op: OpBetOrder, Err: err}
+	}
+	if res.Code != 0 {
+		return nil, &APIError{Op: OpBetOrder, Code: res.Code, Msg: res.Msg}
+	}
+	r, err := decodeBetOrder(g, g.GetProtobufConverter(), g.GameID(), &res)
+	if err != nil {
+		return nil, err
+	}
+	return r.Spin, nil
+}
+
+// DecodeResponse 按线上客户端相同的逻辑从原始响应体解出 IGame 判定的输入（离线回放用）：
+// login 取 freeData，betorder 取 data（protobuf 游戏经转换器），betbonus 取 data
+func DecodeResponse(g base.IGame, op string, raw []byte) (map[string]any, error) {
+	var res apiResponse
+	if err := jsonAPI.Unmarshal(raw, &res); err != nil {
+		return nil, &DecodeError{Op: op, Err: err}
+	}
+	if res.Code != 0 {
+		return nil, &APIError{Op: op, Code: res.Code, Msg: res.Msg}
+	}
+
+	switch op {
+	case OpLogin:
+		var data struct {
+			FreeData map[string]any `json:"freeData"`
+		}
+		if err := jsonAPI.Unmarshal(res.Data, &data); err != nil {
+			return nil, &DecodeError{Op: op, Err: err}
+		}
+		return data.FreeData, nil
+	case OpBetOrder:
+		if conv := g.GetProtobufConverter(); conv != nil {
+			return decodeProtobuf(conv, g.GameID(), res.Bytes)
+		}
+	case OpBetBonus:
+	default:
+		return nil, fmt.Errorf("op %q has no decoded data", op)
+	}
+	var data map[string]any
+	if err := jsonAPI.Unmarshal(res.Data, &data); err != nil {
+		return nil, &DecodeError{Op: op, Err: err}
+	}
+	return data, nil
+}
@@ -0,0 +1,61 @@
+package task
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+)
+
+func TestRecorderRoundTrip(t *testing.T) {
+	r, err := newRecorder(&v1.Recording{MaxRecords: 2}, t.TempDir(), "t1", 18888)
+	if err != nil {
+		t.Fatalf("newRecorder: %v", err)
+	}
+	sess := NewSession("m1")
+	sess.recorded = r.sampleSession()
+
+	for i := 0; i < 3; i++ {
+		_, ex := r.capture(t.Context(), sess)
+		*ex = Exchange{Op: OpBetOrder, Status: 200, Latency: time.Millisecond, Response: []byte(`{"code":0}`)}
+		r.add(sess.MemberName, SessionStateBetting, ex, map[string]any{"over": i == 0}, nil)
+	}
+	_, ex := r.capture(t.Context(), NewSession("m2")) // 未采样的会话不录制
+	r.add("m2", SessionStateBetting, ex, nil, errors.New("ignored"))
+	if n := r.count(); n != 2 {
+		t.Fatalf("live count = %d, want 2 (max_records)", n)
+	}
+	if err := r.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	f, err := os.Open(r.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var got []*Record
+	if err := ReadRecords(f, func(rec *Record) error { got = append(got, rec); return nil }); err != nil {
+		t.Fatalf("ReadRecords: %v", err)
+	}
+	if len(got) != 2 || r.written != 2 {
+		t.Fatalf("got %d records (written=%d), want 2 (max_records)", len(got), r.written)
+	}
+	if rec := got[0]; rec.Member != "m1" || rec.GameID != 18888 || rec.State != "SESSION_BETTING" || rec.Decoded["over"] != true {
+		t.Fatalf("record = %+v", rec)
+	}
+}
+
+func TestDecodeResponse(t *testing.T) {
+	g := base.NewBaseGame(18888, "json")
+	data, err := DecodeResponse(g, OpLogin, []byte(`{"code":0,"data":{"token":"x","freeData":{"free":1}}}`))
+	if err != nil || data["free"] != float64(1) {
+		t.Fatalf("login data=%v err=%v", data, err)
+	}
+	if _, err := DecodeResponse(g, OpBetOrder, []byte(`{"code":7,"msg":"limit"}`)); err == nil {
+		t.Fatal("business error code should fail decode")
+	}
+}
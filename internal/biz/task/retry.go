@@ -0,0 +1,290 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+// 重试策略默认值：次数上限与基础间隔沿用引入 RetryPolicy 前的 5 次 / 100ms
+const (
+	defaultMaxAttempts    = 5
+	defaultBaseDelay      = 100 * time.Millisecond
+	defaultMaxDelay       = 10 * time.Second
+	defaultBackoffFactor  = 1.0
+	defaultBreakerWindow  = 30 * time.Second
+	defaultBreakerMinReqs = 100
+)
+
+// defaultErrorRules 未配置规则时使用：launch 业务错误直接失败；
+// betorder 连接/token 失效类错误重新获取 token，限流类错误重新登录，其他业务错误立即重试。
+// 与引入前的区别：launch 失败前不再等待 100ms，重新获取 token / 重新登录只等待规则 delay，不再额外叠加 100ms
+var defaultErrorRules = []*v1.ErrorRule{
+	{Op: OpLaunch, Cause: "code_*", Action: v1.ErrorRule_FAIL},
+	{Op: OpBetOrder, Cause: "code_*", Keywords: []string{"internal error"}, Action: v1.ErrorRule_RELAUNCH, Delay: "1s"},
+	{Op: OpBetOrder, Cause: "code_*", Keywords: []string{"连接失效", "invalid token", "token expired", "unauthorized"}, Action: v1.ErrorRule_RELAUNCH},
+	{Op: OpBetOrder, Cause: "code_*", Keywords: []string{"limit"}, Action: v1.ErrorRule_RELOGIN, Delay: "3s"},
+	{Op: OpBetOrder, Cause: "code_*", Action: v1.ErrorRule_RETRY, Delay: "0s"},
+}
+
+// retryStateKeys RetryPolicy.state_max_attempts 的 key
+var retryStateKeys = map[string]SessionState{
+	"launching":    SessionStateLaunching,
+	"logging_in":   SessionStateLoggingIn,
+	"betting":      SessionStateBetting,
+	"bonus_select": SessionStateBonusSelect,
+}
+
+// retryPolicy 解析后的重试策略
+type retryPolicy struct {
+	maxAttempts   int32
+	stateAttempts map[SessionState]int32
+	baseDelay     time.Duration
+	maxDelay      time.Duration
+	multiplier    float64
+	jitter        float64
+	rules         []errorRule
+}
+
+type errorRule struct {
+	op       string
+	cause    string
+	codes    []int32
+	keywords []string // 已转小写
+	action   v1.ErrorRule_Action
+	delay    time.Duration
+	hasDelay bool
+}
+
+// retryDecision 单次失败后的处理
+type retryDecision struct {
+	action v1.ErrorRule_Action
+	delay  time.Duration
+}
+
+func newRetryPolicy(p *v1.RetryPolicy) (*retryPolicy, error) {
+	rp := &retryPolicy{
+		maxAttempts:   defaultMaxAttempts,
+		stateAttempts: make(map[SessionState]int32),
+		baseDelay:     defaultBaseDelay,
+		maxDelay:      defaultMaxDelay,
+		multiplier:    defaultBackoffFactor,
+		jitter:        p.GetJitter(),
+	}
+	if n := p.GetMaxAttempts(); n > 0 {
+		rp.maxAttempts = n
+	}
+	for key, n := range p.GetStateMaxAttempts() {
+		state, ok := retryStateKeys[key]
+		if !ok {
+			return nil, fmt.Errorf("retry: unknown state %q in state_max_attempts", key)
+		}
+		if n > 0 {
+			rp.stateAttempts[state] = n
+		}
+	}
+	if d, err := parseDuration("retry.base_delay", p.GetBaseDelay()); err != nil {
+		return nil, err
+	} else if d > 0 {
+		rp.baseDelay = d
+	}
+	if d, err := parseDuration("retry.max_delay", p.GetMaxDelay()); err != nil {
+		return nil, err
+	} else if d > 0 {
+		rp.maxDelay = d
+	}
+	if m := p.GetMultiplier(); m > 0 {
+		rp.multiplier = m
+	}
+
+	rules := p.GetRules()
+	if len(rules) == 0 {
+		rules = defaultErrorRules
+	}
+	rp.rules = make([]errorRule, 0, len(rules))
+	for i, r := range rules {
+		if r.Action == v1.ErrorRule_ACTION_UNSPECIFIED {
+			return nil, fmt.Errorf("retry.rules[%d]: action is required", i)
+		}
+		er := errorRule{op: r.Op, cause: r.Cause, codes: r.Codes, action: r.Action}
+		for _, kw := range r.Keywords {
+			er.keywords = append(er.keywords, strings.ToLower(kw))
+		}
+		if r.Delay != "" {
+			d, err := parseDuration(fmt.Sprintf("retry.rules[%d].delay", i), r.Delay)
+			if err != nil {
+				return nil, err
+			}
+			er.delay, er.hasDelay = d, true
+		}
+		rp.rules = append(rp.rules, er)
+	}
+	return rp, nil
+}
+
+// attemptsFor 状态对应的连续失败上限
+func (p *retryPolicy) attemptsFor(state SessionState) int32 {
+	if state == SessionStateIdle {
+		state = SessionStateLaunching
+	}
+	if n, ok := p.stateAttempts[state]; ok {
+		return n
+	}
+	return p.maxAttempts
+}
+
+// decide 根据错误、所处状态与连续失败次数给出动作；超过上限直接失败
+func (p *retryPolicy) decide(err error, state SessionState, attempt int32) retryDecision {
+	if attempt > p.attemptsFor(state) {
+		return retryDecision{action: v1.ErrorRule_FAIL}
+	}
+	if r := p.match(err); r != nil {
+		d := retryDecision{action: r.action, delay: p.backoff(attempt)}
+		if r.hasDelay {
+			d.delay = r.delay
+		}
+		return d
+	}
+	return retryDecision{action: v1.ErrorRule_RETRY, delay: p.backoff(attempt)}
+}
+
+func (p *retryPolicy) match(err error) *errorRule {
+	op, cause := ClassifyError(err)
+	code, msg, hasCode := errorCode(err)
+	msg = strings.ToLower(msg)
+	for i := range p.rules {
+		if p.rules[i].matches(op, cause, code, hasCode, msg) {
+			return &p.rules[i]
+		}
+	}
+	return nil
+}
+
+func (r *errorRule) matches(op, cause string, code int32, hasCode bool, msg string) bool {
+	if r.op != "" && r.op != op {
+		return false
+	}
+	if r.cause != "" {
+		if prefix, ok := strings.CutSuffix(r.cause, "*"); ok {
+			if !strings.HasPrefix(cause, prefix) {
+				return false
+			}
+		} else if r.cause != cause {
+			return false
+		}
+	}
+	if len(r.codes) > 0 && (!hasCode || !slices.Contains(r.codes, code)) {
+		return false
+	}
+	if len(r.keywords) > 0 && !slices.ContainsFunc(r.keywords, func(kw string) bool { return strings.Contains(msg, kw) }) {
+		return false
+	}
+	return true
+}
+
+// backoff 第 attempt 次失败后的等待：base×multiplier^(attempt-1)，不超过 maxDelay，再叠加抖动
+func (p *retryPolicy) backoff(attempt int32) time.Duration {
+	d := float64(p.baseDelay) * math.Pow(p.multiplier, float64(max(attempt-1, 0)))
+	d = math.Min(d, float64(p.maxDelay))
+	if p.jitter > 0 {
+		d *= 1 + p.jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(d)
+}
+
+// errorCode 提取业务错误码与接口返回的错误信息；非业务错误返回 err.Error()
+func errorCode(err error) (int32, string, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return int32(apiErr.Code), apiErr.Msg, true
+	}
+	var betErr *BetOrderError
+	if errors.As(err, &betErr) {
+		return int32(betErr.Code), betErr.Msg, true
+	}
+	return 0, err.Error(), false
+}
+
+// circuitBreaker 任务级熔断：按秒分桶统计窗口内请求成功/失败数
+type circuitBreaker struct {
+	mu      sync.Mutex
+	rate    float64
+	minReqs int64
+	buckets []breakerBucket
+	tripped bool
+}
+
+type breakerBucket struct {
+	sec       int64
+	ok, fails int64
+}
+
+// newCircuitBreaker 未配置或阈值为 0 时返回 nil（不启用）
+func newCircuitBreaker(c *v1.CircuitBreaker) (*circuitBreaker, error) {
+	if c.GetErrorRate() <= 0 {
+		return nil, nil
+	}
+	window, err := parseDuration("retry.breaker.window", c.GetWindow())
+	if err != nil {
+		return nil, err
+	}
+	if window <= 0 {
+		window = defaultBreakerWindow
+	}
+	minReqs := c.GetMinRequests()
+	if minReqs <= 0 {
+		minReqs = defaultBreakerMinReqs
+	}
+	return &circuitBreaker{
+		rate:    c.GetErrorRate(),
+		minReqs: minReqs,
+		buckets: make([]breakerBucket, max(int(window/time.Second), 1)),
+	}, nil
+}
+
+// observe 记录一次请求结果；首次超过阈值时返回 true 及当前错误率
+func (b *circuitBreaker) observe(ok bool, now time.Time) (bool, float64) {
+	if b == nil {
+		return false, 0
+	}
+	sec := now.Unix()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	bk := &b.buckets[sec%int64(len(b.buckets))]
+	if bk.sec != sec {
+		*bk = breakerBucket{sec: sec}
+	}
+	if ok {
+		bk.ok++
+		return false, 0
+	}
+	bk.fails++
+	if b.tripped {
+		return false, 0
+	}
+
+	var total, fails int64
+	for _, x := range b.buckets {
+		if sec-x.sec < int64(len(b.buckets)) {
+			total += x.ok + x.fails
+			fails += x.fails
+		}
+	}
+	if total < b.minReqs {
+		return false, 0
+	}
+	rate := float64(fails) / float64(total)
+	if rate < b.rate {
+		return false, 0
+	}
+	b.tripped = true
+	return true, rate
+}
@@ -0,0 +1,102 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+func TestDefaultRetryRules(t *testing.T) {
+	p, err := newRetryPolicy(nil)
+	if err != nil {
+		t.Fatalf("newRetryPolicy: %v", err)
+	}
+	cases := []struct {
+		err    error
+		state  SessionState
+		action v1.ErrorRule_Action
+		delay  time.Duration
+	}{
+		{&APIError{Op: OpLaunch, Code: 1}, SessionStateLaunching, v1.ErrorRule_FAIL, defaultBaseDelay},
+		{&BetOrderError{Code: 1, Msg: "Internal Error"}, SessionStateBetting, v1.ErrorRule_RELAUNCH, time.Second},
+		{&BetOrderError{Code: 1, Msg: "token expired"}, SessionStateBetting, v1.ErrorRule_RELAUNCH, defaultBaseDelay},
+		{&BetOrderError{Code: 1, Msg: "rate limit"}, SessionStateBetting, v1.ErrorRule_RELOGIN, 3 * time.Second},
+		{&BetOrderError{Code: 1, Msg: "balance"}, SessionStateBetting, v1.ErrorRule_RETRY, 0},
+		{&HTTPStatusError{Op: OpBetOrder, Status: 502}, SessionStateBetting, v1.ErrorRule_RETRY, defaultBaseDelay},
+	}
+	for _, c := range cases {
+		d := p.decide(c.err, c.state, 1)
+		if d.action != c.action || d.delay != c.delay {
+			t.Errorf("decide(%v) = %v/%v, want %v/%v", c.err, d.action, d.delay, c.action, c.delay)
+		}
+	}
+	if d := p.decide(errors.New("x"), SessionStateBetting, defaultMaxAttempts+1); d.action != v1.ErrorRule_FAIL {
+		t.Errorf("exceeding max attempts should fail, got %v", d.action)
+	}
+}
+
+func TestRetryPolicyBackoffAndRules(t *testing.T) {
+	p, err := newRetryPolicy(&v1.RetryPolicy{
+		MaxAttempts:      10,
+		StateMaxAttempts: map[string]int32{"bonus_select": 2},
+		BaseDelay:        "100ms",
+		MaxDelay:         "1s",
+		Multiplier:       2,
+		Rules: []*v1.ErrorRule{
+			{Op: OpBetOrder, Codes: []int32{5003}, Action: v1.ErrorRule_RELOGIN},
+			{Cause: "http_5*", Action: v1.ErrorRule_RETRY, Delay: "2s"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("newRetryPolicy: %v", err)
+	}
+	for attempt, want := range map[int32]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 8: time.Second} {
+		if got := p.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+	if d := p.decide(&BetOrderError{Code: 5003}, SessionStateBetting, 3); d.action != v1.ErrorRule_RELOGIN || d.delay != 400*time.Millisecond {
+		t.Errorf("code rule = %v/%v", d.action, d.delay)
+	}
+	if d := p.decide(&HTTPStatusError{Op: OpLogin, Status: 503}, SessionStateLoggingIn, 1); d.delay != 2*time.Second {
+		t.Errorf("cause prefix rule delay = %v, want 2s", d.delay)
+	}
+	if d := p.decide(errors.New("x"), SessionStateBonusSelect, 3); d.action != v1.ErrorRule_FAIL {
+		t.Errorf("bonus_select limit 2 should fail on attempt 3, got %v", d.action)
+	}
+
+	if _, err := newRetryPolicy(&v1.RetryPolicy{StateMaxAttempts: map[string]int32{"spinning": 1}}); err == nil {
+		t.Error("unknown state key should be rejected")
+	}
+}
+
+func TestCircuitBreakerTrips(t *testing.T) {
+	b, err := newCircuitBreaker(&v1.CircuitBreaker{ErrorRate: 0.5, Window: "10s", MinRequests: 10})
+	if err != nil {
+		t.Fatalf("newCircuitBreaker: %v", err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+	for range 5 {
+		b.observe(true, now)
+	}
+	for i := range 4 {
+		if tripped, _ := b.observe(false, now); tripped {
+			t.Fatalf("tripped below min requests at failure %d", i+1)
+		}
+	}
+	if tripped, rate := b.observe(false, now); !tripped || rate != 0.5 {
+		t.Fatalf("tripped=%v rate=%v, want true/0.5", tripped, rate)
+	}
+	if tripped, _ := b.observe(false, now); tripped {
+		t.Fatal("breaker should report tripping only once")
+	}
+
+	// 窗口外的旧数据不计入
+	b2, _ := newCircuitBreaker(&v1.CircuitBreaker{ErrorRate: 0.5, Window: "10s", MinRequests: 2})
+	b2.observe(false, now)
+	if tripped, _ := b2.observe(false, now.Add(20*time.Second)); tripped {
+		t.Fatal("stale failures outside the window should be ignored")
+	}
+}
@@ -3,6 +3,7 @@ package task
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -10,12 +11,6 @@ import (
 	v1 "stress/api/stress/v1"
 )
 
-const (
-	defaultRetryDelay    = 100 * time.Millisecond
-	defaultMaxRetries    = 5
-	defaultSleepOnCancel = 100 * time.Millisecond
-)
-
 type SessionState int32
 
 const (
@@ -36,15 +31,29 @@ type Session struct {
 	mu    sync.RWMutex
 
 	Process  int32
-	TryTimes int32
+	TryTimes int32 // 当前连续重试次数，成功后清零
+	Retries  int64 // 累计失败重试次数
 
-	LastError string
+	recorded bool // 是否录制本会话的请求（创建后只读）
+	chaotic  bool // 是否参与故障注入（创建后只读）
+	idled    bool // 故障注入空闲后尚未下注（仅会话协程访问）
+
+	staleToken   string // 已失效的 token（上一次会话 token，首次登录后为已消费的 launch token），受 mu 保护
+	sessionToken string // 最近一次登录得到的会话 token，受 mu 保护
+
+	LastError     string    // 受 mu 保护
+	createdAt     time.Time // 会话创建时间
+	stateSince    time.Time // 进入当前状态的时间，受 mu 保护
+	lastSuccessAt time.Time // 上次成功请求时间，受 mu 保护
 }
 
 func NewSession(memberName string) *Session {
+	now := time.Now()
 	return &Session{
 		MemberName: memberName,
 		State:      SessionStateIdle,
+		createdAt:  now,
+		stateSince: now,
 	}
 }
 
@@ -57,7 +66,26 @@ func (s *Session) getState() SessionState {
 func (s *Session) setState(state SessionState) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.State = state
+	if s.State != state {
+		s.State = state
+		s.stateSince = time.Now()
+	}
+}
+
+func (s *Session) setLastError(msg string) {
+	s.mu.Lock()
+	s.LastError = msg
+	s.mu.Unlock()
+}
+
+// markSuccess 请求成功：清零连续重试次数并记录时间
+func (s *Session) markSuccess(env *SessionEnv) {
+	now := time.Now()
+	atomic.StoreInt32(&s.TryTimes, 0)
+	s.mu.Lock()
+	s.lastSuccessAt = now
+	s.mu.Unlock()
+	env.task.breaker.observe(true, now)
 }
 
 func (s *Session) getToken() string {
@@ -72,13 +100,30 @@ func (s *Session) setToken(token string) {
 	s.Token = token
 }
 
-func (s *Session) Execute(client *APIClient) error {
-	env := client.Env()
+// setSessionToken 登录成功：替换会话 token，旧 token 留作故障注入的失效 token
+func (s *Session) setSessionToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.staleToken = s.sessionToken
+	if s.staleToken == "" {
+		s.staleToken = s.Token
+	}
+	s.sessionToken = token
+	s.Token = token
+}
+
+func (s *Session) getStaleToken() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.staleToken
+}
+
+// Execute 运行会话状态机直到完成或失败；tr 为本会话的传输，由调用方关闭
+func (s *Session) Execute(env *SessionEnv, tr GameTransport) error {
 	if env == nil {
 		return fmt.Errorf("session env is nil")
 	}
 
-	maxRetries := defaultMaxRetries
 	for {
 		if state := s.getState(); state == SessionStateCompleted || state == SessionStateFailed {
 			break
@@ -86,19 +131,25 @@ func (s *Session) Execute(client *APIClient) error {
 
 		select {
 		case <-env.ctx.Done():
-			s.setState(SessionStateFailed)
+			s.endOnCancel(env)
 			return env.ctx.Err()
 		default:
 		}
 
 		if env.task.GetStatus() == v1.TaskStatus_TASK_CANCELLED {
 			s.setState(SessionStateFailed)
-			s.LastError = "task cancelled"
+			s.setLastError("task cancelled")
 			return nil
 		}
 
-		if err := s.executeStep(env, client); err != nil {
-			if !s.handleError(err, maxRetries, env) {
+		if err := s.executeStep(env, tr); err != nil {
+			if env.ctx.Err() != nil && env.task.IsTimeUp(time.Now()) {
+				continue // 时长上限到达取消了进行中的请求，不计为错误
+			}
+			if !s.handleError(err, env) {
+				if ctxErr := env.ctx.Err(); ctxErr != nil {
+					return ctxErr // 重试等待中被取消
+				}
 				return err
 			}
 		}
@@ -106,64 +157,108 @@ func (s *Session) Execute(client *APIClient) error {
 	return nil
 }
 
-func (s *Session) executeStep(env *SessionEnv, client *APIClient) error {
+func (s *Session) executeStep(env *SessionEnv, tr GameTransport) error {
 	atomic.AddInt32(&s.TryTimes, 1)
 
 	switch s.getState() {
 	case SessionStateIdle, SessionStateLaunching:
-		token, err := client.Launch(env.ctx, env.cfg, s.MemberName)
+		ctx, ex := env.task.recorder.capture(env.ctx, s)
+		start := time.Now()
+		token, err := tr.Launch(ctx, env.cfg, s.MemberName)
+		env.task.recorder.add(s.MemberName, SessionStateLaunching, ex, nil, err)
 		if err == nil {
+			env.task.RecordLatency(OpLaunch, time.Since(start))
 			s.setToken(token)
 			s.setState(SessionStateLoggingIn)
-			atomic.StoreInt32(&s.TryTimes, 0)
-		} else {
-			var apiErr *APIError
-			if errors.As(err, &apiErr) && apiErr.Op == "launch" {
-				s.setState(SessionStateFailed)
-			}
+			s.markSuccess(env)
 		}
 		return err
 
 	case SessionStateLoggingIn:
-		token, freeData, err := client.Login(env.ctx, env.cfg, s.getToken())
+		ctx, ex := env.task.recorder.capture(env.ctx, s)
+		start := time.Now()
+		token, freeData, err := tr.Login(ctx, env.cfg, s.getToken())
+		env.task.recorder.add(s.MemberName, SessionStateLoggingIn, ex, freeData, err)
 		if err == nil {
-			s.setToken(token)
+			env.task.RecordLatency(OpLogin, time.Since(start))
+			s.setSessionToken(token)
 			s.setState(SessionStateBetting)
 			if env.game.NeedBetBonus(freeData) {
 				s.setState(SessionStateBonusSelect)
 			}
-			atomic.StoreInt32(&s.TryTimes, 0)
+			s.markSuccess(env)
 		}
 		return err
 
 	case SessionStateBetting:
+		if err := env.task.WaitRunning(env.ctx); err != nil {
+			return nil // ctx 取消由 Execute 主循环处理
+		}
+		if env.task.IsTimeUp(time.Now()) {
+			s.setState(SessionStateCompleted)
+			return nil
+		}
+		if !env.task.chaos.idleBeforeBet(env, s) {
+			return nil
+		}
+		if err := env.pacer.Wait(env.ctx); err != nil {
+			if errors.Is(err, errPacerDone) {
+				s.setState(SessionStateCompleted)
+			}
+			return nil // ctx 取消由 Execute 主循环处理
+		}
+		env.task.chaos.sendStale(env, tr, s)
+		ctx, ex := env.task.recorder.capture(env.ctx, s)
+		ctx, drop := env.task.chaos.withDrop(ctx, s)
+		waitDuplicate := env.task.chaos.duplicateBet(env, tr, s)
 		start := time.Now()
-		data, err := client.BetOrder(env.ctx, env.cfg, s.getToken())
+		res, err := tr.BetOrder(ctx, env.cfg, s.getToken())
+		waitDuplicate(err)
+		if drop.done(env.task.chaos, s, err) {
+			return nil // 主动断开：结果未知，按原状态继续（不计为空闲后被拒绝）
+		}
+		env.task.chaos.afterBet(s, err)
+		var decoded map[string]any
+		if ex != nil {
+			decoded = res.GetData() // 类型化结果的 Data 仅在录制时解码
+		}
+		env.task.recorder.add(s.MemberName, SessionStateBetting, ex, decoded, err)
 		if err == nil {
 			duration := time.Since(start)
-			spinOver := env.game.IsSpinOver(data)
-			if spinOver && atomic.AddInt32(&s.Process, 1) >= env.cfg.TimesPerMember {
+			spinOver := res.SpinOver(env.game)
+			if spinOver && atomic.AddInt32(&s.Process, 1) >= env.cfg.TimesPerMember && env.cfg.TimesPerMember > 0 {
 				s.setState(SessionStateCompleted)
-			} else if env.game.NeedBetBonus(data) {
+			} else if res.NeedBonus(env.game) {
 				s.setState(SessionStateBonusSelect)
 			}
 			env.task.AddBetOrder(duration, spinOver)
-			atomic.StoreInt32(&s.TryTimes, 0)
-		} else {
-			err = s.handleBetOrderError(err, env)
+			env.task.AddSpin(res.Spin)
+			s.markSuccess(env)
 		}
 		return err
 
 	case SessionStateBonusSelect:
+		if err := env.task.WaitRunning(env.ctx); err != nil {
+			return nil
+		}
+		if env.task.chaos.abandonBonus(s) {
+			return nil
+		}
+		ctx, ex := env.task.recorder.capture(env.ctx, s)
+		ctx, drop := env.task.chaos.withDrop(ctx, s)
 		start := time.Now()
-		res, err := client.BetBonus(env.ctx, env.cfg, s.getToken(), env.game.PickBonusNum())
+		res, err := tr.BetBonus(ctx, env.cfg, s.getToken(), env.game.PickBonusNum())
+		if drop.done(env.task.chaos, s, err) {
+			return nil
+		}
+		env.task.recorder.add(s.MemberName, SessionStateBonusSelect, ex, res.GetData(), err)
 		if err == nil {
 			duration := time.Since(start)
 			if !res.NeedContinue {
 				s.setState(SessionStateBetting)
 			}
 			env.task.AddBetBonus(duration)
-			atomic.StoreInt32(&s.TryTimes, 0)
+			s.markSuccess(env)
 		}
 		return err
 
@@ -172,49 +267,36 @@ func (s *Session) executeStep(env *SessionEnv, client *APIClient) error {
 	}
 }
 
-func (s *Session) handleBetOrderError(err error, env *SessionEnv) error {
-	var betErr *BetOrderError
-	if !errors.As(err, &betErr) {
-		return err
-	}
-	if betErr.SleepDuration > 0 && !s.sleepOrCancel(betErr.SleepDuration, env) {
-		return fmt.Errorf("bet order cancelled")
-	}
-	if betErr.NeedRelaunch {
-		s.setState(SessionStateLaunching)
-		s.setToken("")
-	} else if betErr.NeedRelogin {
-		s.setState(SessionStateLoggingIn)
-	}
-	return betErr
-}
+// handleError 按重试策略处理失败，返回 false 表示会话结束；窗口错误率超过熔断阈值时任务失败
+func (s *Session) handleError(err error, env *SessionEnv) bool {
+	env.task.RecordError(s.MemberName, err)
+	atomic.AddInt64(&s.Retries, 1)
+	s.setLastError(err.Error())
 
-func (s *Session) handleError(err error, maxRetries int, env *SessionEnv) bool {
-	if s.LastError != err.Error() {
-		env.task.AddError()
+	if _, cause := ClassifyError(err); cause != CauseCanceled {
+		if tripped, rate := env.task.breaker.observe(false, time.Now()); tripped {
+			env.task.Fail(fmt.Sprintf("circuit breaker open: error rate %.1f%% >= %.1f%%, last error: %v",
+				rate*100, env.task.breaker.rate*100, err))
+		}
 	}
-	s.LastError = err.Error()
 
-	if atomic.LoadInt32(&s.TryTimes) > int32(maxRetries) {
-		s.setState(SessionStateFailed)
+	state := s.getState()
+	d := env.task.retry.decide(err, state, atomic.LoadInt32(&s.TryTimes))
+	if !s.sleepOrCancel(d.delay, env) {
 		return false
 	}
 
-	var apiErr *APIError
-	if errors.As(err, &apiErr) && apiErr.Op == "launch" {
-		if !s.sleepOrCancel(defaultSleepOnCancel, env) {
-			return false
-		}
+	switch d.action {
+	case v1.ErrorRule_FAIL:
 		s.setState(SessionStateFailed)
 		return false
+	case v1.ErrorRule_RELAUNCH:
+		s.setState(SessionStateLaunching)
+		s.setToken("")
+	case v1.ErrorRule_RELOGIN:
+		s.setState(SessionStateLoggingIn)
 	}
-
-	var betErr *BetOrderError
-	if errors.As(err, &betErr) && !betErr.NeedRelaunch && !betErr.NeedRelogin {
-		return s.sleepOrCancel(betErr.SleepDuration, env)
-	}
-
-	return s.sleepOrCancel(defaultRetryDelay, env)
+	return true
 }
 
 func (s *Session) sleepOrCancel(duration time.Duration, env *SessionEnv) bool {
@@ -231,11 +313,96 @@ func (s *Session) sleepOrCancel(duration time.Duration, env *SessionEnv) bool {
 	case <-timer.C:
 		return true
 	case <-env.ctx.Done():
-		s.setState(SessionStateFailed)
+		s.endOnCancel(env)
 		return false
 	}
 }
 
+// endOnCancel 任务上下文取消后结束会话：时长上限到达视为完成，取消或失败为失败
+func (s *Session) endOnCancel(env *SessionEnv) {
+	if env.task.IsTimeUp(time.Now()) {
+		s.setState(SessionStateCompleted)
+		return
+	}
+	s.setState(SessionStateFailed)
+}
+
 func (s *Session) IsFailed() bool {
 	return s.getState() == SessionStateFailed
 }
+
+// Info 会话快照；idle 从上次成功请求起算，从未成功时从会话创建起算
+func (s *Session) Info(now time.Time) *v1.SessionInfo {
+	s.mu.RLock()
+	state, lastErr, since, lastOK := s.State, s.LastError, s.stateSince, s.lastSuccessAt
+	s.mu.RUnlock()
+
+	idleFrom := lastOK
+	if idleFrom.IsZero() {
+		idleFrom = s.createdAt
+	}
+	info := &v1.SessionInfo{
+		Member:    s.MemberName,
+		State:     v1.SessionState(state),
+		Process:   atomic.LoadInt32(&s.Process),
+		TryTimes:  atomic.LoadInt32(&s.TryTimes),
+		Retries:   atomic.LoadInt64(&s.Retries),
+		LastError: lastErr,
+		StateSec:  int64(now.Sub(since).Seconds()),
+		IdleSec:   int64(now.Sub(idleFrom).Seconds()),
+	}
+	if !lastOK.IsZero() {
+		info.LastSuccessAt = lastOK.Format(time.DateTime)
+	}
+	return info
+}
+
+// SessionFilter 会话过滤条件
+type SessionFilter struct {
+	State    v1.SessionState // 0=全部
+	MinState time.Duration   // 处于当前状态的最短时长
+	MinIdle  time.Duration   // 距上次成功请求的最短时长
+	Limit    int             // <=0 不限
+}
+
+func (f SessionFilter) match(info *v1.SessionInfo) bool {
+	if f.State != v1.SessionState_SESSION_UNSPECIFIED && info.State != f.State {
+		return false
+	}
+	if f.MinState > 0 && time.Duration(info.StateSec)*time.Second < f.MinState {
+		return false
+	}
+	if f.MinIdle > 0 && time.Duration(info.IdleSec)*time.Second < f.MinIdle {
+		return false
+	}
+	return true
+}
+
+// setSessions 保存本次执行的会话（任务结束后保留，便于排查）
+func (t *Task) setSessions(sessions []*Session) {
+	t.mu.Lock()
+	t.sessions = sessions
+	t.mu.Unlock()
+}
+
+// Sessions 按条件返回会话快照（空闲时长倒序）及过滤后的总数
+func (t *Task) Sessions(f SessionFilter) ([]*v1.SessionInfo, int) {
+	t.mu.RLock()
+	sessions := t.sessions
+	t.mu.RUnlock()
+
+	now := time.Now()
+	out := make([]*v1.SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		if info := s.Info(now); f.match(info) {
+			out = append(out, info)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].IdleSec > out[j].IdleSec })
+
+	total := len(out)
+	if f.Limit > 0 && len(out) > f.Limit {
+		out = out[:f.Limit]
+	}
+	return out, total
+}
@@ -0,0 +1,180 @@
+package task
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+const defaultSLOWarmup = time.Minute // 运行中检查的默认预热时长
+
+// SLO 指标（延迟类见 latencyMetrics，单位毫秒）
+const (
+	MetricQPS          = "qps"
+	MetricErrorRatePct = "error_rate_pct"
+	MetricRTPPct       = "rtp_pct"
+	MetricClientRTPPct = "client_rtp_pct"
+)
+
+// latencyMetrics 延迟类指标取值
+var latencyMetrics = map[string]func(*v1.LatencyStats) float64{
+	"latency_avg":  func(l *v1.LatencyStats) float64 { return l.Avg },
+	"latency_p50":  func(l *v1.LatencyStats) float64 { return l.P50 },
+	"latency_p90":  func(l *v1.LatencyStats) float64 { return l.P90 },
+	"latency_p95":  func(l *v1.LatencyStats) float64 { return l.P95 },
+	"latency_p99":  func(l *v1.LatencyStats) float64 { return l.P99 },
+	"latency_p999": func(l *v1.LatencyStats) float64 { return l.P999 },
+	"latency_max":  func(l *v1.LatencyStats) float64 { return l.Max },
+}
+
+var sloOperators = map[string]func(actual, want float64) bool{
+	"lt":  func(a, w float64) bool { return a < w },
+	"lte": func(a, w float64) bool { return a <= w },
+	"gt":  func(a, w float64) bool { return a > w },
+	"gte": func(a, w float64) bool { return a >= w },
+}
+
+// slo 解析后的 SLO 配置；nil 表示未配置
+type slo struct {
+	warmup     time.Duration
+	assertions []assertion
+}
+
+type assertion struct {
+	name    string
+	metric  string
+	request string
+	compare func(actual, want float64) bool
+	value   float64
+	hard    bool
+}
+
+func newSLO(c *v1.SLO) (*slo, error) {
+	if len(c.GetAssertions()) == 0 {
+		return nil, nil
+	}
+	warmup, err := parseDuration("slo.warmup", c.GetWarmup())
+	if err != nil {
+		return nil, err
+	}
+	if warmup <= 0 {
+		warmup = defaultSLOWarmup
+	}
+
+	s := &slo{warmup: warmup, assertions: make([]assertion, 0, len(c.Assertions))}
+	for i, a := range c.Assertions {
+		metric := strings.ToLower(strings.TrimSpace(a.Metric))
+		_, isLatency := latencyMetrics[metric]
+		if !isLatency && metric != MetricQPS && metric != MetricErrorRatePct && metric != MetricRTPPct && metric != MetricClientRTPPct {
+			return nil, fmt.Errorf("slo.assertions[%d]: unknown metric %q", i, a.Metric)
+		}
+		operator := strings.ToLower(strings.TrimSpace(a.Operator))
+		compare, ok := sloOperators[operator]
+		if !ok {
+			return nil, fmt.Errorf("slo.assertions[%d]: unknown operator %q", i, a.Operator)
+		}
+
+		name := metric
+		var request string
+		if isLatency {
+			if request = a.Request; request == "" {
+				request = OpBetOrder
+			}
+			if !slices.Contains(latencyOps, request) {
+				return nil, fmt.Errorf("slo.assertions[%d]: unknown request %q", i, a.Request)
+			}
+			name += "(" + request + ")"
+		}
+		s.assertions = append(s.assertions, assertion{
+			name:    name + " " + operator + " " + strconv.FormatFloat(a.Value, 'f', -1, 64),
+			metric:  metric,
+			request: request,
+			compare: compare,
+			value:   a.Value,
+			hard:    a.Hard,
+		})
+	}
+	return s, nil
+}
+
+// evaluate 按报告判定全部断言；未配置时返回 nil, true
+func (s *slo) evaluate(r *v1.TaskCompletionReport) ([]*v1.AssertionResult, bool) {
+	if s == nil {
+		return nil, true
+	}
+	passed := true
+	out := make([]*v1.AssertionResult, 0, len(s.assertions))
+	for _, a := range s.assertions {
+		res := &v1.AssertionResult{Name: a.name, Hard: a.hard}
+		actual, ok := a.actual(r)
+		if !ok {
+			res.NoData, res.Passed = true, true
+		} else {
+			res.Actual = actual
+			res.Passed = a.compare(actual, a.value)
+		}
+		passed = passed && res.Passed
+		out = append(out, res)
+	}
+	return out, passed
+}
+
+// actual 从报告中取指标值，无数据返回 false
+func (a *assertion) actual(r *v1.TaskCompletionReport) (float64, bool) {
+	switch a.metric {
+	case MetricQPS:
+		return r.Qps, true
+	case MetricRTPPct:
+		return r.RtpPct, r.TotalBet > 0
+	case MetricClientRTPPct:
+		return r.ClientRtpPct, r.ClientBet > 0
+	case MetricErrorRatePct:
+		var ok int64
+		for _, l := range r.Latencies {
+			ok += l.Count
+		}
+		total := ok + r.FailedReqs
+		if total == 0 {
+			return 0, false
+		}
+		return float64(r.FailedReqs) * 100 / float64(total), true
+	}
+	if get, isLatency := latencyMetrics[a.metric]; isLatency {
+		for _, l := range r.Latencies {
+			if l.Op == a.request && l.Count > 0 {
+				return get(l), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// hardBreach 返回第一条未通过的硬性断言的失败原因
+func hardBreach(results []*v1.AssertionResult) (string, bool) {
+	for _, r := range results {
+		if r.Hard && !r.Passed {
+			return fmt.Sprintf("SLO breached: %s, actual %.2f", r.Name, r.Actual), true
+		}
+	}
+	return "", false
+}
+
+// applySLO 判定断言并写入报告
+func (t *Task) applySLO(rpt *v1.TaskCompletionReport) {
+	rpt.Slo, rpt.SloPassed = t.slo.evaluate(rpt)
+}
+
+// checkSLO 运行中检查（预热期后）：硬性断言违反时任务失败并提前结束
+func (t *Task) checkSLO(rpt *v1.TaskCompletionReport, now time.Time) {
+	if t.slo == nil || t.elapsed(now) < t.slo.warmup || t.GetStatus() != v1.TaskStatus_TASK_RUNNING {
+		return
+	}
+	if reason, breached := hardBreach(rpt.Slo); breached {
+		t.log.Warnf("[%s] %s, stopping task", t.id, reason)
+		t.Fail(reason)
+	}
+}
@@ -0,0 +1,52 @@
+package task
+
+import (
+	"testing"
+
+	v1 "stress/api/stress/v1"
+)
+
+func TestSLOEvaluate(t *testing.T) {
+	s, err := newSLO(&v1.SLO{Assertions: []*v1.Assertion{
+		{Metric: "latency_p99", Operator: "lt", Value: 200, Hard: true},
+		{Metric: "error_rate_pct", Operator: "lt", Value: 0.5},
+		{Metric: "rtp_pct", Operator: "gte", Value: 95},
+		{Metric: "rtp_pct", Operator: "lte", Value: 97},
+		{Metric: "latency_p99", Request: OpBetBonus, Operator: "lt", Value: 100},
+	}})
+	if err != nil {
+		t.Fatalf("newSLO: %v", err)
+	}
+
+	rpt := &v1.TaskCompletionReport{
+		TotalBet:   1000,
+		RtpPct:     96.2,
+		FailedReqs: 1,
+		Latencies:  []*v1.LatencyStats{{Op: OpBetOrder, Count: 999, P99: 250}},
+	}
+	results, passed := s.evaluate(rpt)
+	if passed {
+		t.Fatal("p99 250ms should fail the SLO")
+	}
+	want := []struct{ passed, noData bool }{{false, false}, {true, false}, {true, false}, {true, false}, {true, true}}
+	for i, w := range want {
+		if results[i].Passed != w.passed || results[i].NoData != w.noData {
+			t.Errorf("%s: passed=%v noData=%v, want %v/%v", results[i].Name, results[i].Passed, results[i].NoData, w.passed, w.noData)
+		}
+	}
+	if reason, ok := hardBreach(results); !ok || reason != "SLO breached: latency_p99(betorder) lt 200, actual 250.00" {
+		t.Fatalf("hardBreach = %q, %v", reason, ok)
+	}
+}
+
+func TestSLOInvalid(t *testing.T) {
+	for _, a := range []*v1.Assertion{
+		{Metric: "latency_p42", Operator: "lt"},
+		{Metric: "qps", Operator: "eq"},
+		{Metric: "latency_p99", Request: "spin", Operator: "lt"},
+	} {
+		if _, err := newSLO(&v1.SLO{Assertions: []*v1.Assertion{a}}); err == nil {
+			t.Errorf("assertion %v should be rejected", a)
+		}
+	}
+}
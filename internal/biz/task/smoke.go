@@ -0,0 +1,124 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+	"stress/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	smokeTaskID   = "smoke"
+	maxSmokeSteps = 200 // 单次冒烟测试的请求数上限，避免判定异常导致死循环
+)
+
+// 游戏判定名（SmokeStep.predicates）
+const (
+	PredicateIsSpinOver     = "IsSpinOver"
+	PredicateNeedBetBonus   = "NeedBetBonus"
+	PredicateBonusNextState = "BonusNextState"
+)
+
+// smokeRun 冒烟测试过程：按顺序记录每次请求的报文与判定
+type smokeRun struct {
+	steps   []*v1.SmokeStep
+	pending *Exchange // 最近一次请求的报文，由 Observer 写入
+}
+
+// SmokeTest 单成员走一遍 launch → login → betorder（→ betbonus），直到完成 cfg.TimesPerMember 局。
+// 与正式压测的会话状态机一致，但不重试：任一步失败即停止，返回已记录的步骤与错误
+func SmokeTest(ctx context.Context, g base.IGame, cfg *v1.TaskConfig, member string, launch *conf.Stress_Launch, secret base.SecretProvider, logger log.Logger) ([]*v1.SmokeStep, error) {
+	t, err := NewTask(ctx, smokeTaskID, g, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer t.Stop()
+
+	client := NewAPIClient(1, secret, launch)
+	defer client.Close()
+	if err := client.BindSessionEnv(t); err != nil {
+		return nil, err
+	}
+	run := &smokeRun{}
+	client.SetObserver(func(ex *Exchange) { run.pending = ex })
+	tr := client.NewTransport()
+	defer tr.Close()
+
+	token, err := tr.Launch(t.ctx, cfg, member)
+	run.record(OpLaunch, nil, err)
+	if err != nil {
+		return run.steps, err
+	}
+
+	token, freeData, err := tr.Login(t.ctx, cfg, token)
+	step := run.record(OpLogin, freeData, err)
+	if err != nil {
+		return run.steps, err
+	}
+	inBonus := g.NeedBetBonus(freeData)
+	step.Predicates = append(step.Predicates, &v1.Predicate{Name: PredicateNeedBetBonus, Fired: inBonus})
+
+	for spins := int32(0); spins < cfg.TimesPerMember; {
+		if len(run.steps) >= maxSmokeSteps {
+			return run.steps, fmt.Errorf("step limit %d reached after %d/%d spins, check IsSpinOver/BonusNextState",
+				maxSmokeSteps, spins, cfg.TimesPerMember)
+		}
+
+		if inBonus {
+			res, err := tr.BetBonus(t.ctx, cfg, token, g.PickBonusNum())
+			if err != nil {
+				run.record(OpBetBonus, nil, err)
+				return run.steps, err
+			}
+			inBonus = res.NeedContinue
+			run.record(OpBetBonus, res.Data, nil).Predicates = []*v1.Predicate{{Name: PredicateBonusNextState, Fired: inBonus}}
+			continue
+		}
+
+		res, err := tr.BetOrder(t.ctx, cfg, token)
+		if err != nil {
+			run.record(OpBetOrder, nil, err)
+			return run.steps, err
+		}
+		spinOver, needBonus := res.SpinOver(g), res.NeedBonus(g)
+		run.record(OpBetOrder, res.GetData(), nil).Predicates = []*v1.Predicate{
+			{Name: PredicateIsSpinOver, Fired: spinOver},
+			{Name: PredicateNeedBetBonus, Fired: needBonus},
+		}
+		if spinOver {
+			spins++
+		}
+		// 与会话状态机一致：已完成目标局数时不再进入 bonus
+		inBonus = needBonus && !(spinOver && spins >= cfg.TimesPerMember)
+	}
+	return run.steps, nil
+}
+
+// record 将最近一次请求的报文、解码结果与错误记为一步（请求未发出时仅有 op 与错误）
+func (r *smokeRun) record(op string, decoded map[string]any, err error) *v1.SmokeStep {
+	step := &v1.SmokeStep{Op: op}
+	if ex := r.pending; ex != nil {
+		step.Url = ex.URL
+		step.Request = string(ex.Request)
+		step.HttpStatus = int32(ex.Status)
+		step.Response = string(ex.Response)
+		step.LatencyMs = ex.Latency.Milliseconds()
+	}
+	r.pending = nil
+	if err != nil {
+		step.Error = err.Error()
+	}
+	if decoded != nil {
+		// encoding/json 按 key 排序，便于对比多次结果
+		if b, err := json.Marshal(decoded); err == nil {
+			step.Decoded = string(b)
+		}
+	}
+	r.steps = append(r.steps, step)
+	return step
+}
@@ -0,0 +1,95 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+	"stress/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// smokeGame 第一次下注触发 bonus，选完 bonus 后下一次下注结束本局
+type smokeGame struct{ *base.Default }
+
+func (smokeGame) IsSpinOver(data map[string]any) bool   { return data["over"] == true }
+func (smokeGame) NeedBetBonus(data map[string]any) bool { return data["bonus"] == true }
+func (smokeGame) BonusNextState(map[string]any) bool    { return false }
+
+func TestSmokeTestRecordsStepsAndPredicates(t *testing.T) {
+	bets := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/game/launch":
+			fmt.Fprint(w, `{"code":0,"data":{"launchUrl":"https://game/?token=t1"}}`)
+		case "/api/member/login":
+			fmt.Fprint(w, `{"code":0,"data":{"token":"t2","freeData":{}}}`)
+		case "/api/game/betorder":
+			bets++
+			fmt.Fprintf(w, `{"code":0,"data":{"over":%t,"bonus":%t}}`, bets > 1, bets == 1)
+		case "/api/game/betbonus":
+			fmt.Fprint(w, `{"code":0,"data":{"picked":1}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	g := smokeGame{base.NewBaseGame(18888, "smoke")}
+	cfg := &v1.TaskConfig{GameId: 18888, MemberCount: 1, TimesPerMember: 1}
+	launch := &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}
+	steps, err := SmokeTest(context.Background(), g, cfg, "m1", launch, NoopSecretProvider, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("SmokeTest: %v", err)
+	}
+
+	want := []struct {
+		op    string
+		fired map[string]bool
+	}{
+		{OpLaunch, nil},
+		{OpLogin, map[string]bool{PredicateNeedBetBonus: false}},
+		{OpBetOrder, map[string]bool{PredicateIsSpinOver: false, PredicateNeedBetBonus: true}},
+		{OpBetBonus, map[string]bool{PredicateBonusNextState: false}},
+		{OpBetOrder, map[string]bool{PredicateIsSpinOver: true, PredicateNeedBetBonus: false}},
+	}
+	if len(steps) != len(want) {
+		t.Fatalf("got %d steps, want %d", len(steps), len(want))
+	}
+	for i, w := range want {
+		s := steps[i]
+		if s.Op != w.op || s.HttpStatus != http.StatusOK || s.Response == "" {
+			t.Fatalf("step %d = %s/%d, want %s/200 with raw response", i, s.Op, s.HttpStatus, w.op)
+		}
+		if len(s.Predicates) != len(w.fired) {
+			t.Fatalf("step %d predicates = %v, want %v", i, s.Predicates, w.fired)
+		}
+		for _, p := range s.Predicates {
+			if fired, ok := w.fired[p.Name]; !ok || fired != p.Fired {
+				t.Fatalf("step %d predicate %s = %t, want %v", i, p.Name, p.Fired, w.fired)
+			}
+		}
+	}
+	if steps[2].Decoded != `{"bonus":true,"over":false}` {
+		t.Fatalf("decoded = %s", steps[2].Decoded)
+	}
+}
+
+func TestSmokeTestStopsOnError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":1001,"msg":"merchant disabled"}`)
+	}))
+	defer srv.Close()
+
+	g := smokeGame{base.NewBaseGame(18888, "smoke")}
+	cfg := &v1.TaskConfig{GameId: 18888, MemberCount: 1, TimesPerMember: 1}
+	steps, err := SmokeTest(context.Background(), g, cfg, "m1", &conf.Stress_Launch{LaunchUrl: srv.URL}, NoopSecretProvider, log.DefaultLogger)
+	if err == nil || len(steps) != 1 || steps[0].Op != OpLaunch || steps[0].Error == "" {
+		t.Fatalf("err=%v steps=%v, want launch failure recorded", err, steps)
+	}
+}
@@ -0,0 +1,120 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+const persistTimeout = 5 * time.Second // 单次持久化超时
+
+// Store 任务持久化：任务每次状态变更异步写一次快照，finalize 时写最终报告
+type Store interface {
+	// SaveTask 按 task_id 插入或更新任务快照
+	SaveTask(ctx context.Context, t *v1.Task) error
+	// SaveTaskReport 保存任务最终报告
+	SaveTaskReport(ctx context.Context, taskID string, rpt *v1.TaskCompletionReport) error
+}
+
+// Filter 任务列表过滤条件（内存池与历史库共用）
+type Filter struct {
+	Status v1.TaskStatus // 0=全部
+	GameID int64         // 0=全部
+	Start  time.Time     // 创建时间下限（含），零值不限
+	End    time.Time     // 创建时间上限（不含），零值不限
+	Offset int
+	Limit  int // <=0 不分页
+}
+
+// Match 判断内存任务是否满足过滤条件
+func (f Filter) Match(t *Task) bool {
+	if f.Status != v1.TaskStatus_TASK_UNSPECIFIED && t.GetStatus() != f.Status {
+		return false
+	}
+	if f.GameID != 0 && t.GetConfig().GetGameId() != f.GameID {
+		return false
+	}
+	if !f.Start.IsZero() && t.createdAt.Before(f.Start) {
+		return false
+	}
+	if !f.End.IsZero() && !t.createdAt.Before(f.End) {
+		return false
+	}
+	return true
+}
+
+// bindStore 绑定持久化存储（入池时调用）
+func (t *Task) bindStore(s Store) {
+	t.mu.Lock()
+	t.store = s
+	t.mu.Unlock()
+}
+
+// persist 将当前快照加入写入队列，由该任务唯一的写协程按入队顺序写入存储，
+// 状态变更的调用方（RPC、调度器）不等待数据库
+func (t *Task) persist() {
+	t.mu.RLock()
+	store := t.store
+	t.mu.RUnlock()
+	if store == nil {
+		return
+	}
+
+	t.persistMu.Lock()
+	defer t.persistMu.Unlock()
+	t.persistQueue = append(t.persistQueue, t.toProto())
+	if t.persistDone == nil {
+		t.persistDone = make(chan struct{})
+		go t.persistLoop(store, t.persistDone)
+	}
+}
+
+// persistLoop 依次写入队列中的快照，队列清空后退出
+func (t *Task) persistLoop(store Store, done chan struct{}) {
+	defer close(done)
+	for {
+		t.persistMu.Lock()
+		if len(t.persistQueue) == 0 {
+			t.persistDone = nil
+			t.persistMu.Unlock()
+			return
+		}
+		snap := t.persistQueue[0]
+		t.persistQueue[0] = nil
+		t.persistQueue = t.persistQueue[1:]
+		t.persistMu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+		if err := store.SaveTask(ctx, snap); err != nil {
+			t.log.Warnf("[%s] persist task: %v", t.id, err)
+		}
+		cancel()
+	}
+}
+
+// waitPersist 等待已入队的快照全部写入
+func (t *Task) waitPersist() {
+	t.persistMu.Lock()
+	done := t.persistDone
+	t.persistMu.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+// persistReport 保存最终报告
+func (t *Task) persistReport(rpt *v1.TaskCompletionReport) {
+	t.mu.RLock()
+	store := t.store
+	t.mu.RUnlock()
+	if store == nil || rpt == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+	defer cancel()
+	if err := store.SaveTaskReport(ctx, t.id, rpt); err != nil {
+		t.log.Warnf("[%s] persist task report: %v", t.id, err)
+	}
+}
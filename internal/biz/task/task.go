@@ -3,6 +3,7 @@ package task
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -12,8 +13,20 @@ import (
 	"stress/pkg/xgo"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
 )
 
+// 请求类型（延迟直方图、错误分类的维度）
+const (
+	OpLaunch   = "launch"
+	OpLogin    = "login"
+	OpBetOrder = "betorder"
+	OpBetBonus = "betbonus"
+)
+
+// latencyOps 延迟统计的请求类型（按调用链顺序输出）
+var latencyOps = []string{OpLaunch, OpLogin, OpBetOrder, OpBetBonus}
+
 // Task 压测任务实体（领域模型）
 type Task struct {
 	mu           sync.RWMutex
@@ -22,14 +35,36 @@ type Task struct {
 	config       *v1.TaskConfig
 	status       v1.TaskStatus
 	createdAt    time.Time
-	startAt      time.Time // 实际开始执行时间
-	finishAt     time.Time //
-	record       string    // S3 HTML 图表 URL
-	orderWarning string    // 订单等待超时警告
+	startAt      time.Time                // 实际开始执行时间
+	finishAt     time.Time                //
+	record       string                   // S3 HTML 图表 URL
+	reason       string                   // 失败原因
+	orderWarning string                   // 订单等待超时警告
+	orders       orderStats               // 最近一次订单统计（上报周期刷新）
+	report       *v1.TaskCompletionReport // 最终报告（finalize 后冻结）
+	pausedAt     time.Time                // 本次暂停开始时间（未暂停为零值）
+	pausedTotal  time.Duration            // 已结束的暂停累计时长
+	resumeCh     chan struct{}            // 暂停期间非 nil，Resume 时关闭
+	timeLimit    time.Duration            // 运行时长上限（config.duration，0 表示仅按局数）
+	statusCh     chan struct{}            // 状态变更广播（WatchTask 订阅，变更时关闭并重建）
+	errors       errorRing                // 最近错误样本
+	errClasses   errorStats               // 错误分类统计
+	sessions     []*Session               // 本次执行的会话（ListSessions）
+	retry        *retryPolicy             // 会话重试策略（创建后只读）
+	breaker      *circuitBreaker          // 任务级熔断（nil 表示不启用）
+	slo          *slo                     // 通过/失败判定（nil 表示未配置）
+	chaos        *chaos                   // 客户端故障注入（nil 表示未配置）
 	ctx          context.Context
 	cancel       context.CancelFunc
 	log          *log.Helper
-	stats        Stats // 统计信息（线程安全）
+	store        Store                 // 持久化（可为 nil）
+	persistMu    sync.Mutex            // 保护下面的写入队列
+	persistQueue []*v1.Task            // 待写入的快照（按状态变更顺序）
+	persistDone  chan struct{}         // 写协程运行中时非 nil，退出时关闭
+	pacer        *Pacer                // 开环模式令牌桶（nil 表示闭环）
+	latency      map[string]*Histogram // op -> 延迟直方图（创建后只读，无需加锁）
+	recorder     *recorder             // 请求录制（Execute 开始时创建，nil 表示不录制）
+	stats        Stats                 // 统计信息（线程安全）
 }
 
 // Stats TaskStats 任务统计信息（线程安全）
@@ -43,6 +78,53 @@ type Stats struct {
 	Completed int64 // 成功完成的成员数
 	Failed    int64 // 失败的成员数
 	Errors    int64 // 错误次数
+	ClientBet int64 // 客户端统计的下注额（×1e4，仅实现 base.SpinParser 的游戏）
+	ClientWin int64 // 客户端统计的赢分（×1e4）
+}
+
+// parsedConfig 由 TaskConfig 解析出的执行参数
+type parsedConfig struct {
+	pacer     *Pacer
+	timeLimit time.Duration
+	retry     *retryPolicy
+	breaker   *circuitBreaker
+	slo       *slo
+	chaos     *chaos
+}
+
+func parseConfig(cfg *v1.TaskConfig) (*parsedConfig, error) {
+	var (
+		p   parsedConfig
+		err error
+	)
+	if p.pacer, err = NewPacer(cfg.GetLoad()); err != nil {
+		return nil, err
+	}
+	if p.timeLimit, err = parseDuration("duration", cfg.GetDuration()); err != nil {
+		return nil, err
+	}
+	if p.retry, err = newRetryPolicy(cfg.GetRetry()); err != nil {
+		return nil, err
+	}
+	if p.breaker, err = newCircuitBreaker(cfg.GetRetry().GetBreaker()); err != nil {
+		return nil, err
+	}
+	if p.slo, err = newSLO(cfg.GetSlo()); err != nil {
+		return nil, err
+	}
+	if p.chaos, err = newChaos(cfg.GetChaos()); err != nil {
+		return nil, err
+	}
+	if cfg.GetTimesPerMember() <= 0 && p.timeLimit <= 0 {
+		return nil, fmt.Errorf("times_per_member or duration is required")
+	}
+	return &p, nil
+}
+
+// ValidateConfig 校验任务配置（与 NewTask 相同的规则，供定时任务模板等在创建任务前校验）
+func ValidateConfig(cfg *v1.TaskConfig) error {
+	_, err := parseConfig(cfg)
+	return err
 }
 
 // NewTask 创建任务，parent 取消时任务会收到信号
@@ -50,6 +132,14 @@ func NewTask(parent context.Context, id string, g base.IGame, cfg *v1.TaskConfig
 	if parent == nil {
 		parent = context.Background()
 	}
+	p, err := parseConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	latency := make(map[string]*Histogram, len(latencyOps))
+	for _, op := range latencyOps {
+		latency[op] = NewHistogram()
+	}
 	ctx, cancel := context.WithCancel(parent)
 	return &Task{
 		id:        id,
@@ -61,15 +151,28 @@ func NewTask(parent context.Context, id string, g base.IGame, cfg *v1.TaskConfig
 		ctx:       ctx,
 		cancel:    cancel,
 		log:       log.NewHelper(logger),
+		pacer:     p.pacer,
+		timeLimit: p.timeLimit,
+		retry:     p.retry,
+		breaker:   p.breaker,
+		slo:       p.slo,
+		chaos:     p.chaos,
+		latency:   latency,
 	}, nil
 }
 
 func (t *Task) GetID() string             { return t.id }
 func (t *Task) Context() context.Context  { return t.ctx }
 func (t *Task) GetConfig() *v1.TaskConfig { return t.config }
-func (t *Task) GetGame() base.IGame       { return t.game }
 func (t *Task) GetCreatedAt() time.Time   { return t.createdAt }
 
+// GetGame 返回游戏实例，任务清理后为 nil
+func (t *Task) GetGame() base.IGame {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.game
+}
+
 func (t *Task) AddActive(delta int64) { atomic.AddInt64(&t.stats.Active, delta) }
 
 func (t *Task) GetStatus() v1.TaskStatus {
@@ -80,20 +183,78 @@ func (t *Task) GetStatus() v1.TaskStatus {
 
 func (t *Task) SetStatus(s v1.TaskStatus) {
 	t.mu.Lock()
-	defer t.mu.Unlock()
 	t.status = s
+	t.mu.Unlock()
+	t.onStatusChange()
 }
 
 func (t *Task) CompareAndSetStatus(old, new v1.TaskStatus) bool {
+	t.mu.Lock()
+	if t.status != old {
+		t.mu.Unlock()
+		return false
+	}
+	t.status = new
+	t.mu.Unlock()
+	t.onStatusChange()
+	return true
+}
+
+// Fail 标记任务失败并记录原因，同时停止任务上下文；任务已结束时返回 false
+func (t *Task) Fail(reason string) bool {
+	return t.fail(reason, false)
+}
+
+// Abort 任务未能开始执行（如目标环境不可用）：以失败结束并记录结束时间，不生成报告；任务已结束时返回 false
+func (t *Task) Abort(reason string) bool {
+	return t.fail(reason, true)
+}
+
+func (t *Task) fail(reason string, finish bool) bool {
+	t.mu.Lock()
+	if !t.isActiveLocked() {
+		t.mu.Unlock()
+		return false
+	}
+	t.status = v1.TaskStatus_TASK_FAILED
+	t.reason = reason
+	if finish && t.finishAt.IsZero() {
+		t.finishAt = time.Now()
+	}
+	t.mu.Unlock()
+
+	t.Stop()
+	t.log.Errorf("[%s] task failed: %s", t.id, reason)
+	t.onStatusChange()
+	return true
+}
+
+// markFailed 记录失败原因但不改变状态（finalize 中先进入 PROCESSING，报告完成后再转为 FAILED）；任务已结束时返回 false
+func (t *Task) markFailed(reason string) bool {
 	t.mu.Lock()
 	defer t.mu.Unlock()
-	if t.status == old {
-		t.status = new
+	if !t.isActiveLocked() {
+		return false
+	}
+	t.reason = reason
+	return true
+}
+
+// isActiveLocked 任务尚未结束（排队/运行/暂停），调用方持有 t.mu
+func (t *Task) isActiveLocked() bool {
+	switch t.status {
+	case v1.TaskStatus_TASK_PENDING, v1.TaskStatus_TASK_RUNNING, v1.TaskStatus_TASK_PAUSED:
 		return true
 	}
 	return false
 }
 
+func (t *Task) GetReason() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.reason
+}
+
 func (t *Task) SetStartAt() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -148,9 +309,8 @@ func (t *Task) getOrderWarning() string {
 
 func (t *Task) Cancel() error {
 	t.mu.Lock()
-	defer t.mu.Unlock()
-
-	if t.status != v1.TaskStatus_TASK_PENDING && t.status != v1.TaskStatus_TASK_RUNNING {
+	if !t.isActiveLocked() {
+		t.mu.Unlock()
 		return fmt.Errorf("TASK_ALREADY_FINISHED. task_id: %s", t.id)
 	}
 
@@ -159,7 +319,10 @@ func (t *Task) Cancel() error {
 		t.finishAt = time.Now()
 	}
 	t.Stop()
+	t.mu.Unlock()
+
 	t.log.Infof("[%s] task cancelled", t.id)
+	t.onStatusChange()
 	return nil
 }
 
@@ -173,28 +336,74 @@ func (t *Task) Stop() {
 
 func (t *Task) GetStep() int64   { return atomic.LoadInt64(&t.stats.Step) }
 func (t *Task) GetTarget() int64 { return atomic.LoadInt64(&t.stats.Target) }
+
+// IsTargetReached 局数目标或时长上限任一达成
 func (t *Task) IsTargetReached() bool {
-	target := atomic.LoadInt64(&t.stats.Target)
-	if target <= 0 {
-		return false
+	if target := atomic.LoadInt64(&t.stats.Target); target > 0 && atomic.LoadInt64(&t.stats.Process) >= target {
+		return true
 	}
-	return atomic.LoadInt64(&t.stats.Process) >= target
+	return t.IsTimeUp(time.Now())
+}
+
+// IsTimeUp 是否达到时长上限（未设置 duration 时恒为 false）
+func (t *Task) IsTimeUp(now time.Time) bool {
+	return t.timeLimit > 0 && t.elapsed(now) >= t.timeLimit
 }
 
 func (t *Task) AddBetOrder(d time.Duration, spinOver bool) {
 	atomic.AddInt64(&t.stats.Step, 1)
 	atomic.AddInt64(&t.stats.Duration, d.Nanoseconds())
+	t.RecordLatency(OpBetOrder, d)
 	if spinOver {
 		atomic.AddInt64(&t.stats.Process, 1)
 	}
 }
 
+// AddSpin 累计类型化下注结果的下注与赢分，spin 为 nil（游戏未实现 base.SpinParser）时忽略
+func (t *Task) AddSpin(spin *base.SpinResult) {
+	if spin == nil {
+		return
+	}
+	atomic.AddInt64(&t.stats.ClientBet, int64(math.Round(spin.Bet*1e4)))
+	atomic.AddInt64(&t.stats.ClientWin, int64(math.Round(spin.Win*1e4)))
+}
+
 func (t *Task) AddBetBonus(d time.Duration) {
 	atomic.AddInt64(&t.stats.BonusStep, 1)
 	atomic.AddInt64(&t.stats.Duration, d.Nanoseconds())
+	t.RecordLatency(OpBetBonus, d)
+}
+
+// RecordLatency 记录一次成功请求的耗时
+func (t *Task) RecordLatency(op string, d time.Duration) {
+	t.latency[op].Record(d)
+}
+
+// latencyStats 汇总各请求类型的延迟分布（毫秒），无样本的类型不输出
+func (t *Task) latencyStats() []*v1.LatencyStats {
+	out := make([]*v1.LatencyStats, 0, len(latencyOps))
+	for _, op := range latencyOps {
+		h := t.latency[op]
+		n := h.Count()
+		if n == 0 {
+			continue
+		}
+		out = append(out, &v1.LatencyStats{
+			Op:    op,
+			Count: int64(n),
+			Avg:   toMs(h.Mean()),
+			P50:   toMs(h.Quantile(0.50)),
+			P90:   toMs(h.Quantile(0.90)),
+			P95:   toMs(h.Quantile(0.95)),
+			P99:   toMs(h.Quantile(0.99)),
+			P999:  toMs(h.Quantile(0.999)),
+			Max:   toMs(h.Max()),
+		})
+	}
+	return out
 }
 
-func (t *Task) AddError() { atomic.AddInt64(&t.stats.Errors, 1) }
+func toMs(d time.Duration) float64 { return float64(d) / float64(time.Millisecond) }
 
 type metricsData struct {
 	Process     int64
@@ -208,18 +417,24 @@ type metricsData struct {
 	Remaining   time.Duration
 }
 
-// calculateMetrics 直接计算并返回任务指标
-func (t *Task) calculateMetrics(now time.Time) metricsData {
+// elapsed 截至 now 的有效运行时长（未开始按创建时间起算，结束后固定，扣除暂停时长）
+func (t *Task) elapsed(now time.Time) time.Duration {
 	t.mu.RLock()
-	finishedAt := t.finishAt
-	startAt := t.startAt
-	t.mu.RUnlock()
+	defer t.mu.RUnlock()
 
-	effectiveStart := startAt
-	if effectiveStart.IsZero() {
-		effectiveStart = t.createdAt
+	end := now
+	if !t.finishAt.IsZero() {
+		end = t.finishAt
+	}
+	start := t.startAt
+	if start.IsZero() {
+		start = t.createdAt
 	}
+	return end.Sub(start) - t.pausedDuration(end)
+}
 
+// calculateMetrics 直接计算并返回任务指标
+func (t *Task) calculateMetrics(now time.Time) metricsData {
 	m := metricsData{
 		Process:   atomic.LoadInt64(&t.stats.Process),
 		Step:      atomic.LoadInt64(&t.stats.Step),
@@ -228,11 +443,8 @@ func (t *Task) calculateMetrics(now time.Time) metricsData {
 	}
 	duration := atomic.LoadInt64(&t.stats.Duration)
 
-	// 计算耗时
-	m.Elapsed = now.Sub(effectiveStart)
-	if !finishedAt.IsZero() {
-		m.Elapsed = finishedAt.Sub(effectiveStart)
-	}
+	// 计算耗时（扣除暂停时长）
+	m.Elapsed = t.elapsed(now)
 
 	// 计算QPS
 	if sec := m.Elapsed.Seconds(); sec > 0 {
@@ -247,22 +459,51 @@ func (t *Task) calculateMetrics(now time.Time) metricsData {
 		m.AvgLatency = "0ms"
 	}
 
-	// 计算进度百分比
+	// 计算进度百分比：局数目标与时长上限取先到者
+	var spinPct, timePct float64
 	if m.Target > 0 {
-		m.ProgressPct = float64(m.Process*100) / float64(m.Target)
-		if m.ProgressPct > 100 {
-			m.ProgressPct = 100
-		}
+		spinPct = float64(m.Process*100) / float64(m.Target)
 	}
+	if t.timeLimit > 0 {
+		timePct = float64(m.Elapsed) * 100 / float64(t.timeLimit)
+	}
+	m.ProgressPct = math.Min(math.Max(spinPct, timePct), 100)
 
-	// 计算剩余时间
-	if m.ProgressPct > 0 && m.ProgressPct < 100 {
-		m.Remaining = time.Duration(float64(m.Elapsed)/m.ProgressPct*100) - m.Elapsed
+	// 计算剩余时间：按局数线性外推，与时长上限的剩余取较小值
+	if spinPct > 0 && spinPct < 100 {
+		m.Remaining = time.Duration(float64(m.Elapsed)/spinPct*100) - m.Elapsed
+	}
+	if t.timeLimit > 0 {
+		left := t.timeLimit - m.Elapsed
+		if left < 0 {
+			left = 0
+		}
+		if m.Remaining <= 0 || left < m.Remaining {
+			m.Remaining = left
+		}
 	}
 
 	return m
 }
 
+// Remaining 预计剩余时长（尚无进度时返回 0）
+func (t *Task) Remaining(now time.Time) time.Duration {
+	return t.calculateMetrics(now).Remaining
+}
+
+// MemberThroughput 单成员吞吐（局/秒），未开始或无进度时返回 0
+func (t *Task) MemberThroughput(now time.Time) float64 {
+	if t.GetStartAt().IsZero() {
+		return 0
+	}
+	m := t.calculateMetrics(now)
+	members := t.GetConfig().GetMemberCount()
+	if members <= 0 || m.Elapsed <= 0 {
+		return 0
+	}
+	return float64(m.Process) / m.Elapsed.Seconds() / float64(members)
+}
+
 // Snapshot 获取当前任务状态快照（供 metrics、notify、logging 复用）
 func (t *Task) Snapshot(now time.Time) *v1.TaskCompletionReport {
 	m := t.calculateMetrics(now)
@@ -271,11 +512,26 @@ func (t *Task) Snapshot(now time.Time) *v1.TaskCompletionReport {
 	completed := atomic.LoadInt64(&t.stats.Completed)
 	failed := atomic.LoadInt64(&t.stats.Failed)
 	errors := atomic.LoadInt64(&t.stats.Errors)
+	clientBet := atomic.LoadInt64(&t.stats.ClientBet)
+	clientWin := atomic.LoadInt64(&t.stats.ClientWin)
+	clientRTP := 0.0
+	if clientBet > 0 {
+		clientRTP = float64(clientWin*100) / float64(clientBet)
+	}
+
+	// cleanup 后 game 置空，结束后的快照只能从配置取游戏 ID
+	t.mu.RLock()
+	g, rec := t.game, t.recorder
+	t.mu.RUnlock()
+	gameID, gameName := t.config.GetGameId(), ""
+	if g != nil {
+		gameID, gameName = g.GameID(), g.Name()
+	}
 
 	return &v1.TaskCompletionReport{
 		TaskId:        t.id,
-		GameId:        t.game.GameID(),
-		GameName:      t.game.Name(),
+		GameId:        gameID,
+		GameName:      gameName,
 		Process:       m.Process,
 		Target:        m.Target,
 		Step:          m.Step,
@@ -288,7 +544,57 @@ func (t *Task) Snapshot(now time.Time) *v1.TaskCompletionReport {
 		Completed:     completed,
 		Failed:        failed,
 		FailedReqs:    errors,
+		TargetRate:    t.pacer.CurrentRate(now),
+		Latencies:     t.latencyStats(),
+		Errors:        t.errClasses.snapshot(),
+		Chaos:         t.chaos.stats(),
+		ClientBet:     clientBet,
+		ClientWin:     clientWin,
+		ClientRtpPct:  clientRTP,
+		Recorded:      rec.count(),
+	}
+}
+
+// orderStats 订单侧统计（来自订单库，查询代价较高，由上报协程周期刷新）
+type orderStats struct {
+	totalBet int64
+	totalWin int64
+	count    int64
+	rtpPct   float64
+}
+
+// setOrderStats 缓存最近一次订单统计
+func (t *Task) setOrderStats(rpt *v1.TaskCompletionReport) {
+	t.mu.Lock()
+	t.orders = orderStats{totalBet: rpt.TotalBet, totalWin: rpt.TotalWin, count: rpt.OrderCount, rtpPct: rpt.RtpPct}
+	t.mu.Unlock()
+}
+
+// setReport 冻结最终报告
+func (t *Task) setReport(rpt *v1.TaskCompletionReport) {
+	t.mu.Lock()
+	t.report = proto.Clone(rpt).(*v1.TaskCompletionReport)
+	t.mu.Unlock()
+}
+
+// Report 任务统计报告：finalize 后返回冻结的最终报告，运行中返回实时快照（订单统计取最近一次刷新值），未开始返回 nil
+func (t *Task) Report(now time.Time) *v1.TaskCompletionReport {
+	t.mu.RLock()
+	final, orders, started := t.report, t.orders, !t.startAt.IsZero()
+	t.mu.RUnlock()
+
+	if final != nil {
+		return proto.Clone(final).(*v1.TaskCompletionReport)
+	}
+	if !started {
+		return nil
 	}
+	rpt := t.Snapshot(now)
+	rpt.TotalBet, rpt.TotalWin, rpt.OrderCount, rpt.RtpPct = orders.totalBet, orders.totalWin, orders.count, orders.rtpPct
+	setExtraOrders(rpt)
+	rpt.OrderWarning = t.getOrderWarning()
+	t.applySLO(rpt)
+	return rpt
 }
 
 // MarkSessionDone 标记会话执行完成
@@ -307,8 +613,9 @@ func (t *Task) LogProgress(isFinal bool) {
 	m := t.calculateMetrics(now)
 
 	if isFinal {
-		t.log.Infof("[%s] 任务结束: 进度:%d/%d, 总步数:%d, 耗时:%v, QPS:%.2f, bonus总步数:%d, 平均延迟:%s",
-			t.id, m.Process, m.Target, m.Step, m.Elapsed, m.QPS, m.BonusStep, m.AvgLatency)
+		bet := t.latency[OpBetOrder]
+		t.log.Infof("[%s] 任务结束: 进度:%d/%d, 总步数:%d, 耗时:%v, QPS:%.2f, bonus总步数:%d, 平均延迟:%s, p99:%.2fms, max:%.2fms",
+			t.id, m.Process, m.Target, m.Step, m.Elapsed, m.QPS, m.BonusStep, m.AvgLatency, toMs(bet.Quantile(0.99)), toMs(bet.Max()))
 	} else {
 		t.log.Infof("[%s]: 进度:%d/%d(%.2f%%), 用时:%s, 剩余:%s, QPS:%.2f, steps:%.2f, bonus:%d, 延迟:%s",
 			t.id, m.Process, m.Target, m.ProgressPct, xgo.ShortDuration(m.Elapsed),
@@ -321,6 +628,13 @@ func (t *Task) ToProto() *v1.Task {
 	if t == nil {
 		return nil
 	}
+	ret := t.toProto()
+	ret.Report = t.Report(time.Now())
+	return ret
+}
+
+// toProto 不含统计报告的任务快照（持久化用，报告单独存储）
+func (t *Task) toProto() *v1.Task {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
@@ -337,6 +651,7 @@ func (t *Task) ToProto() *v1.Task {
 		Config:      t.config,
 		RecordUrl:   t.record,
 		CreatedAt:   t.createdAt.Format(time.DateTime),
+		Reason:      t.reason,
 	}
 	if !t.startAt.IsZero() {
 		ret.StartAt = t.startAt.Format(time.DateTime)
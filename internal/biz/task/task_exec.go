@@ -9,6 +9,7 @@ import (
 
 	v1 "stress/api/stress/v1"
 	"stress/internal/biz/chart"
+	"stress/internal/biz/game/base"
 	"stress/internal/biz/member"
 	"stress/internal/biz/metrics"
 	"stress/internal/biz/notify"
@@ -39,15 +40,60 @@ type Repo interface {
 	CleanRedisBySites(ctx context.Context, sites []string) error
 	// CleanGameOrderTable 清空订单表
 	CleanGameOrderTable(ctx context.Context) error
+	// GetOrderCountByScope 按范围统计订单数（多任务并发时替代全表计数）
+	GetOrderCountByScope(ctx context.Context, scope OrderScope) (int64, error)
+	// DeleteOrdersByScope 按范围删除订单，返回删除行数（多任务并发时替代清表）
+	DeleteOrdersByScope(ctx context.Context, scope OrderScope) (int64, error)
 }
 
 // ExecDeps 任务执行依赖
 type ExecDeps struct {
-	Repo          Repo
-	Conf          *conf.Stress
+	Repo          Repo                // 目标环境的订单库 / Redis
+	Conf          *conf.Stress        // 全局配置
+	Launch        *conf.Stress_Launch // 目标环境的启动配置（nil 使用 Conf.Launch）
+	Secret        base.SecretProvider // launch 签名密钥（nil 不签名）
 	Notify        notify.Notifier
 	Chart         chart.IGenerator
 	ReturnMembers func(taskID string)
+	IsIdle        func(taskID string, r Resource) bool // 除自身外无其他运行中任务使用同一存储（nil 视为空闲）
+	Sites         []string                             // 独占 Redis 时清理的站点（共用该 Redis 的各环境站点，为空使用 launch.sites）
+}
+
+// Resource 目标环境中可能被多个环境共用的存储
+type Resource int
+
+const (
+	ResourceOrders Resource = iota // 订单库
+	ResourceRedis                  // Redis
+)
+
+// launch 目标环境的启动配置
+func (d *ExecDeps) launch() *conf.Stress_Launch {
+	if d.Launch != nil {
+		return d.Launch
+	}
+	return d.Conf.Launch
+}
+
+// secret launch 签名密钥，未配置时不签名
+func (d *ExecDeps) secret() base.SecretProvider {
+	if d.Secret != nil {
+		return d.Secret
+	}
+	return NoopSecretProvider
+}
+
+// isIdle 是否独占存储：独占订单库时可全表计数/清表，独占 Redis 时可清 Redis，否则只能按本任务范围操作
+func (d *ExecDeps) isIdle(taskID string, r Resource) bool {
+	return d.IsIdle == nil || d.IsIdle(taskID, r)
+}
+
+// sites 独占 Redis 时清理的站点
+func (d *ExecDeps) sites() []string {
+	if len(d.Sites) > 0 {
+		return d.Sites
+	}
+	return d.launch().GetSites()
 }
 
 // MemberInfo 成员信息（避免循环依赖）
@@ -63,7 +109,7 @@ type OrderScope struct {
 }
 
 func (t *Task) Execute(members []MemberInfo, deps *ExecDeps) {
-	if t.GetStatus() != v1.TaskStatus_TASK_RUNNING {
+	if s := t.GetStatus(); s != v1.TaskStatus_TASK_RUNNING && s != v1.TaskStatus_TASK_PAUSED {
 		if !t.CompareAndSetStatus(v1.TaskStatus_TASK_PENDING, v1.TaskStatus_TASK_RUNNING) {
 			t.log.Warnf("[%s] task status changed, skip execution", t.GetID())
 			return
@@ -71,11 +117,11 @@ func (t *Task) Execute(members []MemberInfo, deps *ExecDeps) {
 	}
 
 	t.SetStartAt()
+	t.pacer.Start(t.GetStartAt())
 
-	apiClient := NewAPIClient(len(members), NoopSecretProvider, deps.Conf.Launch)
+	apiClient := NewAPIClient(len(members), deps.secret(), deps.launch())
 	if err := apiClient.BindSessionEnv(t); err != nil {
-		t.log.Errorf("[%s] bind session env failed: %v", t.GetID(), err)
-		t.SetStatus(v1.TaskStatus_TASK_FAILED)
+		t.Fail(fmt.Sprintf("bind session env failed: %v", err))
 		t.SetFinishAt()
 		t.waitOrderWrite(deps)
 		t.finalize(deps)
@@ -83,12 +129,18 @@ func (t *Task) Execute(members []MemberInfo, deps *ExecDeps) {
 		return
 	}
 
+	t.startRecording(deps)
+
 	t.Monitor()
 
+	t.watchTimeLimit()
+
 	stopReporter, wg := t.startReporter(deps)
 
 	t.runSessions(members, apiClient)
 
+	t.stopRecording()
+
 	t.Stop()
 
 	t.SetFinishAt()
@@ -111,23 +163,32 @@ func (t *Task) runSessions(members []MemberInfo, apiClient *APIClient) {
 
 	pool, err := ants.NewPool(poolSize, ants.WithPreAlloc(true))
 	if err != nil {
-		t.log.Errorf("[%s] create session pool failed: %v", t.GetID(), err)
-		t.SetStatus(v1.TaskStatus_TASK_FAILED)
+		t.Fail(fmt.Sprintf("create session pool failed: %v", err))
 		return
 	}
 	defer pool.Release()
 
-	var wg sync.WaitGroup
-
+	sessions := make([]*Session, 0, len(members))
 	for _, m := range members {
-		m := m
 		sess := NewSession(m.Name)
+		sess.recorded = t.recorder.sampleSession()
+		sess.chaotic = t.chaos.sampleSession()
+		sessions = append(sessions, sess)
+	}
+	t.setSessions(sessions)
+
+	var wg sync.WaitGroup
+
+	for _, sess := range sessions {
+		sess := sess
 		wg.Add(1)
 		t.AddActive(1)
 		if err := pool.Submit(func() {
 			defer wg.Done()
-			defer t.MarkSessionDone(!sess.IsFailed())
-			if execErr := sess.Execute(apiClient); execErr != nil && !errors.Is(execErr, context.Canceled) {
+			defer func() { t.MarkSessionDone(!sess.IsFailed()) }() // 结束时再判定会话状态
+			tr := apiClient.NewTransport()
+			defer tr.Close()
+			if execErr := sess.Execute(apiClient.Env(), tr); execErr != nil && !errors.Is(execErr, context.Canceled) {
 				t.log.Errorf("[%s] session execution failed: %v", t.GetID(), execErr)
 			}
 		}); err != nil {
@@ -154,7 +215,34 @@ func (t *Task) Monitor() {
 				t.LogProgress(true)
 				return
 			case <-tick.C:
-				t.LogProgress(false)
+				if !t.IsPaused() {
+					t.LogProgress(false)
+				}
+			}
+		}
+	}()
+}
+
+// watchTimeLimit 时长上限到达时取消任务上下文，使处于 bonus、重试等待、重新登录等非下注状态的会话也按时结束；
+// 暂停时长不计入，task context 取消后退出
+func (t *Task) watchTimeLimit() {
+	if t.timeLimit <= 0 {
+		return
+	}
+	go func() {
+		for {
+			left := t.timeLimit - t.elapsed(time.Now())
+			if left <= 0 {
+				t.log.Infof("[%s] duration %v reached, stopping sessions", t.GetID(), t.timeLimit)
+				t.Stop()
+				return
+			}
+			timer := time.NewTimer(left)
+			select {
+			case <-t.ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
 			}
 		}
 	}()
@@ -177,7 +265,7 @@ func (t *Task) startReporter(deps *ExecDeps) (context.CancelFunc, *sync.WaitGrou
 				t.finalize(deps)
 				return
 			case <-ticker.C:
-				t.reportMetrics(deps)
+				t.refreshStats(deps)
 			}
 		}
 	}()
@@ -192,12 +280,26 @@ func (t *Task) waitOrderWrite(deps *ExecDeps) {
 
 	timeout := time.After(orderWaitTimeout)
 	step := t.GetStep()
+	scope := t.buildOrderScope(deps)
 
+	// 独占时全表计数即本任务订单数；并发运行时按本任务范围计数
+	countOrders := func(ctx context.Context) (int64, error) {
+		if deps.isIdle(t.GetID(), ResourceOrders) {
+			return deps.Repo.GetGameOrderCount(ctx)
+		}
+		return deps.Repo.GetOrderCountByScope(ctx, scope)
+	}
+
+	// 先检查一次：订单同步写入（如模拟平台 + 内存订单库）时无需等待
 	for {
+		if orderCount, err := countOrders(context.Background()); err == nil && orderCount >= step {
+			t.log.Infof("[%s] mysql write completed, order count: %d", t.GetID(), orderCount)
+			return
+		}
 		select {
 		case <-timeout:
 			var dbCount int64
-			if n, err := deps.Repo.GetGameOrderCount(context.Background()); err == nil {
+			if n, err := countOrders(context.Background()); err == nil {
 				dbCount = n
 			}
 			warn := fmt.Sprintf("订单等待超时(%v): 任务steps=%d, DB订单数=%d, 差值=%d",
@@ -206,10 +308,6 @@ func (t *Task) waitOrderWrite(deps *ExecDeps) {
 			t.log.Errorf("[%s] %s", t.GetID(), warn)
 			return
 		case <-ticker.C:
-			if orderCount, err := deps.Repo.GetGameOrderCount(context.Background()); err == nil && orderCount >= step {
-				t.log.Infof("[%s] mysql write completed, order count: %d", t.GetID(), orderCount)
-				return
-			}
 		}
 	}
 }
@@ -220,6 +318,7 @@ func (t *Task) fillOrderStats(ctx context.Context, deps *ExecDeps, rpt *v1.TaskC
 		if totalBet > 0 {
 			rpt.RtpPct = float64(totalWin*100) / float64(totalBet)
 		}
+		setExtraOrders(rpt)
 		return
 	}
 	if orderCount, err := deps.Repo.GetGameOrderCount(ctx); err == nil {
@@ -227,16 +326,20 @@ func (t *Task) fillOrderStats(ctx context.Context, deps *ExecDeps, rpt *v1.TaskC
 	}
 }
 
-// reportMetrics 周期性 Prometheus 指标上报
-func (t *Task) reportMetrics(deps *ExecDeps) {
-	if deps.Conf.Metrics == nil || !deps.Conf.Metrics.Enabled {
-		return
-	}
+// refreshStats 周期性刷新订单统计（供实时报告使用）、检查 SLO 并上报 Prometheus 指标
+func (t *Task) refreshStats(deps *ExecDeps) {
 	ctx := context.Background()
-	rpt := t.Snapshot(time.Now())
+	now := time.Now()
+	rpt := t.Snapshot(now)
 	scope := t.buildOrderScope(deps)
 	t.fillOrderStats(ctx, deps, rpt, scope)
-	metrics.ReportTask(rpt)
+	t.setOrderStats(rpt)
+	t.applySLO(rpt)
+	t.checkSLO(rpt, now)
+
+	if deps.Conf.Metrics != nil && deps.Conf.Metrics.Enabled {
+		metrics.ReportTask(rpt)
+	}
 }
 
 // finalize 最终收尾：快照 + 图表上传 + 通知 + 环境清理 + 状态转换
@@ -247,10 +350,19 @@ func (t *Task) finalize(deps *ExecDeps) {
 	t.fillOrderStats(ctx, deps, rpt, scope)
 	rpt.OrderWarning = t.getOrderWarning()
 
+	// 最终判定：硬性断言未通过的任务以失败结束
+	t.applySLO(rpt)
 	pre := t.GetStatus()
+	if reason, breached := hardBreach(rpt.Slo); breached && t.markFailed(reason) {
+		pre = v1.TaskStatus_TASK_FAILED
+		t.log.Errorf("[%s] task failed: %s", t.GetID(), reason)
+	}
 
 	t.SetStatus(v1.TaskStatus_TASK_PROCESSING)
 	t.uploadChart(deps, ctx, rpt, scope)
+	t.uploadRecording(deps, ctx, rpt)
+	t.setReport(rpt)
+	t.persistReport(rpt)
 	t.sendNotification(deps, ctx, rpt)
 	t.cleanupEnvironment(deps, ctx)
 
@@ -267,7 +379,7 @@ func (t *Task) finalize(deps *ExecDeps) {
 	}
 
 	if deps.Conf.Metrics != nil && deps.Conf.Metrics.Enabled {
-		metrics.CleanupTaskMetrics(t.GetID(), t.GetGame().GameID())
+		metrics.CleanupTaskMetrics(t.GetID(), rpt.GameId)
 	}
 }
 
@@ -280,7 +392,7 @@ func (t *Task) buildOrderScope(deps *ExecDeps) OrderScope {
 
 	scope := OrderScope{
 		GameID:     cfg.GameId,
-		Merchant:   deps.Conf.Launch.Merchant,
+		Merchant:   deps.launch().GetMerchant(),
 		StartTime:  t.GetStartAt(),
 		EndTime:    t.GetFinishedAt(),
 		ExcludeAmt: excludeAmt,
@@ -340,17 +452,28 @@ func (t *Task) cleanupEnvironment(deps *ExecDeps, ctx context.Context) {
 	cleanupCtx, cancel := context.WithTimeout(ctx, cleanupTimeout)
 	defer cancel()
 
+	// 订单库与 Redis 分别判断：仍有其他任务使用同一存储时，订单只删除本任务范围，Redis 留给最后结束的任务统一清理
 	var wg sync.WaitGroup
 	wg.Add(2)
 
 	go func() {
 		defer wg.Done()
-		if err := deps.Repo.CleanRedisBySites(cleanupCtx, deps.Conf.Launch.Sites); err != nil {
+		if !deps.isIdle(t.GetID(), ResourceRedis) {
+			t.log.Infof("[%s] other tasks share Redis, skip Redis cleanup", t.GetID())
+			return
+		}
+		if err := deps.Repo.CleanRedisBySites(cleanupCtx, deps.sites()); err != nil {
 			t.log.Errorf("[%s] Redis cleanup: %v", t.GetID(), err)
 		}
 	}()
 	go func() {
 		defer wg.Done()
+		if !deps.isIdle(t.GetID(), ResourceOrders) {
+			if _, err := deps.Repo.DeleteOrdersByScope(cleanupCtx, t.buildOrderScope(deps)); err != nil {
+				t.log.Errorf("[%s] Mysql delete scoped orders: %v", t.GetID(), err)
+			}
+			return
+		}
 		if err := deps.Repo.CleanGameOrderTable(cleanupCtx); err != nil {
 			t.log.Errorf("[%s] Mysql delete orders: %v", t.GetID(), err)
 		}
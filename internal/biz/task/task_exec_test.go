@@ -0,0 +1,432 @@
+package task
+
+import (
+	"context"
+	"encoding/base64"
+	"math"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/chart"
+	"stress/internal/biz/game/base"
+	"stress/internal/conf"
+	"stress/internal/mockplatform"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// orderRepo 以模拟平台的订单回调作为订单库
+type orderRepo struct {
+	mu     sync.Mutex
+	orders []mockplatform.Order
+}
+
+func (r *orderRepo) add(o mockplatform.Order) {
+	r.mu.Lock()
+	r.orders = append(r.orders, o)
+	r.mu.Unlock()
+}
+
+func (r *orderRepo) GetGameOrderCount(context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return int64(len(r.orders)), nil
+}
+
+func (r *orderRepo) GetDetailedOrderAmounts(context.Context, OrderScope) (totalBet, totalWin, betOrderCount, bonusOrderCount int64, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, o := range r.orders {
+		totalBet += int64(math.Round(o.Bet * 1e4))
+		totalWin += int64(math.Round(o.Win * 1e4))
+	}
+	return totalBet, totalWin, int64(len(r.orders)), 0, nil
+}
+
+func (r *orderRepo) QueryGameOrderPoints(context.Context, OrderScope) ([]chart.Point, error) {
+	return nil, nil
+}
+
+func (r *orderRepo) UploadBytes(context.Context, string, string, string, []byte) (string, error) {
+	return "", nil
+}
+
+func (r *orderRepo) CleanRedisBySites(context.Context, []string) error { return nil }
+func (r *orderRepo) CleanGameOrderTable(context.Context) error         { return nil }
+
+func (r *orderRepo) GetOrderCountByScope(ctx context.Context, _ OrderScope) (int64, error) {
+	return r.GetGameOrderCount(ctx)
+}
+
+func (r *orderRepo) DeleteOrdersByScope(context.Context, OrderScope) (int64, error) { return 0, nil }
+
+func TestExecuteAgainstMockPlatform(t *testing.T) {
+	mock, err := mockplatform.New(mockplatform.Config{
+		Latency: map[string]mockplatform.Latency{mockplatform.OpAny: {Dist: mockplatform.DistUniform, MinMs: 1, MaxMs: 3}},
+		Errors:  map[string]mockplatform.ErrorRates{mockplatform.OpBetOrder: {TokenExpired: 0.05}},
+		Games: map[int64]*mockplatform.Script{18888: {
+			Spins: []map[string]any{{"bonus": true}, {"over": true}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("mockplatform.New: %v", err)
+	}
+	repo := &orderRepo{}
+	mock.OnOrder(repo.add)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	g := smokeGame{base.NewBaseGame(18888, "mock")}
+	cfg := &v1.TaskConfig{GameId: 18888, MemberCount: 4, TimesPerMember: 3, BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1}}
+	tk, err := NewTask(context.Background(), "e2e", g, cfg, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	members := []MemberInfo{{Name: "m1"}, {Name: "m2"}, {Name: "m3"}, {Name: "m4"}}
+	tk.Execute(members, &ExecDeps{
+		Repo: repo,
+		Conf: &conf.Stress{Launch: &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}},
+	})
+
+	if s := tk.GetStatus(); s != v1.TaskStatus_TASK_COMPLETED {
+		t.Fatalf("status = %v (%s), want completed", s, tk.GetReason())
+	}
+	rpt := tk.ToProto().Report
+	st := mock.Stats()
+	if rpt.Process != 12 || rpt.Completed != 4 {
+		t.Fatalf("process=%d completed=%d, want 12 / 4", rpt.Process, rpt.Completed)
+	}
+	// 每局：一次触发 bonus 的下注 + 一次 bonus + 一次结束本局的下注
+	if rpt.Step != 24 || rpt.BonusStep != 12 || rpt.OrderCount != st.Orders {
+		t.Fatalf("step=%d bonus=%d orders=%d (mock %d), want 24 / 12 / equal", rpt.Step, rpt.BonusStep, rpt.OrderCount, st.Orders)
+	}
+	if rpt.FailedReqs != st.Injected["token_expired"] {
+		t.Fatalf("failed_reqs=%d, injected=%d", rpt.FailedReqs, st.Injected["token_expired"])
+	}
+}
+
+// spinGame 按模拟平台默认的 {"bet","win"} 响应解析类型化结果；IsSpinOver 恒为 false，任务能结束说明引擎使用了 ParseSpin
+type spinGame struct{ *base.Default }
+
+func (spinGame) IsSpinOver(map[string]any) bool { return false }
+
+func (spinGame) ParseSpin(payload []byte) (*base.SpinResult, error) {
+	var data struct {
+		Bet float64 `json:"bet"`
+		Win float64 `json:"win"`
+	}
+	if err := jsonAPI.Unmarshal(payload, &data); err != nil {
+		return nil, err
+	}
+	return &base.SpinResult{Bet: data.Bet, Win: data.Win, RoundOver: true}, nil
+}
+
+func TestDecodeBetOrderParsesOnce(t *testing.T) {
+	calls := 0
+	conv := func(b []byte) (map[string]any, error) {
+		calls++
+		var data map[string]any
+		err := jsonAPI.Unmarshal(b, &data)
+		return data, err
+	}
+	res := &apiResponse{Bytes: base64.StdEncoding.EncodeToString([]byte(`{"bet":1,"win":2}`))}
+	r, err := decodeBetOrder(spinGame{base.NewBaseGame(18888, "spin")}, conv, 18888, res)
+	if err != nil {
+		t.Fatalf("decodeBetOrder: %v", err)
+	}
+	if calls != 0 || r.Spin.Win != 2 || !r.SpinOver(nil) {
+		t.Fatalf("calls=%d spin=%+v, want typed result without map conversion", calls, r.Spin)
+	}
+	// 录制时才转换，且只转换一次
+	if r.GetData()["win"] != float64(2) || r.GetData() == nil || calls != 1 {
+		t.Fatalf("data=%v calls=%d", r.Data, calls)
+	}
+}
+
+func TestExecuteSpinParser(t *testing.T) {
+	mock, err := mockplatform.New(mockplatform.Config{})
+	if err != nil {
+		t.Fatalf("mockplatform.New: %v", err)
+	}
+	repo := &orderRepo{}
+	mock.OnOrder(repo.add)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	g := spinGame{base.NewBaseGame(18888, "spin")}
+	cfg := &v1.TaskConfig{GameId: 18888, MemberCount: 2, TimesPerMember: 20, BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1}}
+	tk, err := NewTask(context.Background(), "spin", g, cfg, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	tk.Execute([]MemberInfo{{Name: "m1"}, {Name: "m2"}}, &ExecDeps{
+		Repo: repo,
+		Conf: &conf.Stress{Launch: &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}},
+	})
+
+	if s := tk.GetStatus(); s != v1.TaskStatus_TASK_COMPLETED {
+		t.Fatalf("status = %v (%s), want completed", s, tk.GetReason())
+	}
+	rpt := tk.ToProto().Report
+	if rpt.Process != 40 || rpt.ClientBet == 0 {
+		t.Fatalf("process=%d client_bet=%d, want 40 / > 0", rpt.Process, rpt.ClientBet)
+	}
+	// 客户端统计与订单统计一致
+	if rpt.ClientBet != rpt.TotalBet || rpt.ClientWin != rpt.TotalWin || math.Abs(rpt.ClientRtpPct-rpt.RtpPct) > 1e-9 {
+		t.Fatalf("client bet/win/rtp = %d/%d/%.4f, orders = %d/%d/%.4f",
+			rpt.ClientBet, rpt.ClientWin, rpt.ClientRtpPct, rpt.TotalBet, rpt.TotalWin, rpt.RtpPct)
+	}
+}
+
+func TestExecuteChaos(t *testing.T) {
+	mock, err := mockplatform.New(mockplatform.Config{
+		Games: map[int64]*mockplatform.Script{
+			18888: {Spins: []map[string]any{{"over": true}}},
+			18889: {Spins: []map[string]any{{"bonus": true}, {"over": true}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("mockplatform.New: %v", err)
+	}
+	repo := &orderRepo{}
+	mock.OnOrder(repo.add)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	// 全部会话：每次下注先用失效 token 请求一次，并并发重复下注
+	cfg := &v1.TaskConfig{
+		GameId: 18888, MemberCount: 2, TimesPerMember: 3,
+		BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1},
+		Chaos:    &v1.Chaos{StaleToken: 1, DuplicateBet: 1},
+	}
+	tk, err := NewTask(context.Background(), "chaos", smokeGame{base.NewBaseGame(18888, "mock")}, cfg, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	tk.Execute([]MemberInfo{{Name: "m1"}, {Name: "m2"}}, &ExecDeps{
+		Repo: repo,
+		Conf: &conf.Stress{Launch: &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}},
+	})
+
+	rpt := tk.ToProto().Report
+	c := rpt.GetChaos()
+	if rpt.Step != 6 || c.GetSessions() != 2 {
+		t.Fatalf("step=%d chaos=%v, want 6 steps / 2 sessions", rpt.Step, c)
+	}
+	// 模拟平台拒绝已消费的 launch token，但不做重复下注保护
+	if c.StaleSent != 6 || c.StaleAccepted != 0 || c.DuplicateSent != 6 || c.DuplicateAccepted != 6 || c.ExtraOrders != 6 {
+		t.Fatalf("chaos = %v", c)
+	}
+
+	// 全部会话在首次 bonus 时放弃：不计入完成成员数
+	cfg = &v1.TaskConfig{
+		GameId: 18889, MemberCount: 3, TimesPerMember: 3,
+		BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1},
+		Chaos:    &v1.Chaos{AbandonBonus: 1},
+	}
+	tk, err = NewTask(context.Background(), "abandon", smokeGame{base.NewBaseGame(18889, "mock")}, cfg, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	tk.Execute([]MemberInfo{{Name: "m1"}, {Name: "m2"}, {Name: "m3"}}, &ExecDeps{
+		Repo: repo,
+		Conf: &conf.Stress{Launch: &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}},
+	})
+	rpt = tk.ToProto().Report
+	if rpt.GetChaos().GetAbandoned() != 3 || rpt.Completed != 0 || rpt.Failed != 3 {
+		t.Fatalf("abandoned=%d completed=%d failed=%d, want 3 / 0 / 3", rpt.GetChaos().GetAbandoned(), rpt.Completed, rpt.Failed)
+	}
+}
+
+// TestExecuteDurationStuckInBonus 会话停在 bonus 请求或重试等待中时，时长上限到达仍按时结束并计为完成
+func TestExecuteDurationStuckInBonus(t *testing.T) {
+	tests := []struct {
+		name  string
+		mock  mockplatform.Config
+		retry *v1.RetryPolicy
+	}{
+		{
+			name: "slow bonus",
+			mock: mockplatform.Config{
+				Latency: map[string]mockplatform.Latency{mockplatform.OpBetBonus: {Dist: mockplatform.DistFixed, MeanMs: 10000}},
+			},
+		},
+		{
+			name: "bonus retry",
+			mock: mockplatform.Config{
+				Errors: map[string]mockplatform.ErrorRates{mockplatform.OpBetBonus: {Unavailable: 1}},
+			},
+			retry: &v1.RetryPolicy{MaxAttempts: 100, Rules: []*v1.ErrorRule{{Op: OpBetBonus, Action: v1.ErrorRule_RETRY, Delay: "10s"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock.Games = map[int64]*mockplatform.Script{18888: {Spins: []map[string]any{{"bonus": true}}}}
+			mock, err := mockplatform.New(tt.mock)
+			if err != nil {
+				t.Fatalf("mockplatform.New: %v", err)
+			}
+			repo := &orderRepo{}
+			mock.OnOrder(repo.add)
+			srv := httptest.NewServer(mock)
+			defer srv.Close()
+
+			cfg := &v1.TaskConfig{
+				GameId: 18888, MemberCount: 2, Duration: "300ms",
+				BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1},
+				Retry:    tt.retry,
+			}
+			tk, err := NewTask(context.Background(), "stuck", smokeGame{base.NewBaseGame(18888, "mock")}, cfg, log.DefaultLogger)
+			if err != nil {
+				t.Fatalf("NewTask: %v", err)
+			}
+			start := time.Now()
+			tk.Execute([]MemberInfo{{Name: "m1"}, {Name: "m2"}}, &ExecDeps{
+				Repo: repo,
+				Conf: &conf.Stress{Launch: &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}},
+			})
+			if d := time.Since(start); d > 5*time.Second {
+				t.Fatalf("task took %v, want it stopped near the 300ms limit", d)
+			}
+			if s := tk.GetStatus(); s != v1.TaskStatus_TASK_COMPLETED {
+				t.Fatalf("status = %v (%s), want completed", s, tk.GetReason())
+			}
+			rpt := tk.ToProto().Report
+			if rpt.Completed != 2 || rpt.Failed != 0 {
+				t.Fatalf("completed=%d failed=%d, want 2 / 0", rpt.Completed, rpt.Failed)
+			}
+			if tt.retry == nil && rpt.FailedReqs != 0 {
+				t.Fatalf("failed_reqs=%d, want canceled in-flight bonus not counted", rpt.FailedReqs)
+			}
+		})
+	}
+}
+
+func TestExecuteWebSocket(t *testing.T) {
+	mock, err := mockplatform.New(mockplatform.Config{
+		Errors: map[string]mockplatform.ErrorRates{mockplatform.OpBetOrder: {TokenExpired: 0.05, Unavailable: 0.02}},
+		Games: map[int64]*mockplatform.Script{18888: {
+			Spins: []map[string]any{{"bonus": true}, {"over": true}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("mockplatform.New: %v", err)
+	}
+	repo := &orderRepo{}
+	mock.OnOrder(repo.add)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	cfg := &v1.TaskConfig{GameId: 18888, MemberCount: 3, TimesPerMember: 4, BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1}}
+	tk, err := NewTask(context.Background(), "ws", smokeGame{base.NewBaseGame(18888, "mock")}, cfg, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	// 按游戏选择 websocket；不可用注入会断开连接，会话重连后继续
+	tk.Execute([]MemberInfo{{Name: "m1"}, {Name: "m2"}, {Name: "m3"}}, &ExecDeps{
+		Repo: repo,
+		Conf: &conf.Stress{Launch: &conf.Stress_Launch{
+			ApiUrl: srv.URL, LaunchUrl: srv.URL,
+			WsUrl:      "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws/game",
+			Transports: map[int64]string{18888: TransportWebSocket},
+		}},
+	})
+
+	if s := tk.GetStatus(); s != v1.TaskStatus_TASK_COMPLETED {
+		t.Fatalf("status = %v (%s), want completed", s, tk.GetReason())
+	}
+	rpt := tk.ToProto().Report
+	st := mock.Stats()
+	if rpt.Process != 12 || rpt.Step != 24 || rpt.BonusStep != 12 || rpt.OrderCount != st.Orders {
+		t.Fatalf("process=%d step=%d bonus=%d orders=%d (mock %d)", rpt.Process, rpt.Step, rpt.BonusStep, rpt.OrderCount, st.Orders)
+	}
+	if st.Requests[mockplatform.OpLaunch] < 3 || st.Requests[mockplatform.OpBetOrder] < 24 {
+		t.Fatalf("mock requests = %v", st.Requests)
+	}
+}
+
+func TestResolveTransport(t *testing.T) {
+	games := map[int64]string{1: TransportWebSocket}
+	cases := []struct {
+		cfg   *v1.TaskConfig
+		wsURL string
+		want  string
+		err   bool
+	}{
+		{cfg: &v1.TaskConfig{GameId: 2}, want: TransportHTTP},
+		{cfg: &v1.TaskConfig{GameId: 1}, wsURL: "ws://x", want: TransportWebSocket},
+		{cfg: &v1.TaskConfig{GameId: 1, Transport: TransportHTTP}, want: TransportHTTP},
+		{cfg: &v1.TaskConfig{GameId: 1}, err: true},
+		{cfg: &v1.TaskConfig{GameId: 2, Transport: "grpc"}, err: true},
+	}
+	for _, c := range cases {
+		got, err := ResolveTransport(c.cfg, games, c.wsURL)
+		if (err != nil) != c.err || got != c.want {
+			t.Fatalf("%v: got %q, %v", c.cfg, got, err)
+		}
+	}
+}
+
+// statusStore 记录每次持久化时的任务状态
+type statusStore struct {
+	mu       sync.Mutex
+	statuses []v1.TaskStatus
+}
+
+func (s *statusStore) SaveTask(_ context.Context, t *v1.Task) error {
+	s.mu.Lock()
+	s.statuses = append(s.statuses, v1.TaskStatus(t.Status))
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *statusStore) SaveTaskReport(context.Context, string, *v1.TaskCompletionReport) error {
+	return nil
+}
+
+func TestFinalizeHardSLOSingleTerminalTransition(t *testing.T) {
+	mock, err := mockplatform.New(mockplatform.Config{})
+	if err != nil {
+		t.Fatalf("mockplatform.New: %v", err)
+	}
+	repo := &orderRepo{}
+	mock.OnOrder(repo.add)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	g := base.NewBaseGame(18888, "slo")
+	cfg := &v1.TaskConfig{
+		GameId: 18888, MemberCount: 1, TimesPerMember: 1,
+		BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1},
+		Slo:      &v1.SLO{Assertions: []*v1.Assertion{{Metric: "qps", Operator: "gt", Value: 1e9, Hard: true}}},
+	}
+	tk, err := NewTask(context.Background(), "slo", g, cfg, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	store := &statusStore{}
+	tk.bindStore(store)
+	tk.Execute([]MemberInfo{{Name: "m1"}}, &ExecDeps{
+		Repo: repo,
+		Conf: &conf.Stress{Launch: &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}},
+	})
+
+	if s := tk.GetStatus(); s != v1.TaskStatus_TASK_FAILED || !strings.Contains(tk.GetReason(), "SLO breached") {
+		t.Fatalf("status = %v (%s), want failed by SLO", s, tk.GetReason())
+	}
+	tk.waitPersist()
+	// 只有最后一次持久化为终态：RUNNING → PROCESSING → FAILED
+	n := len(store.statuses)
+	if n < 2 || store.statuses[n-2] != v1.TaskStatus_TASK_PROCESSING {
+		t.Fatalf("statuses = %v, want ... PROCESSING, FAILED", store.statuses)
+	}
+	for _, s := range store.statuses[:n-1] {
+		if IsFinished(s) {
+			t.Fatalf("statuses = %v, terminal state before report", store.statuses)
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"sort"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	v1 "stress/api/stress/v1"
@@ -14,27 +13,54 @@ import (
 
 // Pool 任务池
 type Pool struct {
-	mu           sync.RWMutex
-	tasks        map[string]*Task
-	pending      []string
-	runningCount int32 // 运行中任务数
+	mu      sync.RWMutex
+	tasks   map[string]*Task
+	pending []string               // 待调度队列（按优先级降序，同优先级先进先出）
+	paused  bool                   // 暂停调度
+	running map[string]runningTask // 运行中任务（taskID -> 占用）
+	store   Store                  // 任务持久化（异步写入，可为 nil）
 }
 
-// NewTaskPool 创建任务池
-func NewTaskPool() *Pool {
+// runningTask 运行中任务的资源占用
+type runningTask struct {
+	gameID  int64
+	env     string
+	members int
+}
+
+// NewTaskPool 创建任务池，store 为 nil 时仅保存在内存
+func NewTaskPool(store Store) *Pool {
 	return &Pool{
-		tasks:        make(map[string]*Task),
-		pending:      nil,
-		runningCount: 0,
+		tasks:   make(map[string]*Task),
+		pending: nil,
+		running: make(map[string]runningTask),
+		store:   store,
 	}
 }
 
-// Add 添加任务到池中
+// Add 添加任务到池中（按优先级插入队列），并写入初始快照
 func (p *Pool) Add(t *Task) {
+	t.bindStore(p.store)
 	p.mu.Lock()
 	p.tasks[t.GetID()] = t
-	p.pending = append(p.pending, t.GetID())
+	p.insertPendingLocked(t)
 	p.mu.Unlock()
+	t.persist()
+}
+
+// insertPendingLocked 插入到第一个优先级更低的任务之前
+func (p *Pool) insertPendingLocked(t *Task) {
+	prio := t.GetConfig().GetPriority()
+	i := len(p.pending)
+	for j, id := range p.pending {
+		if q, ok := p.tasks[id]; ok && q.GetConfig().GetPriority() < prio {
+			i = j
+			break
+		}
+	}
+	p.pending = append(p.pending, "")
+	copy(p.pending[i+1:], p.pending[i:])
+	p.pending[i] = t.GetID()
 }
 
 // Get 获取任务
@@ -60,6 +86,28 @@ func (p *Pool) List() []*Task {
 	return out
 }
 
+// Filter 按条件过滤任务（按创建时间倒序），返回分页结果与总数
+func (p *Pool) Filter(f Filter) ([]*Task, int) {
+	all := p.List()
+	out := make([]*Task, 0, len(all))
+	for _, t := range all {
+		if f.Match(t) {
+			out = append(out, t)
+		}
+	}
+	total := len(out)
+	if f.Offset > 0 {
+		if f.Offset >= len(out) {
+			return nil, total
+		}
+		out = out[f.Offset:]
+	}
+	if f.Limit > 0 && len(out) > f.Limit {
+		out = out[:f.Limit]
+	}
+	return out, total
+}
+
 // Remove 移除任务，同时从 pending 中移除
 func (p *Pool) Remove(id string) (*Task, bool) {
 	p.mu.Lock()
@@ -74,52 +122,75 @@ func (p *Pool) Remove(id string) (*Task, bool) {
 		}
 	}
 	p.mu.Unlock()
+	if ok {
+		t.bindStore(nil) // 已删除的任务不再回写，避免退出过程中重新插入历史
+	}
 	return t, ok
 }
 
-// IsRateLimited 限流控制
-func (p *Pool) IsRateLimited(maxRunning int) bool {
-	return atomic.LoadInt32(&p.runningCount) >= int32(maxRunning)
+// MarkRunning 登记运行中任务、目标环境及其占用的成员数（调度器出队后同步调用）
+func (p *Pool) MarkRunning(taskID string, gameID int64, env string, members int) {
+	p.mu.Lock()
+	p.running[taskID] = runningTask{gameID: gameID, env: env, members: members}
+	p.mu.Unlock()
 }
 
-// IncreaseRunningCount 增加运行中任务计数
-func (p *Pool) IncreaseRunningCount() {
-	atomic.AddInt32(&p.runningCount, 1)
+// MarkDone 注销运行中任务
+func (p *Pool) MarkDone(taskID string) {
+	p.mu.Lock()
+	delete(p.running, taskID)
+	p.mu.Unlock()
 }
 
-// DecreaseRunningCount 减少运行中任务计数
-func (p *Pool) DecreaseRunningCount() {
-	atomic.AddInt32(&p.runningCount, -1)
+// RunningStats 返回运行中任务数与占用成员总数
+func (p *Pool) RunningStats() (tasks, members int) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, r := range p.running {
+		members += r.members
+	}
+	return len(p.running), members
 }
 
-// PeekPending 取队首待调度任务（不出队）
-func (p *Pool) PeekPending() (taskID string, t *Task, ok bool) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
+// IsGameRunning 指定游戏是否有任务在运行
+func (p *Pool) IsGameRunning(gameID int64) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, r := range p.running {
+		if r.gameID == gameID {
+			return true
+		}
+	}
+	return false
+}
 
-	// 循环清理无效的任务 ID
-	for len(p.pending) > 0 {
-		taskID = p.pending[0]
-		t, ok = p.tasks[taskID]
-		if !ok {
-			// 任务已不存在，清理并继续
-			p.pending = p.pending[1:]
-			continue
+// IsIdle 除 taskID 外是否没有运行在 shares(env) 为 true 的环境中的任务
+func (p *Pool) IsIdle(taskID string, shares func(env string) bool) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for id, r := range p.running {
+		if id != taskID && shares(r.env) {
+			return false
 		}
-		return taskID, t, true
 	}
-	return "", nil, false
+	return true
 }
 
-// DequeuePending 队首出队，仅当 taskID 与队首一致时执行
-func (p *Pool) DequeuePending(taskID string) bool {
+// PendingTasks 按排队顺序返回待调度任务快照，顺带清理已不存在的任务 ID
+func (p *Pool) PendingTasks() []*Task {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	if len(p.pending) == 0 || p.pending[0] != taskID {
-		return false
+
+	out := make([]*Task, 0, len(p.pending))
+	kept := p.pending[:0]
+	for _, id := range p.pending {
+		if t, ok := p.tasks[id]; ok {
+			kept = append(kept, id)
+			out = append(out, t)
+		}
 	}
-	p.pending = p.pending[1:]
-	return true
+	p.pending = kept
+	return out
 }
 
 // RequeueAtHead 将 taskID 重新放回队首
@@ -129,15 +200,39 @@ func (p *Pool) RequeueAtHead(taskID string) {
 	p.mu.Unlock()
 }
 
-// DropPendingHead 丢弃队首（跳过无效任务）
-func (p *Pool) DropPendingHead() {
+// MovePending 将排队任务移到队首或队尾（人工调整，不受优先级约束）
+func (p *Pool) MovePending(id string, front bool) bool {
 	p.mu.Lock()
-	if len(p.pending) > 0 {
-		p.pending = p.pending[1:]
+	defer p.mu.Unlock()
+	for i, pid := range p.pending {
+		if pid != id {
+			continue
+		}
+		p.pending = append(p.pending[:i], p.pending[i+1:]...)
+		if front {
+			p.pending = append([]string{id}, p.pending...)
+		} else {
+			p.pending = append(p.pending, id)
+		}
+		return true
 	}
+	return false
+}
+
+// SetPaused 暂停/恢复调度
+func (p *Pool) SetPaused(paused bool) {
+	p.mu.Lock()
+	p.paused = paused
 	p.mu.Unlock()
 }
 
+// IsPaused 调度是否已暂停
+func (p *Pool) IsPaused() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.paused
+}
+
 // DropPending 移除任务，仅从 pending 中移除
 func (p *Pool) DropPending(id string) bool {
 	p.mu.Lock()
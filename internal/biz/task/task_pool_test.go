@@ -0,0 +1,46 @@
+package task
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "stress/api/stress/v1"
+)
+
+func newQueuedTask(id string, priority int32) *Task {
+	return &Task{id: id, status: v1.TaskStatus_TASK_PENDING, config: &v1.TaskConfig{Priority: priority}}
+}
+
+func pendingIDs(p *Pool) []string {
+	var ids []string
+	for _, t := range p.PendingTasks() {
+		ids = append(ids, t.GetID())
+	}
+	return ids
+}
+
+func TestPoolPriorityQueue(t *testing.T) {
+	p := NewTaskPool(nil)
+	p.Add(newQueuedTask("a", 0))
+	p.Add(newQueuedTask("b", 0))
+	p.Add(newQueuedTask("urgent", 10))
+	p.Add(newQueuedTask("c", 5))
+	p.Add(newQueuedTask("low", -1))
+	p.Add(newQueuedTask("d", 0))
+
+	want := []string{"urgent", "c", "a", "b", "d", "low"}
+	if got := pendingIDs(p); !reflect.DeepEqual(got, want) {
+		t.Fatalf("queue = %v, want %v", got, want)
+	}
+
+	if !p.MovePending("low", true) || !p.MovePending("urgent", false) {
+		t.Fatal("MovePending failed")
+	}
+	want = []string{"low", "c", "a", "b", "d", "urgent"}
+	if got := pendingIDs(p); !reflect.DeepEqual(got, want) {
+		t.Fatalf("after move = %v, want %v", got, want)
+	}
+	if p.MovePending("missing", true) {
+		t.Fatal("moving unknown task should fail")
+	}
+}
@@ -0,0 +1,101 @@
+package task
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestTimeLimitedProgress(t *testing.T) {
+	now := time.Now()
+	tk := &Task{startAt: now.Add(-30 * time.Minute), timeLimit: time.Hour}
+
+	m := tk.calculateMetrics(now)
+	if math.Abs(m.ProgressPct-50) > 0.01 || m.Remaining != 30*time.Minute {
+		t.Fatalf("progress=%.2f remaining=%v, want 50%% / 30m", m.ProgressPct, m.Remaining)
+	}
+	if tk.IsTimeUp(now) || !tk.IsTimeUp(now.Add(30*time.Minute)) {
+		t.Fatal("time limit should be reached exactly after 1h of active time")
+	}
+
+	// 暂停时长不计入进度
+	tk.pausedTotal = 10 * time.Minute
+	if m := tk.calculateMetrics(now); m.Elapsed != 20*time.Minute || m.Remaining != 40*time.Minute {
+		t.Fatalf("elapsed=%v remaining=%v, want 20m / 40m", m.Elapsed, m.Remaining)
+	}
+}
+
+func TestSpinAndTimeLimitFirstWins(t *testing.T) {
+	now := time.Now()
+	tk := &Task{startAt: now.Add(-10 * time.Minute), timeLimit: time.Hour}
+	tk.stats.Target = 1000
+	tk.stats.Process = 500 // 局数已过半，按局数外推剩余 10m，早于时长上限
+
+	m := tk.calculateMetrics(now)
+	if math.Abs(m.ProgressPct-50) > 0.01 {
+		t.Fatalf("progress = %.2f, want 50", m.ProgressPct)
+	}
+	if m.Remaining != 10*time.Minute {
+		t.Fatalf("remaining = %v, want 10m", m.Remaining)
+	}
+
+	tk.stats.Process = 1000
+	if !tk.IsTargetReached() {
+		t.Fatal("spin target reached should stop the task")
+	}
+}
+
+func TestReportLiveThenFrozen(t *testing.T) {
+	tk, err := NewTask(context.Background(), "t1", nil, &v1.TaskConfig{GameId: 18888, MemberCount: 1, TimesPerMember: 10}, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+	if tk.ToProto().Report != nil {
+		t.Fatal("pending task should have no report")
+	}
+
+	tk.SetStartAt()
+	tk.stats.Process = 4
+	tk.setOrderStats(&v1.TaskCompletionReport{TotalBet: 100, TotalWin: 95, OrderCount: 4, RtpPct: 95})
+	live := tk.ToProto().Report
+	if live == nil || live.Process != 4 || live.GameId != 18888 || live.RtpPct != 95 {
+		t.Fatalf("live report = %+v", live)
+	}
+
+	tk.setReport(live)
+	tk.stats.Process = 10
+	if got := tk.ToProto().Report; got.Process != 4 {
+		t.Fatalf("frozen report changed: process=%d", got.Process)
+	}
+}
+
+func TestSessionsFilterStuckBonus(t *testing.T) {
+	now := time.Now()
+	stuck := NewSession("stuck")
+	stuck.setState(SessionStateBonusSelect)
+	stuck.stateSince = now.Add(-2 * time.Minute)
+	stuck.lastSuccessAt = now.Add(-time.Second) // bonus 请求一直成功但不结束
+
+	fresh := NewSession("fresh")
+	fresh.setState(SessionStateBonusSelect)
+
+	betting := NewSession("betting")
+	betting.setState(SessionStateBetting)
+	betting.stateSince = now.Add(-5 * time.Minute)
+
+	tk := &Task{}
+	tk.setSessions([]*Session{stuck, fresh, betting})
+
+	list, total := tk.Sessions(SessionFilter{State: v1.SessionState_SESSION_BONUS_SELECT, MinState: time.Minute})
+	if total != 1 || list[0].Member != "stuck" || list[0].IdleSec > 1 {
+		t.Fatalf("sessions = %v, total = %d", list, total)
+	}
+	if _, total := tk.Sessions(SessionFilter{}); total != 3 {
+		t.Fatalf("unfiltered total = %d, want 3", total)
+	}
+}
@@ -0,0 +1,57 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	v1 "stress/api/stress/v1"
+)
+
+// 传输方式（TaskConfig.transport / stress.launch.transports）
+const (
+	TransportHTTP      = "http"
+	TransportWebSocket = "websocket"
+)
+
+// GameTransport 会话与游戏平台之间的传输层；每个会话持有一个，会话结束后 Close
+type GameTransport interface {
+	Launch(ctx context.Context, cfg *v1.TaskConfig, member string) (string, error)
+	Login(ctx context.Context, cfg *v1.TaskConfig, token string) (string, map[string]any, error)
+	BetOrder(ctx context.Context, cfg *v1.TaskConfig, token string) (*BetOrderResult, error)
+	BetBonus(ctx context.Context, cfg *v1.TaskConfig, token string, bonusNum int64) (*BetBonusResult, error)
+	Close()
+}
+
+// ResolveTransport 任务的传输方式：cfg.transport 优先，其次按游戏配置，默认 http
+func ResolveTransport(cfg *v1.TaskConfig, games map[int64]string, wsURL string) (string, error) {
+	transport := cfg.GetTransport()
+	if transport == "" {
+		transport = games[cfg.GetGameId()]
+	}
+	switch transport {
+	case "", TransportHTTP:
+		return TransportHTTP, nil
+	case TransportWebSocket:
+		if wsURL == "" {
+			return "", fmt.Errorf("transport %s requires launch.ws_url", transport)
+		}
+		return transport, nil
+	default:
+		return "", fmt.Errorf("unknown transport %q", transport)
+	}
+}
+
+// NewTransport 为会话创建传输：http 共享 APIClient 的连接池，websocket 每个会话一条长连接
+func (c *APIClient) NewTransport() GameTransport {
+	if c.transport == TransportWebSocket {
+		return newWSTransport(c)
+	}
+	return httpTransport{c}
+}
+
+// httpTransport 连接池由 APIClient 持有，会话结束时无需释放
+type httpTransport struct {
+	*APIClient
+}
+
+func (httpTransport) Close() {}
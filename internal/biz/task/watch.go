@@ -0,0 +1,184 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+const errorSampleSize = 100 // 错误样本环形缓冲容量
+
+// ErrorSample 单条错误样本
+type ErrorSample struct {
+	Seq     int64
+	At      time.Time
+	Member  string
+	Message string
+}
+
+// errorRing 最近 N 条错误样本，Seq 单调递增，供订阅方增量读取
+type errorRing struct {
+	mu   sync.Mutex
+	buf  [errorSampleSize]ErrorSample
+	next int64 // 下一条的 Seq（从 1 开始）
+}
+
+func (r *errorRing) add(member, msg string) {
+	r.mu.Lock()
+	r.next++
+	r.buf[r.next%errorSampleSize] = ErrorSample{Seq: r.next, At: time.Now(), Member: member, Message: msg}
+	r.mu.Unlock()
+}
+
+// since 返回 Seq > seq 的样本（超出容量的旧样本已被覆盖）及最新 Seq
+func (r *errorRing) since(seq int64) ([]ErrorSample, int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if seq >= r.next {
+		return nil, r.next
+	}
+	from := seq + 1
+	if oldest := r.next - errorSampleSize + 1; from < oldest {
+		from = oldest
+	}
+	out := make([]ErrorSample, 0, r.next-from+1)
+	for s := from; s <= r.next; s++ {
+		out = append(out, r.buf[s%errorSampleSize])
+	}
+	return out, r.next
+}
+
+// ErrorsSince 增量读取错误样本
+func (t *Task) ErrorsSince(seq int64) ([]ErrorSample, int64) {
+	return t.errors.since(seq)
+}
+
+// StatusChanged 返回在下一次状态变更时关闭的 channel
+func (t *Task) StatusChanged() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.statusCh == nil {
+		t.statusCh = make(chan struct{})
+	}
+	return t.statusCh
+}
+
+// onStatusChange 状态变更后调用：唤醒订阅方并持久化
+func (t *Task) onStatusChange() {
+	t.mu.Lock()
+	if t.statusCh != nil {
+		close(t.statusCh)
+		t.statusCh = nil
+	}
+	t.mu.Unlock()
+	t.persist()
+}
+
+// IsFinished 是否已到终态（完成/失败/取消）
+func IsFinished(s v1.TaskStatus) bool {
+	switch s {
+	case v1.TaskStatus_TASK_COMPLETED, v1.TaskStatus_TASK_FAILED, v1.TaskStatus_TASK_CANCELLED:
+		return true
+	}
+	return false
+}
+
+// reportFinal 最终报告已生成，或任务未执行即结束（排队中取消，不会生成报告）
+func (t *Task) reportFinal() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.report != nil || t.startAt.IsZero()
+}
+
+// Watch 订阅任务进度：立即推送一次状态与快照，之后每 interval 推送快照与新增错误样本，
+// 状态变更即时推送；任务到终态且最终报告生成后推送最终快照并返回 nil
+// （取消/失败先于 finalize 置终态，此时继续推送直到报告生成）
+func (t *Task) Watch(ctx context.Context, interval time.Duration, emit func(*v1.WatchTaskEvent) error) error {
+	status := t.GetStatus()
+	_, seq := t.ErrorsSince(0)
+
+	send := func(kind v1.WatchTaskEvent_Kind, fill func(*v1.WatchTaskEvent)) error {
+		ev := &v1.WatchTaskEvent{
+			Kind:   kind,
+			TaskId: t.id,
+			Status: int32(t.GetStatus()),
+			Time:   time.Now().Format(time.DateTime),
+		}
+		if fill != nil {
+			fill(ev)
+		}
+		return emit(ev)
+	}
+	snapshot := func() error {
+		return send(v1.WatchTaskEvent_SNAPSHOT, func(ev *v1.WatchTaskEvent) { ev.Snapshot = t.Report(time.Now()) })
+	}
+	flushErrors := func() error {
+		var samples []ErrorSample
+		samples, seq = t.ErrorsSince(seq)
+		if len(samples) == 0 {
+			return nil
+		}
+		return send(v1.WatchTaskEvent_ERROR, func(ev *v1.WatchTaskEvent) {
+			for _, s := range samples {
+				ev.Errors = append(ev.Errors, &v1.ErrorSample{
+					Time:    s.At.Format(time.DateTime),
+					Member:  s.Member,
+					Message: s.Message,
+				})
+			}
+		})
+	}
+
+	if err := send(v1.WatchTaskEvent_STATUS, nil); err != nil {
+		return err
+	}
+	if err := snapshot(); err != nil || (IsFinished(status) && t.reportFinal()) {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// onStatus 推送状态变更；到终态且最终报告已生成时补发错误样本与最终快照，返回 done=true
+	onStatus := func() (bool, error) {
+		cur := t.GetStatus()
+		if cur != status {
+			status = cur
+			if err := send(v1.WatchTaskEvent_STATUS, nil); err != nil {
+				return true, err
+			}
+		}
+		if !IsFinished(cur) || !t.reportFinal() {
+			return false, nil
+		}
+		if err := flushErrors(); err != nil {
+			return true, err
+		}
+		return true, snapshot()
+	}
+
+	for {
+		// 先取 channel 再比较状态，避免漏掉两者之间发生的变更
+		changed := t.StatusChanged()
+		if done, err := onStatus(); done || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		case <-ticker.C:
+			if status == v1.TaskStatus_TASK_PENDING {
+				continue // 未开始无快照可推
+			}
+			if err := flushErrors(); err != nil {
+				return err
+			}
+			if err := snapshot(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package task
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/game/base"
+	"stress/internal/conf"
+	"stress/internal/mockplatform"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWatchCancelEndsWithFinalReport(t *testing.T) {
+	mock, err := mockplatform.New(mockplatform.Config{
+		Latency: map[string]mockplatform.Latency{mockplatform.OpAny: {Dist: mockplatform.DistUniform, MinMs: 1, MaxMs: 2}},
+	})
+	if err != nil {
+		t.Fatalf("mockplatform.New: %v", err)
+	}
+	repo := &orderRepo{}
+	mock.OnOrder(repo.add)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	g := base.NewBaseGame(18888, "watch")
+	cfg := &v1.TaskConfig{GameId: 18888, MemberCount: 2, Duration: "1m", BetOrder: &v1.BetOrderConfig{BaseMoney: 1, Multiple: 1}}
+	tk, err := NewTask(context.Background(), "watch", g, cfg, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewTask: %v", err)
+	}
+
+	var (
+		mu     sync.Mutex
+		events []*v1.WatchTaskEvent
+	)
+	watchDone := make(chan error, 1)
+	go func() {
+		watchDone <- tk.Watch(context.Background(), time.Hour, func(ev *v1.WatchTaskEvent) error {
+			mu.Lock()
+			events = append(events, ev)
+			mu.Unlock()
+			return nil
+		})
+	}()
+
+	execDone := make(chan struct{})
+	go func() {
+		defer close(execDone)
+		tk.Execute([]MemberInfo{{Name: "m1"}, {Name: "m2"}}, &ExecDeps{
+			Repo: repo,
+			Conf: &conf.Stress{Launch: &conf.Stress_Launch{ApiUrl: srv.URL, LaunchUrl: srv.URL}},
+		})
+	}()
+	for tk.GetStep() < 10 {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if err := tk.Cancel(); err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	<-execDone
+
+	select {
+	case err := <-watchDone:
+		if err != nil {
+			t.Fatalf("Watch: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Watch did not return after finalize")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	last := events[len(events)-1]
+	final := tk.Report(time.Now())
+	if last.Kind != v1.WatchTaskEvent_SNAPSHOT || last.Status != int32(v1.TaskStatus_TASK_CANCELLED) {
+		t.Fatalf("last event = %v / %d, want final snapshot of cancelled task", last.Kind, last.Status)
+	}
+	if last.Snapshot.OrderCount == 0 || !proto.Equal(last.Snapshot, final) {
+		t.Fatalf("last snapshot = %v, want final report %v", last.Snapshot, final)
+	}
+}
@@ -0,0 +1,238 @@
+package task
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/pkg/ws"
+)
+
+// WebSocket 传输：launch 仍走平台 HTTP 接口，login / betorder / betbonus 通过每个会话一条长连接收发，
+// 连接在首次请求时建立，断开后下次请求重连。帧为文本 JSON：
+//
+//	请求 {"id":1,"op":"betorder","token":"...","data":{...}}  data 与 HTTP 请求体一致
+//	响应 {"id":1,"code":0,"msg":"","data":{...},"bytes":"..."}  与 HTTP 响应一致并回带 id
+//
+// 同一连接上可并发多个请求（按 id 匹配），id 为 0 或无人等待的响应直接丢弃
+const wsRequestTimeout = 30 * time.Second // 与 HTTP 客户端超时一致
+
+type wsTransport struct {
+	*APIClient
+
+	mu   sync.Mutex
+	conn *wsConn
+}
+
+func newWSTransport(c *APIClient) *wsTransport {
+	return &wsTransport{APIClient: c}
+}
+
+type wsRequest struct {
+	ID    uint64 `json:"id"`
+	Op    string `json:"op"`
+	Token string `json:"token,omitempty"`
+	Data  any    `json:"data"`
+}
+
+type wsResponse struct {
+	ID uint64 `json:"id"`
+	apiResponse
+}
+
+type wsReply struct {
+	res *apiResponse
+	raw []byte
+}
+
+// wsConn 一条连接：读协程按 id 分发响应
+type wsConn struct {
+	conn *ws.Conn
+	done chan struct{} // 读协程退出后关闭
+
+	mu      sync.Mutex
+	nextID  uint64
+	pending map[uint64]chan wsReply
+	err     error // 读协程退出原因
+}
+
+func dialWS(ctx context.Context, url string, tlsConfig *tls.Config) (*wsConn, error) {
+	d := ws.Dialer{TLSConfig: tlsConfig}
+	conn, err := d.Dial(ctx, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	c := &wsConn{conn: conn, done: make(chan struct{}), pending: make(map[uint64]chan wsReply)}
+	go c.readLoop()
+	return c, nil
+}
+
+func (c *wsConn) readLoop() {
+	defer close(c.done)
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			c.mu.Lock()
+			c.err = err
+			c.mu.Unlock()
+			_ = c.conn.Close()
+			return
+		}
+		var res wsResponse
+		if err := jsonAPI.Unmarshal(msg, &res); err != nil || res.ID == 0 {
+			continue
+		}
+		c.mu.Lock()
+		ch, ok := c.pending[res.ID]
+		delete(c.pending, res.ID)
+		c.mu.Unlock()
+		if ok {
+			ch <- wsReply{res: &res.apiResponse, raw: msg}
+		}
+	}
+}
+
+func (c *wsConn) register() (uint64, chan wsReply) {
+	ch := make(chan wsReply, 1)
+	c.mu.Lock()
+	c.nextID++
+	id := c.nextID
+	c.pending[id] = ch
+	c.mu.Unlock()
+	return id, ch
+}
+
+func (c *wsConn) unregister(id uint64) {
+	c.mu.Lock()
+	delete(c.pending, id)
+	c.mu.Unlock()
+}
+
+func (c *wsConn) closed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *wsConn) closeErr() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		return ws.ErrClosed
+	}
+	return c.err
+}
+
+// connect 返回当前连接，未连接或已断开时重新建立
+func (t *wsTransport) connect(ctx context.Context) (*wsConn, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.conn != nil && !t.conn.closed() {
+		return t.conn, nil
+	}
+	conn, err := dialWS(ctx, t.wsURL, t.wsTLS)
+	if err != nil {
+		return nil, err
+	}
+	t.conn = conn
+	return conn, nil
+}
+
+// call 发送一次请求并等待响应；观察/录制时记录原始帧
+func (t *wsTransport) call(ctx context.Context, op, token string, data any) (*apiResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, wsRequestTimeout)
+	defer cancel()
+
+	sink := t.exchangeSink(ctx)
+	ex := &Exchange{Op: op, URL: t.wsURL}
+	start := time.Now()
+	res, err := t.roundTrip(ctx, op, token, data, ex, sink != nil)
+	if sink != nil {
+		ex.Latency, ex.Err = time.Since(start), err
+		sink(ex)
+	}
+	return res, err
+}
+
+func (t *wsTransport) roundTrip(ctx context.Context, op, token string, data any, ex *Exchange, observe bool) (*apiResponse, error) {
+	conn, err := t.connect(ctx)
+	if err != nil {
+		return nil, &TransportError{Op: op, Err: err}
+	}
+	id, ch := conn.register()
+	req, err := jsonAPI.Marshal(wsRequest{ID: id, Op: op, Token: token, Data: data})
+	if err != nil {
+		conn.unregister(id)
+		return nil, err
+	}
+	if observe {
+		ex.Request = req
+	}
+	if err := conn.conn.WriteMessage(ws.TextMessage, req); err != nil {
+		conn.unregister(id)
+		_ = conn.conn.Close()
+		return nil, &TransportError{Op: op, Err: err}
+	}
+	// 与 HTTP 一致地通知请求已写出（故障注入据此断开）
+	if trace := httptrace.ContextClientTrace(ctx); trace != nil && trace.WroteRequest != nil {
+		trace.WroteRequest(httptrace.WroteRequestInfo{})
+	}
+
+	select {
+	case r := <-ch:
+		if observe {
+			ex.Response = r.raw
+		}
+		return r.res, nil
+	case <-conn.done:
+		conn.unregister(id)
+		return nil, &TransportError{Op: op, Err: conn.closeErr()}
+	case <-ctx.Done():
+		conn.unregister(id)
+		return nil, &TransportError{Op: op, Err: ctx.Err()}
+	}
+}
+
+func (t *wsTransport) Login(ctx context.Context, cfg *v1.TaskConfig, token string) (string, map[string]any, error) {
+	res, err := t.call(ctx, OpLogin, "", loginParams(token))
+	if err != nil {
+		return "", nil, err
+	}
+	return parseLogin(res)
+}
+
+func (t *wsTransport) BetOrder(ctx context.Context, cfg *v1.TaskConfig, token string) (*BetOrderResult, error) {
+	res, err := t.call(ctx, OpBetOrder, token, betOrderParams(cfg))
+	if err != nil {
+		return nil, err
+	}
+	return t.parseBetOrder(cfg, res)
+}
+
+func (t *wsTransport) BetBonus(ctx context.Context, cfg *v1.TaskConfig, token string, bonusNum int64) (*BetBonusResult, error) {
+	res, err := t.call(ctx, OpBetBonus, token, betBonusParams(cfg, bonusNum))
+	if err != nil {
+		return nil, err
+	}
+	return t.parseBetBonus(res)
+}
+
+// Close 关闭会话的连接
+func (t *wsTransport) Close() {
+	t.mu.Lock()
+	conn := t.conn
+	t.conn = nil
+	t.mu.Unlock()
+	if conn != nil {
+		_ = conn.conn.Close()
+		<-conn.done
+	}
+}
+
+var _ GameTransport = (*wsTransport)(nil)
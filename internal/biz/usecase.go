@@ -2,14 +2,17 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
+	v1 "stress/api/stress/v1"
 	"stress/internal/biz/chart"
 	"stress/internal/biz/game"
 	"stress/internal/biz/game/base"
 	"stress/internal/biz/member"
 	"stress/internal/biz/notify"
+	"stress/internal/biz/secret"
 	"stress/internal/biz/task"
 	"stress/internal/conf"
 
@@ -21,22 +24,45 @@ const (
 	cleanupTimeout      = 10 * time.Minute
 	taskRetentionPeriod = 24 * time.Hour // 任务保留时长
 	taskCleanupInterval = 1 * time.Hour  // 清理任务执行间隔
+
+	restartFailReason = "service restarted, task interrupted" // 重启时残留任务的失败原因
 )
 
 // DataRepo 数据层接口，嵌入 task.Repo（任务执行期子集）避免方法重复声明
 type DataRepo interface {
 	task.Repo
+	task.Store
 
 	// BatchUpsertMembers 批量创建或更新压测成员
 	BatchUpsertMembers(ctx context.Context, members []member.Info) error
-	// DeleteOrdersByScope 按范围删除订单，返回删除行数
-	DeleteOrdersByScope(ctx context.Context, scope task.OrderScope) (int64, error)
-	// GetOrderCountByScope 按范围统计订单数
-	GetOrderCountByScope(ctx context.Context, scope task.OrderScope) (int64, error)
 	// NextTaskID 生成下一个任务 ID（Redis 自增）
 	NextTaskID(ctx context.Context, gameID int64) (string, error)
+	// Environment 返回命名环境（订单库、Redis）的仓储，"" 为默认环境
+	Environment(name string) (task.Repo, error)
+	// EnvironmentStores 环境实际使用的订单库 / Redis 标识，相同标识表示共用同一存储
+	EnvironmentStores(name string) (orders, redis string)
+	// ListMerchantSecrets 从主库 merchant 表读取商户签名密钥
+	ListMerchantSecrets(ctx context.Context) (map[string]string, error)
 	// GetGameBetSize 从 DB 获取游戏下注档位
 	GetGameBetSize(ctx context.Context, gameIDs []int64) (map[int64][]float64, error)
+
+	// ListTaskHistory 分页查询任务历史，返回当前页与总数
+	ListTaskHistory(ctx context.Context, f task.Filter) ([]*v1.Task, int64, error)
+	// GetTaskHistory 查询单个历史任务及最终报告，不存在返回 nil
+	GetTaskHistory(ctx context.Context, taskID string) (*v1.Task, *v1.TaskCompletionReport, error)
+	// DeleteTaskHistory 删除任务历史及报告
+	DeleteTaskHistory(ctx context.Context, taskID string) error
+	// FailUnfinishedTasks 将未结束的历史任务标记为失败，返回影响行数
+	FailUnfinishedTasks(ctx context.Context, reason string) (int64, error)
+
+	// CreateSchedule 保存定时任务，返回 ID
+	CreateSchedule(ctx context.Context, s *v1.Schedule) (int64, error)
+	// ListSchedules 查询全部定时任务
+	ListSchedules(ctx context.Context) ([]*v1.Schedule, error)
+	// UpdateScheduleRun 记录定时任务最近一次触发
+	UpdateScheduleRun(ctx context.Context, id int64, runAt time.Time, taskIDs []string) error
+	// DeleteSchedule 删除定时任务
+	DeleteSchedule(ctx context.Context, id int64) error
 }
 
 // UseCase 编排层：通过 DataRepo + 领域池（Game/Task/Member）编排业务
@@ -50,15 +76,33 @@ type UseCase struct {
 	gamePool   *game.Pool
 	taskPool   *task.Pool
 	memberPool *member.Pool
+	secrets    *secret.Provider
 
 	notify notify.Notifier
 	chart  chart.IGenerator
 
 	scheduleCh chan struct{} // 调度触发信号
+
+	cronMu sync.Mutex
+	crons  map[int64]*cronEntry // 定时任务（scheduleID -> entry）
 }
 
 // NewUseCase 创建 UseCase
 func NewUseCase(repo DataRepo, logger log.Logger, c *conf.Stress, notify notify.Notifier, chart chart.IGenerator) (*UseCase, func(), error) {
+	// 商户签名密钥仅在有环境需要签名时加载
+	var secrets *secret.Provider
+	if needsSecrets(c) {
+		var err error
+		if secrets, err = secret.NewProvider(c.Secrets, repo); err != nil {
+			return nil, nil, err
+		}
+	}
+	gamePool := game.NewPool(repo.GetGameBetSize)
+	if c.GameSpecs != "" {
+		if err := loadGameSpecs(context.Background(), gamePool, c.GameSpecs, logger); err != nil {
+			return nil, nil, err
+		}
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	uc := &UseCase{
 		ctx:        ctx,
@@ -66,20 +110,40 @@ func NewUseCase(repo DataRepo, logger log.Logger, c *conf.Stress, notify notify.
 		repo:       repo,
 		log:        log.NewHelper(logger),
 		conf:       c,
-		gamePool:   game.NewPool(repo.GetGameBetSize),
-		taskPool:   task.NewTaskPool(),
+		gamePool:   gamePool,
+		taskPool:   task.NewTaskPool(repo),
 		memberPool: member.NewMemberPool(),
+		secrets:    secrets,
 		notify:     notify,
 		chart:      chart,
 		scheduleCh: make(chan struct{}, 1),
+		crons:      make(map[int64]*cronEntry),
+	}
+
+	// 上次进程残留的未结束任务无法恢复，统一标记失败
+	if n, err := repo.FailUnfinishedTasks(ctx, restartFailReason); err != nil {
+		uc.log.Warnf("fail unfinished tasks: %v", err)
+	} else if n > 0 {
+		uc.log.Warnf("marked %d unfinished tasks as failed", n)
 	}
 
 	// 清理残余资源
 	_, _ = uc.Cleanup(ctx)
 
+	// 商户签名密钥：首次同步加载，之后定期刷新
+	if secrets != nil {
+		if err := secrets.Refresh(ctx); err != nil {
+			uc.log.Warnf("load merchant secrets: %v", err)
+		}
+		go secrets.StartAutoRefresh(ctx, logger)
+	}
+
 	// 启动调度器
 	go uc.scheduleLoop()
 
+	// 启动定时任务
+	go uc.cronLoop()
+
 	go uc.memberPool.StartAutoLoad(ctx, c.Member, repo, logger, uc.WakeScheduler)
 
 	go uc.taskPool.StartAutoCleanup(ctx, logger, taskRetentionPeriod, taskCleanupInterval)
@@ -103,14 +167,78 @@ func (uc *UseCase) ListGames() []base.IGame {
 	return uc.gamePool.List()
 }
 
+// ReloadGames 重新加载声明式游戏定义，返回已加载数量；运行中的任务继续使用原定义
+func (uc *UseCase) ReloadGames(ctx context.Context) (int, error) {
+	if uc.conf.GameSpecs == "" {
+		return 0, fmt.Errorf("stress.game_specs is not configured")
+	}
+	specs, err := game.LoadSpecFiles(uc.conf.GameSpecs)
+	if err != nil {
+		return 0, err
+	}
+	n, err := uc.gamePool.LoadSpecs(ctx, specs)
+	if err != nil {
+		return 0, err
+	}
+	uc.log.Infof("reloaded %d game specs from %s", n, uc.conf.GameSpecs)
+	return n, nil
+}
+
+// loadGameSpecs 启动时加载声明式游戏定义
+func loadGameSpecs(ctx context.Context, pool *game.Pool, path string, logger log.Logger) error {
+	specs, err := game.LoadSpecFiles(path)
+	if err != nil {
+		return err
+	}
+	n, err := pool.LoadSpecs(ctx, specs)
+	if err != nil {
+		return err
+	}
+	log.NewHelper(logger).Infof("loaded %d game specs from %s", n, path)
+	return nil
+}
+
 // GetTask 按 ID 获取任务
 func (uc *UseCase) GetTask(id string) (*task.Task, bool) {
 	return uc.taskPool.Get(id)
 }
 
-// ListTasks 返回所有任务（已按创建时间倒序）
-func (uc *UseCase) ListTasks() []*task.Task {
-	return uc.taskPool.List()
+// ListTasks 分页查询任务（按创建时间倒序）：以历史库为准，内存中的任务用实时快照覆盖；
+// 历史库不可用时退化为仅查询内存任务
+func (uc *UseCase) ListTasks(ctx context.Context, f task.Filter) ([]*v1.Task, int) {
+	history, total, err := uc.repo.ListTaskHistory(ctx, f)
+	if err != nil {
+		uc.log.Warnf("list task history: %v, fallback to memory", err)
+		live, n := uc.taskPool.Filter(f)
+		out := make([]*v1.Task, 0, len(live))
+		for _, t := range live {
+			out = append(out, t.ToProto())
+		}
+		return out, n
+	}
+
+	for i, h := range history {
+		if t, ok := uc.taskPool.Get(h.TaskId); ok {
+			history[i] = t.ToProto()
+		}
+	}
+	return history, int(total)
+}
+
+// GetTaskInfo 查询任务快照，内存中不存在时回查历史库
+func (uc *UseCase) GetTaskInfo(ctx context.Context, id string) (*v1.Task, error) {
+	if t, ok := uc.taskPool.Get(id); ok {
+		return t.ToProto(), nil
+	}
+	h, rpt, err := uc.repo.GetTaskHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, fmt.Errorf("task not found")
+	}
+	h.Report = rpt
+	return h, nil
 }
 
 // Cleanup 清理 Redis 和 MySQL 订单数据
@@ -149,6 +149,7 @@ type Data struct {
 	OrderDatabase *Data_Database         `protobuf:"bytes,2,opt,name=order_database,json=orderDatabase,proto3" json:"order_database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,3,opt,name=redis,proto3" json:"redis,omitempty"`
 	S3            *Data_S3               `protobuf:"bytes,4,opt,name=s3,proto3" json:"s3,omitempty"`
+	Memory        *Data_Memory           `protobuf:"bytes,5,opt,name=memory,proto3" json:"memory,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -211,6 +212,13 @@ func (x *Data) GetS3() *Data_S3 {
 	return nil
 }
 
+func (x *Data) GetMemory() *Data_Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
 type Log struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Mode          int32                  `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
@@ -297,12 +305,17 @@ func (x *Log) GetFile() bool {
 
 // 压测系统配置
 type Stress struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notify        *Stress_Notify         `protobuf:"bytes,1,opt,name=notify,proto3" json:"notify,omitempty"`
-	Chart         *Stress_Chart          `protobuf:"bytes,2,opt,name=chart,proto3" json:"chart,omitempty"`
-	Member        *Stress_Member         `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
-	Launch        *Stress_Launch         `protobuf:"bytes,4,opt,name=launch,proto3" json:"launch,omitempty"`
-	Metrics       *Stress_Metrics        `protobuf:"bytes,5,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Notify        *Stress_Notify                 `protobuf:"bytes,1,opt,name=notify,proto3" json:"notify,omitempty"`
+	Chart         *Stress_Chart                  `protobuf:"bytes,2,opt,name=chart,proto3" json:"chart,omitempty"`
+	Member        *Stress_Member                 `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	Launch        *Stress_Launch                 `protobuf:"bytes,4,opt,name=launch,proto3" json:"launch,omitempty"`
+	Metrics       *Stress_Metrics                `protobuf:"bytes,5,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Scheduler     *Stress_Scheduler              `protobuf:"bytes,6,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	Recording     *Stress_Recording              `protobuf:"bytes,7,opt,name=recording,proto3" json:"recording,omitempty"`
+	Environments  map[string]*Stress_Environment `protobuf:"bytes,8,rep,name=environments,proto3" json:"environments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 命名环境（TaskConfig.environment），为空名称使用 launch 与 data 中的默认环境
+	Secrets       *Stress_Secrets                `protobuf:"bytes,9,opt,name=secrets,proto3" json:"secrets,omitempty"`                                                                                     // 商户签名密钥（环境配置了 sign_secret 时以其为准）
+	GameSpecs     string                         `protobuf:"bytes,10,opt,name=game_specs,json=gameSpecs,proto3" json:"game_specs,omitempty"`                                                               // 声明式游戏定义文件或目录（*.yaml / *.json），启动时加载，可通过 ReloadGames 重新加载
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -372,6 +385,41 @@ func (x *Stress) GetMetrics() *Stress_Metrics {
 	return nil
 }
 
+func (x *Stress) GetScheduler() *Stress_Scheduler {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *Stress) GetRecording() *Stress_Recording {
+	if x != nil {
+		return x.Recording
+	}
+	return nil
+}
+
+func (x *Stress) GetEnvironments() map[string]*Stress_Environment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *Stress) GetSecrets() *Stress_Secrets {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *Stress) GetGameSpecs() string {
+	if x != nil {
+		return x.GameSpecs
+	}
+	return ""
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -720,6 +768,67 @@ func (x *Data_S3) GetEndpoint() string {
 	return ""
 }
 
+// 内存数据层：启用后不连接 MySQL / Redis / S3，数据随进程退出丢失（本地演示、测试用）
+type Data_Memory struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Enabled          bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                            // 是否启用
+	BetSize          []float64              `protobuf:"fixed64,2,rep,packed,name=bet_size,json=betSize,proto3" json:"bet_size,omitempty"`                     // 所有游戏的下注档位，默认 [0.1, 1, 10]
+	MockPlatformAddr string                 `protobuf:"bytes,3,opt,name=mock_platform_addr,json=mockPlatformAddr,proto3" json:"mock_platform_addr,omitempty"` // 非空时在该地址内嵌启动模拟平台，下注订单直接写入内存库（需将 launch.api_url / launch_url 指向此地址）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Data_Memory) Reset() {
+	*x = Data_Memory{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Memory) ProtoMessage() {}
+
+func (x *Data_Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Memory.ProtoReflect.Descriptor instead.
+func (*Data_Memory) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Memory) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_Memory) GetBetSize() []float64 {
+	if x != nil {
+		return x.BetSize
+	}
+	return nil
+}
+
+func (x *Data_Memory) GetMockPlatformAddr() string {
+	if x != nil {
+		return x.MockPlatformAddr
+	}
+	return ""
+}
+
 // Prometheus 指标上报
 type Stress_Metrics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -730,7 +839,7 @@ type Stress_Metrics struct {
 
 func (x *Stress_Metrics) Reset() {
 	*x = Stress_Metrics{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -742,7 +851,7 @@ func (x *Stress_Metrics) String() string {
 func (*Stress_Metrics) ProtoMessage() {}
 
 func (x *Stress_Metrics) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +887,7 @@ type Stress_Notify struct {
 
 func (x *Stress_Notify) Reset() {
 	*x = Stress_Notify{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -790,7 +899,7 @@ func (x *Stress_Notify) String() string {
 func (*Stress_Notify) ProtoMessage() {}
 
 func (x *Stress_Notify) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,7 +954,7 @@ type Stress_Chart struct {
 
 func (x *Stress_Chart) Reset() {
 	*x = Stress_Chart{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -857,7 +966,7 @@ func (x *Stress_Chart) String() string {
 func (*Stress_Chart) ProtoMessage() {}
 
 func (x *Stress_Chart) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1010,7 @@ type Stress_Member struct {
 
 func (x *Stress_Member) Reset() {
 	*x = Stress_Member{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -913,7 +1022,7 @@ func (x *Stress_Member) String() string {
 func (*Stress_Member) ProtoMessage() {}
 
 func (x *Stress_Member) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,19 +1075,22 @@ func (x *Stress_Member) GetMemberPrefix() string {
 
 // 启动配置（API和认证配置）
 type Stress_Launch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sites         []string               `protobuf:"bytes,1,rep,name=sites,proto3" json:"sites,omitempty"`                          // SITE标识，用于Redis清理
-	Merchant      string                 `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`                    //
-	ApiUrl        string                 `protobuf:"bytes,3,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`          // API地址
-	LaunchUrl     string                 `protobuf:"bytes,4,opt,name=launch_url,json=launchUrl,proto3" json:"launch_url,omitempty"` // 启动地址
-	SignRequired  bool                   `protobuf:"varint,5,opt,name=sign_required,json=signRequired,proto3" json:"sign_required,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Sites                []string               `protobuf:"bytes,1,rep,name=sites,proto3" json:"sites,omitempty"` // SITE标识，用于Redis清理
+	Merchant             string                 `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	ApiUrl               string                 `protobuf:"bytes,3,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`          // API地址
+	LaunchUrl            string                 `protobuf:"bytes,4,opt,name=launch_url,json=launchUrl,proto3" json:"launch_url,omitempty"` // 启动地址
+	SignRequired         bool                   `protobuf:"varint,5,opt,name=sign_required,json=signRequired,proto3" json:"sign_required,omitempty"`
+	WsUrl                string                 `protobuf:"bytes,6,opt,name=ws_url,json=wsUrl,proto3" json:"ws_url,omitempty"`                                                                         // WebSocket 地址（ws:// 或 wss://），websocket 传输时必填
+	Transports           map[int64]string       `protobuf:"bytes,7,rep,name=transports,proto3" json:"transports,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 按游戏指定传输方式（gameID -> http / websocket），未配置为 http
+	WsInsecureSkipVerify bool                   `protobuf:"varint,8,opt,name=ws_insecure_skip_verify,json=wsInsecureSkipVerify,proto3" json:"ws_insecure_skip_verify,omitempty"`                       // wss 不校验服务端证书（自签名测试环境），默认校验
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Stress_Launch) Reset() {
 	*x = Stress_Launch{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1102,7 @@ func (x *Stress_Launch) String() string {
 func (*Stress_Launch) ProtoMessage() {}
 
 func (x *Stress_Launch) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,6 +1153,271 @@ func (x *Stress_Launch) GetSignRequired() bool {
 	return false
 }
 
+func (x *Stress_Launch) GetWsUrl() string {
+	if x != nil {
+		return x.WsUrl
+	}
+	return ""
+}
+
+func (x *Stress_Launch) GetTransports() map[int64]string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *Stress_Launch) GetWsInsecureSkipVerify() bool {
+	if x != nil {
+		return x.WsInsecureSkipVerify
+	}
+	return false
+}
+
+// 任务调度配置
+type Stress_Scheduler struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MaxRunningTasks    int32                  `protobuf:"varint,1,opt,name=max_running_tasks,json=maxRunningTasks,proto3" json:"max_running_tasks,omitempty"`          // 最大并发运行任务数，<=0 时为 1（串行）
+	GameExclusive      bool                   `protobuf:"varint,2,opt,name=game_exclusive,json=gameExclusive,proto3" json:"game_exclusive,omitempty"`                  // 同一游戏同时只运行一个任务（订单统计按游戏+时间范围，并发同游戏会互相污染）
+	MaxInflightMembers int32                  `protobuf:"varint,3,opt,name=max_inflight_members,json=maxInflightMembers,proto3" json:"max_inflight_members,omitempty"` // 运行中任务占用成员总数上限，0 不限制（仍受成员池容量约束）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Stress_Scheduler) Reset() {
+	*x = Stress_Scheduler{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stress_Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stress_Scheduler) ProtoMessage() {}
+
+func (x *Stress_Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stress_Scheduler.ProtoReflect.Descriptor instead.
+func (*Stress_Scheduler) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *Stress_Scheduler) GetMaxRunningTasks() int32 {
+	if x != nil {
+		return x.MaxRunningTasks
+	}
+	return 0
+}
+
+func (x *Stress_Scheduler) GetGameExclusive() bool {
+	if x != nil {
+		return x.GameExclusive
+	}
+	return false
+}
+
+func (x *Stress_Scheduler) GetMaxInflightMembers() int32 {
+	if x != nil {
+		return x.MaxInflightMembers
+	}
+	return 0
+}
+
+// 请求/响应录制
+type Stress_Recording struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dir           string                 `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"` // 本地录制文件目录，默认 ./recordings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stress_Recording) Reset() {
+	*x = Stress_Recording{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stress_Recording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stress_Recording) ProtoMessage() {}
+
+func (x *Stress_Recording) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stress_Recording.ProtoReflect.Descriptor instead.
+func (*Stress_Recording) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *Stress_Recording) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+// launch 签名密钥来源（launch.sign_required 时使用），缓存并定期刷新
+type Stress_Secrets struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Source          string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                                                                                 // db（默认，主库 merchant 表）/ config / file
+	Merchants       map[string]string      `protobuf:"bytes,2,rep,name=merchants,proto3" json:"merchants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // source=config 时的 merchant -> secret
+	File            string                 `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`                                                                                     // source=file 时的文件路径（YAML / JSON，merchant: secret）
+	RefreshInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`                                        // 刷新间隔，默认 5m
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Stress_Secrets) Reset() {
+	*x = Stress_Secrets{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stress_Secrets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stress_Secrets) ProtoMessage() {}
+
+func (x *Stress_Secrets) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stress_Secrets.ProtoReflect.Descriptor instead.
+func (*Stress_Secrets) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *Stress_Secrets) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Stress_Secrets) GetMerchants() map[string]string {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+func (x *Stress_Secrets) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Stress_Secrets) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+// 命名压测环境：独立的启动配置、订单库、Redis 与签名密钥
+type Stress_Environment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Launch        *Stress_Launch         `protobuf:"bytes,1,opt,name=launch,proto3" json:"launch,omitempty"`                                    // 启动配置（merchant 可被 TaskConfig.merchant 覆盖）
+	OrderDatabase *Data_Database         `protobuf:"bytes,2,opt,name=order_database,json=orderDatabase,proto3" json:"order_database,omitempty"` // 订单库，为空时使用 data.order_database
+	Redis         *Data_Redis            `protobuf:"bytes,3,opt,name=redis,proto3" json:"redis,omitempty"`                                      // Redis，为空时使用 data.redis
+	SignSecret    string                 `protobuf:"bytes,4,opt,name=sign_secret,json=signSecret,proto3" json:"sign_secret,omitempty"`          // launch 签名密钥，为空时从 secrets 按商户获取
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stress_Environment) Reset() {
+	*x = Stress_Environment{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stress_Environment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stress_Environment) ProtoMessage() {}
+
+func (x *Stress_Environment) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stress_Environment.ProtoReflect.Descriptor instead.
+func (*Stress_Environment) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 8}
+}
+
+func (x *Stress_Environment) GetLaunch() *Stress_Launch {
+	if x != nil {
+		return x.Launch
+	}
+	return nil
+}
+
+func (x *Stress_Environment) GetOrderDatabase() *Data_Database {
+	if x != nil {
+		return x.OrderDatabase
+	}
+	return nil
+}
+
+func (x *Stress_Environment) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *Stress_Environment) GetSignSecret() string {
+	if x != nil {
+		return x.SignSecret
+	}
+	return ""
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
@@ -1062,12 +1439,13 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xe0\x05\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xfe\x06\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12@\n" +
 	"\x0eorder_database\x18\x02 \x01(\v2\x19.kratos.api.Data.DatabaseR\rorderDatabase\x12,\n" +
 	"\x05redis\x18\x03 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12#\n" +
-	"\x02s3\x18\x04 \x01(\v2\x13.kratos.api.Data.S3R\x02s3\x1a\x86\x01\n" +
+	"\x02s3\x18\x04 \x01(\v2\x13.kratos.api.Data.S3R\x02s3\x12/\n" +
+	"\x06memory\x18\x05 \x01(\v2\x17.kratos.api.Data.MemoryR\x06memory\x1a\x86\x01\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
@@ -1085,20 +1463,31 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\raccess_key_id\x18\x02 \x01(\tR\vaccessKeyId\x12*\n" +
 	"\x11secret_access_key\x18\x03 \x01(\tR\x0fsecretAccessKey\x12\x16\n" +
 	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x1a\n" +
-	"\bendpoint\x18\x05 \x01(\tR\bendpoint\"\x7f\n" +
+	"\bendpoint\x18\x05 \x01(\tR\bendpoint\x1ak\n" +
+	"\x06Memory\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x19\n" +
+	"\bbet_size\x18\x02 \x03(\x01R\abetSize\x12,\n" +
+	"\x12mock_platform_addr\x18\x03 \x01(\tR\x10mockPlatformAddr\"\x7f\n" +
 	"\x03Log\x12\x12\n" +
 	"\x04mode\x18\x01 \x01(\x05R\x04mode\x12\x14\n" +
 	"\x05level\x18\x02 \x01(\tR\x05level\x12\x10\n" +
 	"\x03app\x18\x03 \x01(\tR\x03app\x12\x16\n" +
 	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x10\n" +
 	"\x03dir\x18\x05 \x01(\tR\x03dir\x12\x12\n" +
-	"\x04file\x18\x06 \x01(\bR\x04file\"\xef\x06\n" +
+	"\x04file\x18\x06 \x01(\bR\x04file\"\xca\x10\n" +
 	"\x06Stress\x121\n" +
 	"\x06notify\x18\x01 \x01(\v2\x19.kratos.api.Stress.NotifyR\x06notify\x12.\n" +
 	"\x05chart\x18\x02 \x01(\v2\x18.kratos.api.Stress.ChartR\x05chart\x121\n" +
 	"\x06member\x18\x03 \x01(\v2\x19.kratos.api.Stress.MemberR\x06member\x121\n" +
 	"\x06launch\x18\x04 \x01(\v2\x19.kratos.api.Stress.LaunchR\x06launch\x124\n" +
-	"\ametrics\x18\x05 \x01(\v2\x1a.kratos.api.Stress.MetricsR\ametrics\x1a#\n" +
+	"\ametrics\x18\x05 \x01(\v2\x1a.kratos.api.Stress.MetricsR\ametrics\x12:\n" +
+	"\tscheduler\x18\x06 \x01(\v2\x1c.kratos.api.Stress.SchedulerR\tscheduler\x12:\n" +
+	"\trecording\x18\a \x01(\v2\x1c.kratos.api.Stress.RecordingR\trecording\x12H\n" +
+	"\fenvironments\x18\b \x03(\v2$.kratos.api.Stress.EnvironmentsEntryR\fenvironments\x124\n" +
+	"\asecrets\x18\t \x01(\v2\x1a.kratos.api.Stress.SecretsR\asecrets\x12\x1d\n" +
+	"\n" +
+	"game_specs\x18\n" +
+	" \x01(\tR\tgameSpecs\x1a#\n" +
 	"\aMetrics\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x1a\x82\x01\n" +
 	"\x06Notify\x12\x18\n" +
@@ -1117,14 +1506,45 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\finterval_sec\x18\x02 \x01(\x05R\vintervalSec\x12&\n" +
 	"\x0fbatch_load_size\x18\x03 \x01(\x05R\rbatchLoadSize\x12$\n" +
 	"\x0emax_load_total\x18\x04 \x01(\x05R\fmaxLoadTotal\x12#\n" +
-	"\rmember_prefix\x18\x05 \x01(\tR\fmemberPrefix\x1a\xa9\x01\n" +
+	"\rmember_prefix\x18\x05 \x01(\tR\fmemberPrefix\x1a\x81\x03\n" +
 	"\x06Launch\x12\x14\n" +
 	"\x05sites\x18\x01 \x03(\tR\x05sites\x12\x1a\n" +
 	"\bmerchant\x18\x02 \x01(\tR\bmerchant\x12 \n" +
 	"\aapi_url\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06apiUrl\x12&\n" +
 	"\n" +
 	"launch_url\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tlaunchUrl\x12#\n" +
-	"\rsign_required\x18\x05 \x01(\bR\fsignRequiredB\x1bZ\x19stress/internal/conf;confb\x06proto3"
+	"\rsign_required\x18\x05 \x01(\bR\fsignRequired\x12\x15\n" +
+	"\x06ws_url\x18\x06 \x01(\tR\x05wsUrl\x12I\n" +
+	"\n" +
+	"transports\x18\a \x03(\v2).kratos.api.Stress.Launch.TransportsEntryR\n" +
+	"transports\x125\n" +
+	"\x17ws_insecure_skip_verify\x18\b \x01(\bR\x14wsInsecureSkipVerify\x1a=\n" +
+	"\x0fTransportsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x90\x01\n" +
+	"\tScheduler\x12*\n" +
+	"\x11max_running_tasks\x18\x01 \x01(\x05R\x0fmaxRunningTasks\x12%\n" +
+	"\x0egame_exclusive\x18\x02 \x01(\bR\rgameExclusive\x120\n" +
+	"\x14max_inflight_members\x18\x03 \x01(\x05R\x12maxInflightMembers\x1a\x1d\n" +
+	"\tRecording\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x1a\x82\x02\n" +
+	"\aSecrets\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12G\n" +
+	"\tmerchants\x18\x02 \x03(\v2).kratos.api.Stress.Secrets.MerchantsEntryR\tmerchants\x12\x12\n" +
+	"\x04file\x18\x03 \x01(\tR\x04file\x12D\n" +
+	"\x10refresh_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x1a<\n" +
+	"\x0eMerchantsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xd1\x01\n" +
+	"\vEnvironment\x121\n" +
+	"\x06launch\x18\x01 \x01(\v2\x19.kratos.api.Stress.LaunchR\x06launch\x12@\n" +
+	"\x0eorder_database\x18\x02 \x01(\v2\x19.kratos.api.Data.DatabaseR\rorderDatabase\x12,\n" +
+	"\x05redis\x18\x03 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12\x1f\n" +
+	"\vsign_secret\x18\x04 \x01(\tR\n" +
+	"signSecret\x1a_\n" +
+	"\x11EnvironmentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.kratos.api.Stress.EnvironmentR\x05value:\x028\x01B\x1bZ\x19stress/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -1138,7 +1558,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -1150,12 +1570,20 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
 	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
 	(*Data_S3)(nil),             // 9: kratos.api.Data.S3
-	(*Stress_Metrics)(nil),      // 10: kratos.api.Stress.Metrics
-	(*Stress_Notify)(nil),       // 11: kratos.api.Stress.Notify
-	(*Stress_Chart)(nil),        // 12: kratos.api.Stress.Chart
-	(*Stress_Member)(nil),       // 13: kratos.api.Stress.Member
-	(*Stress_Launch)(nil),       // 14: kratos.api.Stress.Launch
-	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
+	(*Data_Memory)(nil),         // 10: kratos.api.Data.Memory
+	(*Stress_Metrics)(nil),      // 11: kratos.api.Stress.Metrics
+	(*Stress_Notify)(nil),       // 12: kratos.api.Stress.Notify
+	(*Stress_Chart)(nil),        // 13: kratos.api.Stress.Chart
+	(*Stress_Member)(nil),       // 14: kratos.api.Stress.Member
+	(*Stress_Launch)(nil),       // 15: kratos.api.Stress.Launch
+	(*Stress_Scheduler)(nil),    // 16: kratos.api.Stress.Scheduler
+	(*Stress_Recording)(nil),    // 17: kratos.api.Stress.Recording
+	(*Stress_Secrets)(nil),      // 18: kratos.api.Stress.Secrets
+	(*Stress_Environment)(nil),  // 19: kratos.api.Stress.Environment
+	nil,                         // 20: kratos.api.Stress.EnvironmentsEntry
+	nil,                         // 21: kratos.api.Stress.Launch.TransportsEntry
+	nil,                         // 22: kratos.api.Stress.Secrets.MerchantsEntry
+	(*durationpb.Duration)(nil), // 23: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -1168,20 +1596,32 @@ var file_conf_conf_proto_depIdxs = []int32{
 	7,  // 7: kratos.api.Data.order_database:type_name -> kratos.api.Data.Database
 	8,  // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
 	9,  // 9: kratos.api.Data.s3:type_name -> kratos.api.Data.S3
-	11, // 10: kratos.api.Stress.notify:type_name -> kratos.api.Stress.Notify
-	12, // 11: kratos.api.Stress.chart:type_name -> kratos.api.Stress.Chart
-	13, // 12: kratos.api.Stress.member:type_name -> kratos.api.Stress.Member
-	14, // 13: kratos.api.Stress.launch:type_name -> kratos.api.Stress.Launch
-	10, // 14: kratos.api.Stress.metrics:type_name -> kratos.api.Stress.Metrics
-	15, // 15: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	15, // 16: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	15, // 17: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	15, // 18: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	10, // 10: kratos.api.Data.memory:type_name -> kratos.api.Data.Memory
+	12, // 11: kratos.api.Stress.notify:type_name -> kratos.api.Stress.Notify
+	13, // 12: kratos.api.Stress.chart:type_name -> kratos.api.Stress.Chart
+	14, // 13: kratos.api.Stress.member:type_name -> kratos.api.Stress.Member
+	15, // 14: kratos.api.Stress.launch:type_name -> kratos.api.Stress.Launch
+	11, // 15: kratos.api.Stress.metrics:type_name -> kratos.api.Stress.Metrics
+	16, // 16: kratos.api.Stress.scheduler:type_name -> kratos.api.Stress.Scheduler
+	17, // 17: kratos.api.Stress.recording:type_name -> kratos.api.Stress.Recording
+	20, // 18: kratos.api.Stress.environments:type_name -> kratos.api.Stress.EnvironmentsEntry
+	18, // 19: kratos.api.Stress.secrets:type_name -> kratos.api.Stress.Secrets
+	23, // 20: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	23, // 21: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	23, // 22: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	23, // 23: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	21, // 24: kratos.api.Stress.Launch.transports:type_name -> kratos.api.Stress.Launch.TransportsEntry
+	22, // 25: kratos.api.Stress.Secrets.merchants:type_name -> kratos.api.Stress.Secrets.MerchantsEntry
+	23, // 26: kratos.api.Stress.Secrets.refresh_interval:type_name -> google.protobuf.Duration
+	15, // 27: kratos.api.Stress.Environment.launch:type_name -> kratos.api.Stress.Launch
+	7,  // 28: kratos.api.Stress.Environment.order_database:type_name -> kratos.api.Data.Database
+	8,  // 29: kratos.api.Stress.Environment.redis:type_name -> kratos.api.Data.Redis
+	19, // 30: kratos.api.Stress.EnvironmentsEntry.value:type_name -> kratos.api.Stress.Environment
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1195,7 +1635,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -543,6 +543,35 @@ func (m *Data) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetMemory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Memory",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Memory",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMemory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Memory",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DataMultiError(errors)
 	}
@@ -896,6 +925,141 @@ func (m *Stress) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetScheduler()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StressValidationError{
+					field:  "Scheduler",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StressValidationError{
+					field:  "Scheduler",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduler()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StressValidationError{
+				field:  "Scheduler",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecording()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StressValidationError{
+					field:  "Recording",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StressValidationError{
+					field:  "Recording",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecording()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StressValidationError{
+				field:  "Recording",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetEnvironments()))
+		i := 0
+		for key := range m.GetEnvironments() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetEnvironments()[key]
+			_ = val
+
+			// no validation rules for Environments[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, StressValidationError{
+							field:  fmt.Sprintf("Environments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, StressValidationError{
+							field:  fmt.Sprintf("Environments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return StressValidationError{
+						field:  fmt.Sprintf("Environments[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecrets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StressValidationError{
+					field:  "Secrets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StressValidationError{
+					field:  "Secrets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecrets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StressValidationError{
+				field:  "Secrets",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GameSpecs
+
 	if len(errors) > 0 {
 		return StressMultiError(errors)
 	}
@@ -1616,6 +1780,109 @@ var _ interface {
 	ErrorName() string
 } = Data_S3ValidationError{}
 
+// Validate checks the field values on Data_Memory with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Memory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Memory with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_MemoryMultiError, or
+// nil if none found.
+func (m *Data_Memory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Memory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for MockPlatformAddr
+
+	if len(errors) > 0 {
+		return Data_MemoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_MemoryMultiError is an error wrapping multiple validation errors
+// returned by Data_Memory.ValidateAll() if the designated constraints aren't met.
+type Data_MemoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_MemoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_MemoryMultiError) AllErrors() []error { return m }
+
+// Data_MemoryValidationError is the validation error returned by
+// Data_Memory.Validate if the designated constraints aren't met.
+type Data_MemoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_MemoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_MemoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_MemoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_MemoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_MemoryValidationError) ErrorName() string { return "Data_MemoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_MemoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Memory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_MemoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_MemoryValidationError{}
+
 // Validate checks the field values on Stress_Metrics with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2087,6 +2354,12 @@ func (m *Stress_Launch) validate(all bool) error {
 
 	// no validation rules for SignRequired
 
+	// no validation rules for WsUrl
+
+	// no validation rules for Transports
+
+	// no validation rules for WsInsecureSkipVerify
+
 	if len(errors) > 0 {
 		return Stress_LaunchMultiError(errors)
 	}
@@ -2164,3 +2437,537 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Stress_LaunchValidationError{}
+
+// Validate checks the field values on Stress_Scheduler with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Stress_Scheduler) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stress_Scheduler with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Stress_SchedulerMultiError, or nil if none found.
+func (m *Stress_Scheduler) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stress_Scheduler) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxRunningTasks
+
+	// no validation rules for GameExclusive
+
+	// no validation rules for MaxInflightMembers
+
+	if len(errors) > 0 {
+		return Stress_SchedulerMultiError(errors)
+	}
+
+	return nil
+}
+
+// Stress_SchedulerMultiError is an error wrapping multiple validation errors
+// returned by Stress_Scheduler.ValidateAll() if the designated constraints
+// aren't met.
+type Stress_SchedulerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Stress_SchedulerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Stress_SchedulerMultiError) AllErrors() []error { return m }
+
+// Stress_SchedulerValidationError is the validation error returned by
+// Stress_Scheduler.Validate if the designated constraints aren't met.
+type Stress_SchedulerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Stress_SchedulerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Stress_SchedulerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Stress_SchedulerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Stress_SchedulerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Stress_SchedulerValidationError) ErrorName() string { return "Stress_SchedulerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Stress_SchedulerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStress_Scheduler.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Stress_SchedulerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Stress_SchedulerValidationError{}
+
+// Validate checks the field values on Stress_Recording with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Stress_Recording) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stress_Recording with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Stress_RecordingMultiError, or nil if none found.
+func (m *Stress_Recording) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stress_Recording) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dir
+
+	if len(errors) > 0 {
+		return Stress_RecordingMultiError(errors)
+	}
+
+	return nil
+}
+
+// Stress_RecordingMultiError is an error wrapping multiple validation errors
+// returned by Stress_Recording.ValidateAll() if the designated constraints
+// aren't met.
+type Stress_RecordingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Stress_RecordingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Stress_RecordingMultiError) AllErrors() []error { return m }
+
+// Stress_RecordingValidationError is the validation error returned by
+// Stress_Recording.Validate if the designated constraints aren't met.
+type Stress_RecordingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Stress_RecordingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Stress_RecordingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Stress_RecordingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Stress_RecordingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Stress_RecordingValidationError) ErrorName() string { return "Stress_RecordingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Stress_RecordingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStress_Recording.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Stress_RecordingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Stress_RecordingValidationError{}
+
+// Validate checks the field values on Stress_Secrets with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Stress_Secrets) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stress_Secrets with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Stress_SecretsMultiError,
+// or nil if none found.
+func (m *Stress_Secrets) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stress_Secrets) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	// no validation rules for Merchants
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetRefreshInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Stress_SecretsValidationError{
+					field:  "RefreshInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Stress_SecretsValidationError{
+					field:  "RefreshInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Stress_SecretsValidationError{
+				field:  "RefreshInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Stress_SecretsMultiError(errors)
+	}
+
+	return nil
+}
+
+// Stress_SecretsMultiError is an error wrapping multiple validation errors
+// returned by Stress_Secrets.ValidateAll() if the designated constraints
+// aren't met.
+type Stress_SecretsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Stress_SecretsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Stress_SecretsMultiError) AllErrors() []error { return m }
+
+// Stress_SecretsValidationError is the validation error returned by
+// Stress_Secrets.Validate if the designated constraints aren't met.
+type Stress_SecretsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Stress_SecretsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Stress_SecretsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Stress_SecretsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Stress_SecretsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Stress_SecretsValidationError) ErrorName() string { return "Stress_SecretsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Stress_SecretsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStress_Secrets.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Stress_SecretsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Stress_SecretsValidationError{}
+
+// Validate checks the field values on Stress_Environment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Stress_Environment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stress_Environment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Stress_EnvironmentMultiError, or nil if none found.
+func (m *Stress_Environment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stress_Environment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLaunch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Stress_EnvironmentValidationError{
+					field:  "Launch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Stress_EnvironmentValidationError{
+					field:  "Launch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLaunch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Stress_EnvironmentValidationError{
+				field:  "Launch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOrderDatabase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Stress_EnvironmentValidationError{
+					field:  "OrderDatabase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Stress_EnvironmentValidationError{
+					field:  "OrderDatabase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrderDatabase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Stress_EnvironmentValidationError{
+				field:  "OrderDatabase",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRedis()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Stress_EnvironmentValidationError{
+					field:  "Redis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Stress_EnvironmentValidationError{
+					field:  "Redis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedis()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Stress_EnvironmentValidationError{
+				field:  "Redis",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SignSecret
+
+	if len(errors) > 0 {
+		return Stress_EnvironmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// Stress_EnvironmentMultiError is an error wrapping multiple validation errors
+// returned by Stress_Environment.ValidateAll() if the designated constraints
+// aren't met.
+type Stress_EnvironmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Stress_EnvironmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Stress_EnvironmentMultiError) AllErrors() []error { return m }
+
+// Stress_EnvironmentValidationError is the validation error returned by
+// Stress_Environment.Validate if the designated constraints aren't met.
+type Stress_EnvironmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Stress_EnvironmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Stress_EnvironmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Stress_EnvironmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Stress_EnvironmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Stress_EnvironmentValidationError) ErrorName() string {
+	return "Stress_EnvironmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Stress_EnvironmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStress_Environment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Stress_EnvironmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Stress_EnvironmentValidationError{}
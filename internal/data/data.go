@@ -17,18 +17,58 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewRedis, NewMysql, NewDataRepo, NewS3Bucket)
+var ProviderSet = wire.NewSet(NewDataRepo)
 
 type dataRepo struct {
 	data *Data
 	log  *log.Helper
+	envs *environments // 命名环境（默认环境与各环境仓储共享）
 }
 
-func NewDataRepo(data *Data, logger log.Logger) biz.DataRepo {
+// NewDataRepo 按配置创建数据层：memory.enabled 时使用内存实现，否则连接 MySQL / Redis / S3；
+// 命名环境的订单库与 Redis 在首次使用时连接
+func NewDataRepo(c *conf.Data, sc *conf.Stress, logger log.Logger) (biz.DataRepo, func(), error) {
+	if c.GetMemory().GetEnabled() {
+		return newMemoryDataRepo(c.Memory, logger)
+	}
+
+	var cleanups []func()
+	cleanup := func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}
+	db, dbCleanup, err := NewMysql(c, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanups = append(cleanups, dbCleanup)
+	rdb, rdbCleanup, err := NewRedis(c, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	cleanups = append(cleanups, rdbCleanup)
+	s3, s3Cleanup, err := NewS3Bucket(c, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	cleanups = append(cleanups, s3Cleanup)
+	data, dataCleanup, err := NewData(c, logger, db, rdb, s3)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	cleanups = append(cleanups, dataCleanup)
+
+	envs := newEnvironments(sc.GetEnvironments(), logger)
+	cleanups = append(cleanups, envs.close)
 	return &dataRepo{
 		data: data,
 		log:  log.NewHelper(logger),
-	}
+		envs: envs,
+	}, cleanup, nil
 }
 
 // Data .
@@ -42,6 +82,10 @@ type Data struct {
 // NewData .
 func NewData(c *conf.Data, logger log.Logger, db *xorm.Engine, rdb redis.UniversalClient, s3 *S3Bucket) (*Data, func(), error) {
 	l := log.NewHelper(logger)
+	// 任务历史/定时任务表（不存在则创建，新增字段自动补齐）
+	if err := db.Sync2(new(StressTask), new(StressTaskReport), new(StressSchedule)); err != nil {
+		return nil, nil, errors.Newf(500, "DB_SYNC_FAILED", "sync task tables: %v", err)
+	}
 	order, orderCleanup, err := newMysqlFromConf(c.OrderDatabase, logger, "order")
 	if err != nil {
 		return nil, nil, err
@@ -53,21 +97,26 @@ func NewData(c *conf.Data, logger log.Logger, db *xorm.Engine, rdb redis.Univers
 	return &Data{db: db, order: order, rdb: rdb, s3Bucket: s3}, cleanup, nil
 }
 
-// NewRedis 创建并配置 Redis 客户端
+// NewRedis 创建默认 Redis 客户端
 func NewRedis(c *conf.Data, logger log.Logger) (redis.UniversalClient, func(), error) {
+	return newRedisFromConf(c.GetRedis(), logger)
+}
+
+// newRedisFromConf 创建并配置 Redis 客户端
+func newRedisFromConf(c *conf.Data_Redis, logger log.Logger) (redis.UniversalClient, func(), error) {
 	l := log.NewHelper(logger)
 
 	// 验证配置
-	if len(c.Redis.Addr) == 0 {
+	if len(c.GetAddr()) == 0 {
 		return nil, nil, errors.Newf(500, "REDIS_ADDR_REQUIRED", "redis address is required")
 	}
 
 	rdb := redis.NewUniversalClient(&redis.UniversalOptions{
-		Addrs:        c.Redis.Addr,
-		Password:     c.Redis.Password,
-		DB:           int(c.Redis.Db),
-		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
-		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+		Addrs:        c.Addr,
+		Password:     c.Password,
+		DB:           int(c.Db),
+		ReadTimeout:  c.ReadTimeout.AsDuration(),
+		WriteTimeout: c.WriteTimeout.AsDuration(),
 		// 连接池配置 - 防止 "connection pool timeout"
 		PoolSize:        50,               // 连接池大小（默认 10 * CPU 核心数）
 		MinIdleConns:    10,               // 最小空闲连接数
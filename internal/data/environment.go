@@ -0,0 +1,95 @@
+package data
+
+import (
+	"fmt"
+	"sync"
+
+	"stress/internal/biz/task"
+	"stress/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// environments 命名环境的仓储：订单库与 Redis 在首次使用时连接并缓存，未单独配置的部分沿用默认环境
+type environments struct {
+	conf   map[string]*conf.Stress_Environment
+	logger log.Logger
+
+	mu       sync.Mutex
+	repos    map[string]*dataRepo
+	cleanups []func()
+}
+
+func newEnvironments(c map[string]*conf.Stress_Environment, logger log.Logger) *environments {
+	return &environments{conf: c, logger: logger, repos: make(map[string]*dataRepo)}
+}
+
+// Environment 实现 DataRepo：返回命名环境的仓储，"" 为默认环境
+func (r *dataRepo) Environment(name string) (task.Repo, error) {
+	if name == "" {
+		return r, nil
+	}
+	return r.envs.open(name, r)
+}
+
+// EnvironmentStores 单独配置了订单库 / Redis 时为环境名，沿用默认环境时为 ""
+func (r *dataRepo) EnvironmentStores(name string) (orders, redis string) {
+	c := r.envs.conf[name]
+	if c.GetOrderDatabase() != nil {
+		orders = name
+	}
+	if c.GetRedis() != nil {
+		redis = name
+	}
+	return orders, redis
+}
+
+// open 连接环境的订单库与 Redis（已连接则直接返回）；任务历史、成员与 S3 共用默认环境
+func (e *environments) open(name string, root *dataRepo) (*dataRepo, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if r, ok := e.repos[name]; ok {
+		return r, nil
+	}
+	c, ok := e.conf[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown environment: %s", name)
+	}
+
+	data := *root.data
+	var cleanups []func()
+	if c.OrderDatabase != nil {
+		order, cleanup, err := newMysqlFromConf(c.OrderDatabase, e.logger, name+"/order")
+		if err != nil {
+			return nil, err
+		}
+		data.order = order
+		cleanups = append(cleanups, cleanup)
+	}
+	if c.Redis != nil {
+		rdb, cleanup, err := newRedisFromConf(c.Redis, e.logger)
+		if err != nil {
+			for _, fn := range cleanups {
+				fn()
+			}
+			return nil, err
+		}
+		data.rdb = rdb
+		cleanups = append(cleanups, cleanup)
+	}
+
+	r := &dataRepo{data: &data, log: log.NewHelper(log.With(e.logger, "env", name)), envs: e}
+	e.repos[name] = r
+	e.cleanups = append(e.cleanups, cleanups...)
+	return r, nil
+}
+
+// close 关闭各环境的连接
+func (e *environments) close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i := len(e.cleanups) - 1; i >= 0; i-- {
+		e.cleanups[i]()
+	}
+	e.cleanups = nil
+}
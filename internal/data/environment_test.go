@@ -0,0 +1,60 @@
+package data
+
+import (
+	"strings"
+	"testing"
+
+	"stress/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestEnvironmentOpen(t *testing.T) {
+	envs := newEnvironments(map[string]*conf.Stress_Environment{
+		"shared":    {},
+		"bad_redis": {Redis: &conf.Data_Redis{}},
+		"bad_order": {OrderDatabase: &conf.Data_Database{Driver: "nope"}},
+	}, log.DefaultLogger)
+	defer envs.close()
+	root := &dataRepo{data: &Data{}, log: log.NewHelper(log.DefaultLogger), envs: envs}
+
+	tests := []struct {
+		name string
+		env  string
+		err  string
+	}{
+		{name: "empty name is default", env: ""},
+		{name: "no overrides share default", env: "shared"},
+		{name: "unknown environment", env: "prod", err: "unknown environment: prod"},
+		{name: "redis override fails", env: "bad_redis", err: "redis address is required"},
+		{name: "order database override fails", env: "bad_order", err: "nope"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := root.Environment(tt.env)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				if _, ok := envs.repos[tt.env]; ok {
+					t.Fatal("failed environment should not be cached")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := repo.(*dataRepo)
+			if tt.env == "" && r != root {
+				t.Fatal("empty name should return the default repo")
+			}
+			if r.data.order != root.data.order || r.data.rdb != root.data.rdb {
+				t.Fatal("environment without overrides should share the default order db / redis")
+			}
+			again, _ := root.Environment(tt.env)
+			if again != repo {
+				t.Fatal("environment repo should be cached")
+			}
+		})
+	}
+}
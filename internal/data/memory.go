@@ -0,0 +1,467 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz"
+	"stress/internal/biz/chart"
+	"stress/internal/biz/member"
+	"stress/internal/biz/task"
+	"stress/internal/conf"
+	"stress/internal/mockplatform"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultMemoryBetSize 内存模式下未配置 bet_size 时所有游戏的下注档位
+var defaultMemoryBetSize = []float64{0.1, 1, 10}
+
+// MemoryOrder 内存订单库中的一条订单（对应 game_order 的 amount / bonus_amount）
+type MemoryOrder struct {
+	GameID      int64
+	Merchant    string
+	Member      string
+	Amount      float64
+	BonusAmount float64
+	CreatedAt   time.Time
+}
+
+// memoryRepo 内存实现的 DataRepo：订单、任务 ID 计数、成员、对象存储、任务历史与定时任务均保存在进程内，
+// 用于无基础设施时演示与测试调度、任务生命周期、RTP 计算与绘图流程
+type memoryRepo struct {
+	log     *log.Helper
+	betSize []float64
+
+	mu        sync.Mutex
+	orders    []MemoryOrder
+	counters  map[string]int64 // date/gameID -> 当日计数
+	members   map[string]int64 // member_name -> id
+	blobs     map[string][]byte
+	tasks     map[string]*v1.Task
+	taskSeq   map[string]int64 // task_id -> 首次写入顺序（同一秒内创建的任务按此排序，对应 MySQL 自增 id）
+	reports   map[string]*v1.TaskCompletionReport
+	schedules map[int64]*v1.Schedule
+	lastID    int64 // 成员 / 任务 / 定时任务共用的自增 ID
+}
+
+// newMemoryRepo 创建内存 DataRepo；betSize 为空时使用默认档位
+func newMemoryRepo(betSize []float64, logger log.Logger) *memoryRepo {
+	if len(betSize) == 0 {
+		betSize = defaultMemoryBetSize
+	}
+	return &memoryRepo{
+		log:       log.NewHelper(logger),
+		betSize:   slices.Clone(betSize),
+		counters:  make(map[string]int64),
+		members:   make(map[string]int64),
+		blobs:     make(map[string][]byte),
+		tasks:     make(map[string]*v1.Task),
+		taskSeq:   make(map[string]int64),
+		reports:   make(map[string]*v1.TaskCompletionReport),
+		schedules: make(map[int64]*v1.Schedule),
+	}
+}
+
+var _ biz.DataRepo = (*memoryRepo)(nil)
+
+// RecordOrder 写入一条订单（模拟平台下注回调、测试用）
+func (r *memoryRepo) RecordOrder(o MemoryOrder) {
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = time.Now()
+	}
+	r.mu.Lock()
+	r.orders = append(r.orders, o)
+	r.mu.Unlock()
+}
+
+// matchOrder 与 buildOrderWhere 口径一致（created_at 按秒比较）
+func matchOrder(o *MemoryOrder, scope task.OrderScope) bool {
+	ex := scope.ExcludeAmt
+	if ex <= 0 {
+		ex = excludeAmt
+	}
+	if o.GameID != scope.GameID || o.Amount == ex {
+		return false
+	}
+	if scope.Merchant != "" && o.Merchant != scope.Merchant {
+		return false
+	}
+	ts := o.CreatedAt.Unix()
+	if !scope.StartTime.IsZero() && ts < scope.StartTime.Unix() {
+		return false
+	}
+	if !scope.EndTime.IsZero() && ts > scope.EndTime.Unix() {
+		return false
+	}
+	return true
+}
+
+// scopedOrders 按范围筛选订单（调用方持有 r.mu）
+func (r *memoryRepo) scopedOrders(scope task.OrderScope) []MemoryOrder {
+	var out []MemoryOrder
+	for i := range r.orders {
+		if matchOrder(&r.orders[i], scope) {
+			out = append(out, r.orders[i])
+		}
+	}
+	return out
+}
+
+// GetGameOrderCount 订单总数（无过滤）
+func (r *memoryRepo) GetGameOrderCount(ctx context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return int64(len(r.orders)), nil
+}
+
+// GetDetailedOrderAmounts 与 MySQL 实现一致：金额 ×10000 取整
+func (r *memoryRepo) GetDetailedOrderAmounts(ctx context.Context, scope task.OrderScope) (totalBet, totalWin, betOrderCount, bonusOrderCount int64, err error) {
+	r.mu.Lock()
+	orders := r.scopedOrders(scope)
+	r.mu.Unlock()
+
+	var bet, win float64
+	for _, o := range orders {
+		bet += o.Amount
+		win += o.BonusAmount
+		if o.BonusAmount > 0 {
+			bonusOrderCount++
+		}
+	}
+	return int64(math.Round(bet * orderUnit)), int64(math.Round(win * orderUnit)), int64(len(orders)), bonusOrderCount, nil
+}
+
+// QueryGameOrderPoints 与 MySQL 实现一致：按写入顺序分为 ≤sampleMax 个桶，前缀累加计算盈利率
+func (r *memoryRepo) QueryGameOrderPoints(ctx context.Context, scope task.OrderScope) ([]chart.Point, error) {
+	if scope.GameID == 0 || scope.Merchant == "" {
+		return nil, fmt.Errorf("game_id and merchant are required")
+	}
+	r.mu.Lock()
+	orders := r.scopedOrders(scope)
+	r.mu.Unlock()
+	if len(orders) == 0 {
+		return []chart.Point{}, nil
+	}
+
+	width := max((len(orders)+sampleMax-1)/sampleMax, 1)
+	pts := make([]chart.Point, 0, min(len(orders), sampleMax))
+	var cumBet, cumWin float64
+	for i, o := range orders {
+		if o.Amount > 0 {
+			cumBet += o.Amount
+		}
+		cumWin += o.BonusAmount
+		if (i+1)%width != 0 && i != len(orders)-1 {
+			continue
+		}
+		rate := 0.0
+		if cumBet > 0 {
+			rate = (cumBet - cumWin) / cumBet
+		}
+		pts = append(pts, chart.Point{
+			X:    float64(i+1) / orderUnit,
+			Y:    rate,
+			Time: o.CreatedAt.In(time.Local).Format(timeLayout),
+		})
+	}
+	return pts, nil
+}
+
+// UploadBytes 保存到内存对象存储，返回 mem://bucket/key
+func (r *memoryRepo) UploadBytes(ctx context.Context, bucket, key, contentType string, data []byte) (string, error) {
+	if bucket == "" {
+		bucket = "stress"
+	}
+	url := "mem://" + bucket + "/" + key
+	r.mu.Lock()
+	r.blobs[url] = slices.Clone(data)
+	r.mu.Unlock()
+	return url, nil
+}
+
+// Blob 读取 UploadBytes 保存的内容
+func (r *memoryRepo) Blob(url string) ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.blobs[url]
+	return b, ok
+}
+
+// CleanRedisBySites 内存模式无 Redis 缓存
+func (r *memoryRepo) CleanRedisBySites(ctx context.Context, sites []string) error {
+	return nil
+}
+
+// CleanGameOrderTable 清空订单
+func (r *memoryRepo) CleanGameOrderTable(ctx context.Context) error {
+	r.mu.Lock()
+	n := len(r.orders)
+	r.orders = nil
+	r.mu.Unlock()
+	r.log.Infof("[Memory] game orders cleared: %d", n)
+	return nil
+}
+
+// GetOrderCountByScope 按范围统计订单数
+func (r *memoryRepo) GetOrderCountByScope(ctx context.Context, scope task.OrderScope) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return int64(len(r.scopedOrders(scope))), nil
+}
+
+// DeleteOrdersByScope 按范围删除订单
+func (r *memoryRepo) DeleteOrdersByScope(ctx context.Context, scope task.OrderScope) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	before := len(r.orders)
+	r.orders = slices.DeleteFunc(r.orders, func(o MemoryOrder) bool { return matchOrder(&o, scope) })
+	return int64(before - len(r.orders)), nil
+}
+
+// BatchUpsertMembers 已有账号回填 ID，没有则分配新 ID
+func (r *memoryRepo) BatchUpsertMembers(ctx context.Context, members []member.Info) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range members {
+		id, ok := r.members[members[i].Name]
+		if !ok {
+			r.lastID++
+			id = r.lastID
+			r.members[members[i].Name] = id
+		}
+		members[i].ID = id
+	}
+	return nil
+}
+
+// NextTaskID 与 Redis 实现格式一致：YYYYMMDD-gameID-count
+func (r *memoryRepo) NextTaskID(ctx context.Context, gameID int64) (string, error) {
+	date := time.Now().Format("20060102")
+	key := fmt.Sprintf("%s/%d", date, gameID)
+	r.mu.Lock()
+	r.counters[key]++
+	count := r.counters[key]
+	r.mu.Unlock()
+	return fmt.Sprintf("%s-%d-%d", date, gameID, count), nil
+}
+
+// GetGameBetSize 所有游戏使用配置的下注档位
+func (r *memoryRepo) GetGameBetSize(ctx context.Context, gameIDs []int64) (map[int64][]float64, error) {
+	out := make(map[int64][]float64, len(gameIDs))
+	for _, id := range gameIDs {
+		out[id] = slices.Clone(r.betSize)
+	}
+	return out, nil
+}
+
+// ListMerchantSecrets 内存模式无商户表，密钥需通过 secrets.source=config/file 提供
+func (r *memoryRepo) ListMerchantSecrets(ctx context.Context) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// SaveTask 按 task_id 覆盖保存
+func (r *memoryRepo) SaveTask(ctx context.Context, t *v1.Task) error {
+	c := proto.Clone(t).(*v1.Task)
+	c.Report = nil
+	r.mu.Lock()
+	if _, ok := r.taskSeq[t.TaskId]; !ok {
+		r.lastID++
+		r.taskSeq[t.TaskId] = r.lastID
+	}
+	r.tasks[t.TaskId] = c
+	r.mu.Unlock()
+	return nil
+}
+
+// SaveTaskReport 保存最终报告（重复写入覆盖）
+func (r *memoryRepo) SaveTaskReport(ctx context.Context, taskID string, rpt *v1.TaskCompletionReport) error {
+	r.mu.Lock()
+	r.reports[taskID] = proto.Clone(rpt).(*v1.TaskCompletionReport)
+	r.mu.Unlock()
+	return nil
+}
+
+// ListTaskHistory 分页查询任务历史（按创建时间倒序）
+func (r *memoryRepo) ListTaskHistory(ctx context.Context, f task.Filter) ([]*v1.Task, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []*v1.Task
+	for _, t := range r.tasks {
+		created := parseDateTime(t.CreatedAt)
+		switch {
+		case f.Status != v1.TaskStatus_TASK_UNSPECIFIED && t.Status != int32(f.Status):
+		case f.GameID != 0 && t.GetConfig().GetGameId() != f.GameID:
+		case !f.Start.IsZero() && created < f.Start.Unix():
+		case !f.End.IsZero() && created >= f.End.Unix():
+		default:
+			matched = append(matched, t)
+		}
+	}
+	slices.SortFunc(matched, func(a, b *v1.Task) int {
+		if c := parseDateTime(b.CreatedAt) - parseDateTime(a.CreatedAt); c != 0 {
+			return int(c)
+		}
+		return int(r.taskSeq[b.TaskId] - r.taskSeq[a.TaskId])
+	})
+
+	total := int64(len(matched))
+	if f.Limit > 0 {
+		matched = matched[min(f.Offset, len(matched)):min(f.Offset+f.Limit, len(matched))]
+	}
+	out := make([]*v1.Task, 0, len(matched))
+	for _, t := range matched {
+		c := proto.Clone(t).(*v1.Task)
+		if rpt, ok := r.reports[t.TaskId]; ok {
+			c.Report = proto.Clone(rpt).(*v1.TaskCompletionReport)
+		}
+		out = append(out, c)
+	}
+	return out, total, nil
+}
+
+// GetTaskHistory 查询单个任务及最终报告；任务不存在返回 nil
+func (r *memoryRepo) GetTaskHistory(ctx context.Context, taskID string) (*v1.Task, *v1.TaskCompletionReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tasks[taskID]
+	if !ok {
+		return nil, nil, nil
+	}
+	var rpt *v1.TaskCompletionReport
+	if x, ok := r.reports[taskID]; ok {
+		rpt = proto.Clone(x).(*v1.TaskCompletionReport)
+	}
+	return proto.Clone(t).(*v1.Task), rpt, nil
+}
+
+// DeleteTaskHistory 删除任务历史及报告
+func (r *memoryRepo) DeleteTaskHistory(ctx context.Context, taskID string) error {
+	r.mu.Lock()
+	delete(r.tasks, taskID)
+	delete(r.taskSeq, taskID)
+	delete(r.reports, taskID)
+	r.mu.Unlock()
+	return nil
+}
+
+// FailUnfinishedTasks 将未结束任务（PENDING/RUNNING/PAUSED/PROCESSING）标记为失败
+func (r *memoryRepo) FailUnfinishedTasks(ctx context.Context, reason string) (int64, error) {
+	now := formatDateTime(time.Now().Unix())
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int64
+	for _, t := range r.tasks {
+		switch v1.TaskStatus(t.Status) {
+		case v1.TaskStatus_TASK_PENDING, v1.TaskStatus_TASK_RUNNING, v1.TaskStatus_TASK_PAUSED, v1.TaskStatus_TASK_PROCESSING:
+			t.Status, t.Reason, t.FinishAt = int32(v1.TaskStatus_TASK_FAILED), reason, now
+			n++
+		}
+	}
+	return n, nil
+}
+
+// CreateSchedule 保存定时任务，返回自增 ID
+func (r *memoryRepo) CreateSchedule(ctx context.Context, s *v1.Schedule) (int64, error) {
+	c := proto.Clone(s).(*v1.Schedule)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastID++
+	c.ScheduleId = r.lastID
+	c.CreatedAt = formatDateTime(time.Now().Unix())
+	r.schedules[c.ScheduleId] = c
+	return c.ScheduleId, nil
+}
+
+// ListSchedules 查询全部定时任务（按 ID 升序）
+func (r *memoryRepo) ListSchedules(ctx context.Context) ([]*v1.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]*v1.Schedule, 0, len(r.schedules))
+	for _, s := range r.schedules {
+		out = append(out, proto.Clone(s).(*v1.Schedule))
+	}
+	slices.SortFunc(out, func(a, b *v1.Schedule) int { return int(a.ScheduleId - b.ScheduleId) })
+	return out, nil
+}
+
+// UpdateScheduleRun 记录最近一次触发
+func (r *memoryRepo) UpdateScheduleRun(ctx context.Context, id int64, runAt time.Time, taskIDs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.schedules[id]; ok {
+		s.LastRunAt = formatDateTime(runAt.Unix())
+		s.LastTaskIds = slices.Clone(taskIDs)
+	}
+	return nil
+}
+
+// DeleteSchedule 删除定时任务
+func (r *memoryRepo) DeleteSchedule(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	delete(r.schedules, id)
+	r.mu.Unlock()
+	return nil
+}
+
+// newMemoryDataRepo 创建内存数据层；配置了 mock_platform_addr 时内嵌启动模拟平台，其下注订单直接写入内存订单库
+func newMemoryDataRepo(c *conf.Data_Memory, logger log.Logger) (biz.DataRepo, func(), error) {
+	repo := newMemoryRepo(c.GetBetSize(), logger)
+	if c.GetMockPlatformAddr() == "" {
+		repo.log.Info("using in-memory data repo")
+		return repo, func() {}, nil
+	}
+
+	mock, err := mockplatform.New(mockplatform.Config{})
+	if err != nil {
+		return nil, nil, err
+	}
+	mock.OnOrder(func(o mockplatform.Order) {
+		repo.RecordOrder(MemoryOrder{
+			GameID:      o.GameID,
+			Merchant:    o.Merchant,
+			Member:      o.Member,
+			Amount:      o.Bet,
+			BonusAmount: o.Win,
+			CreatedAt:   o.Time,
+		})
+	})
+	ln, err := net.Listen("tcp", c.MockPlatformAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listen mock platform: %w", err)
+	}
+	srv := &http.Server{Handler: mock, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			repo.log.Errorf("mock platform: %v", err)
+		}
+	}()
+	repo.log.Infof("using in-memory data repo, mock platform listening on %s", ln.Addr())
+
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(ctx)
+	}
+	return repo, cleanup, nil
+}
+
+// Environment 内存模式下所有环境共用同一份数据（订单按商户区分）
+func (r *memoryRepo) Environment(name string) (task.Repo, error) {
+	return r, nil
+}
+
+// EnvironmentStores 内存模式下所有环境共用同一存储
+func (r *memoryRepo) EnvironmentStores(string) (orders, redis string) {
+	return "", ""
+}
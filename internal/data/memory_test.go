@@ -0,0 +1,76 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/task"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestMemoryRepoOrders(t *testing.T) {
+	r := newMemoryRepo(nil, log.DefaultLogger)
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		r.RecordOrder(MemoryOrder{GameID: 1, Merchant: "m", Amount: 2, BonusAmount: float64(i), CreatedAt: now})
+	}
+	r.RecordOrder(MemoryOrder{GameID: 1, Merchant: "m", Amount: 0.01, CreatedAt: now})              // 排除金额
+	r.RecordOrder(MemoryOrder{GameID: 1, Merchant: "other", Amount: 2, CreatedAt: now})             // 其他商户
+	r.RecordOrder(MemoryOrder{GameID: 2, Merchant: "m", Amount: 2, CreatedAt: now})                 // 其他游戏
+	r.RecordOrder(MemoryOrder{GameID: 1, Merchant: "m", Amount: 2, CreatedAt: now.Add(-time.Hour)}) // 范围外
+	scope := task.OrderScope{GameID: 1, Merchant: "m", StartTime: now.Add(-time.Minute), EndTime: now}
+
+	bet, win, n, bonus, err := r.GetDetailedOrderAmounts(t.Context(), scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bet != 80000 || win != 60000 || n != 4 || bonus != 3 {
+		t.Fatalf("amounts = %d/%d/%d/%d, want 80000/60000/4/3", bet, win, n, bonus)
+	}
+
+	pts, err := r.QueryGameOrderPoints(t.Context(), scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pts) != 4 || pts[3].X != 4/orderUnit || pts[3].Y != (8.0-6)/8 {
+		t.Fatalf("points = %+v", pts)
+	}
+
+	if n, _ := r.DeleteOrdersByScope(t.Context(), scope); n != 4 {
+		t.Fatalf("deleted %d, want 4", n)
+	}
+	if n, _ := r.GetGameOrderCount(t.Context()); n != 4 {
+		t.Fatalf("remaining %d, want 4", n)
+	}
+}
+
+func TestMemoryRepoTaskHistory(t *testing.T) {
+	r := newMemoryRepo(nil, log.DefaultLogger)
+	created := formatDateTime(time.Now().Unix())
+	for _, id := range []string{"a", "b", "c"} {
+		tk := &v1.Task{TaskId: id, Status: int32(v1.TaskStatus_TASK_RUNNING), CreatedAt: created, Config: &v1.TaskConfig{GameId: 1}}
+		if err := r.SaveTask(t.Context(), tk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = r.SaveTaskReport(t.Context(), "b", &v1.TaskCompletionReport{TotalBet: 1})
+
+	if n, _ := r.FailUnfinishedTasks(t.Context(), "restart"); n != 3 {
+		t.Fatalf("failed %d tasks, want 3", n)
+	}
+	list, total, err := r.ListTaskHistory(t.Context(), task.Filter{Status: v1.TaskStatus_TASK_FAILED, Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3 || len(list) != 1 || list[0].TaskId != "b" || list[0].Report.GetTotalBet() != 1 {
+		t.Fatalf("list = %v, total = %d", list, total)
+	}
+
+	id1, _ := r.NextTaskID(t.Context(), 7)
+	id2, _ := r.NextTaskID(t.Context(), 7)
+	if date := time.Now().Format("20060102"); id1 != date+"-7-1" || id2 != date+"-7-2" {
+		t.Fatalf("task ids = %s, %s", id1, id2)
+	}
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"context"
+)
+
+// Merchant 主库商户表（仅读取签名密钥）
+type Merchant struct {
+	Merchant string `xorm:"'merchant'"`
+	Secret   string `xorm:"'secret'"`
+}
+
+func (*Merchant) TableName() string {
+	return "merchant"
+}
+
+// ListMerchantSecrets 读取全部商户签名密钥，空密钥跳过
+func (r *dataRepo) ListMerchantSecrets(ctx context.Context) (map[string]string, error) {
+	var list []Merchant
+	if err := r.data.db.Context(ctx).Cols("merchant", "secret").Find(&list); err != nil {
+		return nil, err
+	}
+	out := make(map[string]string, len(list))
+	for _, m := range list {
+		if m.Merchant != "" && m.Secret != "" {
+			out[m.Merchant] = m.Secret
+		}
+	}
+	return out, nil
+}
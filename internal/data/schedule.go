@@ -0,0 +1,102 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "stress/api/stress/v1"
+)
+
+// StressSchedule 定时任务（stress_schedule），template 为仅含模板字段的 Schedule JSON
+type StressSchedule struct {
+	ID          int64  `xorm:"pk autoincr 'id'"`
+	Name        string `xorm:"varchar(128) 'name'"`
+	Cron        string `xorm:"varchar(128) notnull 'cron'"`
+	Template    string `xorm:"text 'template'"`
+	LastRunAt   int64  `xorm:"'last_run_at'"`
+	LastTaskIDs string `xorm:"text 'last_task_ids'"` // 逗号分隔
+	CreatedAt   int64  `xorm:"'created_at'"`
+	UpdatedAt   int64  `xorm:"'updated_at'"`
+}
+
+func (*StressSchedule) TableName() string {
+	return "stress_schedule"
+}
+
+// CreateSchedule 新建定时任务，返回自增 ID
+func (r *dataRepo) CreateSchedule(ctx context.Context, s *v1.Schedule) (int64, error) {
+	tmpl, err := protoMarshal.Marshal(&v1.Schedule{Template: s.Template})
+	if err != nil {
+		return 0, fmt.Errorf("marshal schedule template: %w", err)
+	}
+	now := time.Now().Unix()
+	row := &StressSchedule{
+		Name:      s.Name,
+		Cron:      s.Cron,
+		Template:  string(tmpl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := r.data.db.Context(ctx).Insert(row); err != nil {
+		return 0, fmt.Errorf("insert stress_schedule: %w", err)
+	}
+	return row.ID, nil
+}
+
+// ListSchedules 查询全部定时任务（按 ID 升序）
+func (r *dataRepo) ListSchedules(ctx context.Context) ([]*v1.Schedule, error) {
+	var rows []StressSchedule
+	if err := r.data.db.Context(ctx).Asc("id").Find(&rows); err != nil {
+		return nil, fmt.Errorf("query stress_schedule: %w", err)
+	}
+
+	out := make([]*v1.Schedule, 0, len(rows))
+	for i := range rows {
+		s, err := fromScheduleRow(&rows[i])
+		if err != nil {
+			r.log.Warnf("skip schedule %d: %v", rows[i].ID, err)
+			continue
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// UpdateScheduleRun 记录最近一次触发
+func (r *dataRepo) UpdateScheduleRun(ctx context.Context, id int64, runAt time.Time, taskIDs []string) error {
+	_, err := r.data.db.Context(ctx).ID(id).Cols("last_run_at", "last_task_ids", "updated_at").Update(&StressSchedule{
+		LastRunAt:   runAt.Unix(),
+		LastTaskIDs: strings.Join(taskIDs, ","),
+		UpdatedAt:   time.Now().Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("update stress_schedule: %w", err)
+	}
+	return nil
+}
+
+// DeleteSchedule 删除定时任务
+func (r *dataRepo) DeleteSchedule(ctx context.Context, id int64) error {
+	if _, err := r.data.db.Context(ctx).ID(id).Delete(&StressSchedule{}); err != nil {
+		return fmt.Errorf("delete stress_schedule: %w", err)
+	}
+	return nil
+}
+
+func fromScheduleRow(row *StressSchedule) (*v1.Schedule, error) {
+	s := &v1.Schedule{}
+	if err := protoUnmarshal.Unmarshal([]byte(row.Template), s); err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	s.ScheduleId = row.ID
+	s.Name = row.Name
+	s.Cron = row.Cron
+	s.CreatedAt = formatDateTime(row.CreatedAt)
+	s.LastRunAt = formatDateTime(row.LastRunAt)
+	if row.LastTaskIDs != "" {
+		s.LastTaskIds = strings.Split(row.LastTaskIDs, ",")
+	}
+	return s, nil
+}
@@ -0,0 +1,270 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "stress/api/stress/v1"
+	"stress/internal/biz/task"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// StressTask 任务历史（stress_task）
+type StressTask struct {
+	ID          int64  `xorm:"pk autoincr 'id'"`
+	TaskID      string `xorm:"varchar(64) notnull unique 'task_id'"`
+	GameID      int64  `xorm:"index 'game_id'"`
+	Description string `xorm:"varchar(255) 'description'"`
+	Status      int32  `xorm:"index 'status'"`
+	Reason      string `xorm:"varchar(1024) 'reason'"`
+	Process     int64  `xorm:"'process'"`
+	Config      string `xorm:"text 'config'"`
+	RecordURL   string `xorm:"text 'record_url'"`
+	CreatedAt   int64  `xorm:"index 'created_at'"`
+	StartAt     int64  `xorm:"'start_at'"`
+	FinishAt    int64  `xorm:"'finish_at'"`
+	UpdatedAt   int64  `xorm:"'updated_at'"`
+}
+
+func (*StressTask) TableName() string {
+	return "stress_task"
+}
+
+// StressTaskReport 任务最终报告（stress_task_report），report 为 TaskCompletionReport 的 JSON
+type StressTaskReport struct {
+	TaskID    string `xorm:"varchar(64) pk 'task_id'"`
+	Report    string `xorm:"mediumtext 'report'"`
+	CreatedAt int64  `xorm:"'created_at'"`
+}
+
+func (*StressTaskReport) TableName() string {
+	return "stress_task_report"
+}
+
+var (
+	protoMarshal   = protojson.MarshalOptions{UseProtoNames: true}
+	protoUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// SaveTask 实现 task.Store：按 task_id 更新，不存在则插入
+func (r *dataRepo) SaveTask(ctx context.Context, t *v1.Task) error {
+	row, err := toTaskRow(t)
+	if err != nil {
+		return err
+	}
+	row.UpdatedAt = time.Now().Unix()
+
+	n, err := r.data.db.Context(ctx).
+		Where("task_id = ?", row.TaskID).
+		Cols("game_id", "description", "status", "reason", "process", "config", "record_url", "start_at", "finish_at", "updated_at").
+		Update(row)
+	if err != nil {
+		return fmt.Errorf("update stress_task: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+	if _, err := r.data.db.Context(ctx).Insert(row); err != nil {
+		return fmt.Errorf("insert stress_task: %w", err)
+	}
+	return nil
+}
+
+// SaveTaskReport 实现 task.Store：保存最终报告（重复写入覆盖）
+func (r *dataRepo) SaveTaskReport(ctx context.Context, taskID string, rpt *v1.TaskCompletionReport) error {
+	b, err := protoMarshal.Marshal(rpt)
+	if err != nil {
+		return fmt.Errorf("marshal report: %w", err)
+	}
+	row := &StressTaskReport{TaskID: taskID, Report: string(b), CreatedAt: time.Now().Unix()}
+
+	n, err := r.data.db.Context(ctx).Where("task_id = ?", taskID).Cols("report", "created_at").Update(row)
+	if err != nil {
+		return fmt.Errorf("update stress_task_report: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+	if _, err := r.data.db.Context(ctx).Insert(row); err != nil {
+		return fmt.Errorf("insert stress_task_report: %w", err)
+	}
+	return nil
+}
+
+// ListTaskHistory 分页查询任务历史（按创建时间倒序）
+func (r *dataRepo) ListTaskHistory(ctx context.Context, f task.Filter) ([]*v1.Task, int64, error) {
+	sess := r.data.db.NewSession().Context(ctx)
+	defer sess.Close()
+
+	if f.Status != v1.TaskStatus_TASK_UNSPECIFIED {
+		sess.And("status = ?", int32(f.Status))
+	}
+	if f.GameID != 0 {
+		sess.And("game_id = ?", f.GameID)
+	}
+	if !f.Start.IsZero() {
+		sess.And("created_at >= ?", f.Start.Unix())
+	}
+	if !f.End.IsZero() {
+		sess.And("created_at < ?", f.End.Unix())
+	}
+	if f.Limit > 0 {
+		sess.Limit(f.Limit, f.Offset)
+	}
+
+	var rows []StressTask
+	total, err := sess.Desc("created_at", "id").FindAndCount(&rows)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query stress_task: %w", err)
+	}
+
+	out := make([]*v1.Task, 0, len(rows))
+	ids := make([]string, 0, len(rows))
+	for i := range rows {
+		out = append(out, fromTaskRow(&rows[i]))
+		ids = append(ids, rows[i].TaskID)
+	}
+
+	reports, err := r.loadTaskReports(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, t := range out {
+		t.Report = reports[t.TaskId]
+	}
+	return out, total, nil
+}
+
+// loadTaskReports 批量读取最终报告，无法解析的报告跳过
+func (r *dataRepo) loadTaskReports(ctx context.Context, taskIDs []string) (map[string]*v1.TaskCompletionReport, error) {
+	out := make(map[string]*v1.TaskCompletionReport, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return out, nil
+	}
+	var rows []StressTaskReport
+	if err := r.data.db.Context(ctx).In("task_id", taskIDs).Find(&rows); err != nil {
+		return nil, fmt.Errorf("query stress_task_report: %w", err)
+	}
+	for i := range rows {
+		rpt := &v1.TaskCompletionReport{}
+		if err := protoUnmarshal.Unmarshal([]byte(rows[i].Report), rpt); err != nil {
+			r.log.Warnf("unmarshal report %s: %v", rows[i].TaskID, err)
+			continue
+		}
+		out[rows[i].TaskID] = rpt
+	}
+	return out, nil
+}
+
+// GetTaskHistory 查询单个任务及最终报告；任务不存在返回 nil，报告不存在时 rpt 为 nil
+func (r *dataRepo) GetTaskHistory(ctx context.Context, taskID string) (*v1.Task, *v1.TaskCompletionReport, error) {
+	var row StressTask
+	ok, err := r.data.db.Context(ctx).Where("task_id = ?", taskID).Get(&row)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query stress_task: %w", err)
+	}
+	if !ok {
+		return nil, nil, nil
+	}
+
+	var rptRow StressTaskReport
+	ok, err = r.data.db.Context(ctx).Where("task_id = ?", taskID).Get(&rptRow)
+	if err != nil || !ok {
+		return fromTaskRow(&row), nil, err
+	}
+	rpt := &v1.TaskCompletionReport{}
+	if err := protoUnmarshal.Unmarshal([]byte(rptRow.Report), rpt); err != nil {
+		return fromTaskRow(&row), nil, fmt.Errorf("unmarshal report: %w", err)
+	}
+	return fromTaskRow(&row), rpt, nil
+}
+
+// DeleteTaskHistory 删除任务历史及报告
+func (r *dataRepo) DeleteTaskHistory(ctx context.Context, taskID string) error {
+	if _, err := r.data.db.Context(ctx).Where("task_id = ?", taskID).Delete(&StressTaskReport{}); err != nil {
+		return fmt.Errorf("delete stress_task_report: %w", err)
+	}
+	if _, err := r.data.db.Context(ctx).Where("task_id = ?", taskID).Delete(&StressTask{}); err != nil {
+		return fmt.Errorf("delete stress_task: %w", err)
+	}
+	return nil
+}
+
+// FailUnfinishedTasks 将上次进程残留的未结束任务（PENDING/RUNNING/PAUSED/PROCESSING）标记为失败
+func (r *dataRepo) FailUnfinishedTasks(ctx context.Context, reason string) (int64, error) {
+	now := time.Now().Unix()
+	n, err := r.data.db.Context(ctx).
+		In("status", int32(v1.TaskStatus_TASK_PENDING), int32(v1.TaskStatus_TASK_RUNNING),
+			int32(v1.TaskStatus_TASK_PAUSED), int32(v1.TaskStatus_TASK_PROCESSING)).
+		Cols("status", "reason", "finish_at", "updated_at").
+		Update(&StressTask{Status: int32(v1.TaskStatus_TASK_FAILED), Reason: reason, FinishAt: now, UpdatedAt: now})
+	if err != nil {
+		return 0, fmt.Errorf("fail unfinished tasks: %w", err)
+	}
+	return n, nil
+}
+
+func toTaskRow(t *v1.Task) (*StressTask, error) {
+	row := &StressTask{
+		TaskID:      t.TaskId,
+		GameID:      t.GetConfig().GetGameId(),
+		Description: t.Description,
+		Status:      t.Status,
+		Reason:      t.Reason,
+		Process:     t.Process,
+		RecordURL:   t.RecordUrl,
+		CreatedAt:   parseDateTime(t.CreatedAt),
+		StartAt:     parseDateTime(t.StartAt),
+		FinishAt:    parseDateTime(t.FinishAt),
+	}
+	if t.Config != nil {
+		b, err := protoMarshal.Marshal(t.Config)
+		if err != nil {
+			return nil, fmt.Errorf("marshal task config: %w", err)
+		}
+		row.Config = string(b)
+	}
+	return row, nil
+}
+
+func fromTaskRow(row *StressTask) *v1.Task {
+	t := &v1.Task{
+		TaskId:      row.TaskID,
+		Description: row.Description,
+		Status:      row.Status,
+		Process:     row.Process,
+		RecordUrl:   row.RecordURL,
+		Reason:      row.Reason,
+		CreatedAt:   formatDateTime(row.CreatedAt),
+		StartAt:     formatDateTime(row.StartAt),
+		FinishAt:    formatDateTime(row.FinishAt),
+	}
+	if row.Config != "" {
+		cfg := &v1.TaskConfig{}
+		if err := protoUnmarshal.Unmarshal([]byte(row.Config), cfg); err == nil {
+			t.Config = cfg
+		}
+	}
+	return t
+}
+
+// parseDateTime 解析 v1.Task 中的本地时间字符串，空串返回 0
+func parseDateTime(s string) int64 {
+	if s == "" {
+		return 0
+	}
+	ts, err := time.ParseInLocation(time.DateTime, s, time.Local)
+	if err != nil {
+		return 0
+	}
+	return ts.Unix()
+}
+
+func formatDateTime(sec int64) string {
+	if sec <= 0 {
+		return ""
+	}
+	return time.Unix(sec, 0).In(time.Local).Format(time.DateTime)
+}
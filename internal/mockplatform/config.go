@@ -0,0 +1,144 @@
+package mockplatform
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// 延迟分布
+const (
+	DistFixed       = "fixed"
+	DistUniform     = "uniform"
+	DistNormal      = "normal"
+	DistExponential = "exponential"
+)
+
+// 接口（与压测客户端的 op 一致），OpAny 为未单独配置时的默认值
+const (
+	OpLaunch   = "launch"
+	OpLogin    = "login"
+	OpBetOrder = "betorder"
+	OpBetBonus = "betbonus"
+	OpAny      = "*"
+)
+
+const (
+	defaultRTP   = 0.96
+	defaultLines = 20
+)
+
+// Config 模拟平台配置（可由 YAML/JSON 加载，见 cmd/mockplatform）
+type Config struct {
+	Latency  map[string]Latency    `json:"latency"`   // op -> 延迟分布，"*" 为默认
+	Errors   map[string]ErrorRates `json:"errors"`    // op -> 错误注入概率，"*" 为默认
+	Games    map[int64]*Script     `json:"games"`     // gameID -> 响应脚本（未配置的游戏每次下注即结束一局）
+	RTP      float64               `json:"rtp"`       // 订单赢额期望（相对下注额），默认 0.96
+	HitRate  float64               `json:"hit_rate"`  // 中奖概率，默认 0.25；中奖时赢额 = 下注 × rtp / hit_rate
+	Lines    int64                 `json:"lines"`     // 线数，订单下注额 = base_money × multiple × lines，默认 20
+	TokenTTL string                `json:"token_ttl"` // 会话 token 有效期（如 "30s"），过期返回 token expired 并被清理；为空不过期。重新登录后旧 token 失效
+}
+
+// Latency 延迟分布（毫秒）；min/max 对所有分布生效（截断）
+type Latency struct {
+	Dist   string  `json:"dist"`    // fixed（默认）/ uniform / normal / exponential
+	MeanMs float64 `json:"mean_ms"` // fixed / normal / exponential 的均值
+	StdMs  float64 `json:"std_ms"`  // normal 的标准差
+	MinMs  float64 `json:"min_ms"`  // uniform 下限
+	MaxMs  float64 `json:"max_ms"`  // uniform 上限；>0 时为所有分布的上限
+}
+
+// ErrorRates 错误注入概率（0~1，按顺序判定，合计不应超过 1）
+type ErrorRates struct {
+	TokenExpired  float64 `json:"token_expired"`  // 业务错误 token expired（默认策略：重新 launch）
+	Limit         float64 `json:"limit"`          // 业务错误 limit exceeded（默认策略：重新登录）
+	InternalError float64 `json:"internal_error"` // 业务错误 internal error（默认策略：重新 launch）
+	Unavailable   float64 `json:"unavailable"`    // HTTP 503
+}
+
+// Script 按游戏编排的响应序列；每个成员独立从头依次返回，到末尾后循环
+type Script struct {
+	FreeData map[string]any   `json:"free_data"` // login 返回的 freeData
+	Spins    []map[string]any `json:"spins"`     // betorder 依次返回的 data
+	Bonus    []map[string]any `json:"bonus"`     // betbonus 依次返回的 data
+}
+
+// Validate 校验配置
+func (c *Config) Validate() error {
+	for op, l := range c.Latency {
+		switch l.Dist {
+		case "", DistFixed, DistUniform, DistNormal, DistExponential:
+		default:
+			return fmt.Errorf("latency[%s]: unknown dist %q", op, l.Dist)
+		}
+		if l.MaxMs > 0 && l.MinMs > l.MaxMs {
+			return fmt.Errorf("latency[%s]: min_ms > max_ms", op)
+		}
+	}
+	for op, e := range c.Errors {
+		if sum := e.TokenExpired + e.Limit + e.InternalError + e.Unavailable; sum < 0 || sum > 1 {
+			return fmt.Errorf("errors[%s]: rates sum %.3f out of [0, 1]", op, sum)
+		}
+	}
+	if c.TokenTTL != "" {
+		if _, err := time.ParseDuration(c.TokenTTL); err != nil {
+			return fmt.Errorf("token_ttl: %w", err)
+		}
+	}
+	return nil
+}
+
+// Sample 按分布采样一次延迟
+func (l Latency) Sample() time.Duration {
+	var ms float64
+	switch l.Dist {
+	case DistUniform:
+		ms = l.MinMs + rand.Float64()*math.Max(l.MaxMs-l.MinMs, 0)
+	case DistNormal:
+		ms = l.MeanMs + rand.NormFloat64()*l.StdMs
+	case DistExponential:
+		ms = rand.ExpFloat64() * l.MeanMs
+	default:
+		ms = l.MeanMs
+	}
+	ms = math.Max(ms, l.MinMs)
+	if l.MaxMs > 0 {
+		ms = math.Min(ms, l.MaxMs)
+	}
+	return time.Duration(math.Max(ms, 0) * float64(time.Millisecond))
+}
+
+// pick 按概率选出注入的错误，未命中返回 ""
+func (e ErrorRates) pick() string {
+	r := rand.Float64()
+	for _, x := range []struct {
+		kind string
+		rate float64
+	}{
+		{errTokenExpired, e.TokenExpired},
+		{errLimit, e.Limit},
+		{errInternal, e.InternalError},
+		{errUnavailable, e.Unavailable},
+	} {
+		if r < x.rate {
+			return x.kind
+		}
+		r -= x.rate
+	}
+	return ""
+}
+
+func (c *Config) latency(op string) Latency {
+	if l, ok := c.Latency[op]; ok {
+		return l
+	}
+	return c.Latency[OpAny]
+}
+
+func (c *Config) errors(op string) ErrorRates {
+	if e, ok := c.Errors[op]; ok {
+		return e
+	}
+	return c.Errors[OpAny]
+}
@@ -0,0 +1,47 @@
+package mockplatform
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatencySampleBounds(t *testing.T) {
+	cases := []Latency{
+		{MeanMs: 5},
+		{Dist: DistUniform, MinMs: 2, MaxMs: 4},
+		{Dist: DistNormal, MeanMs: 10, StdMs: 50, MinMs: 1, MaxMs: 20},
+		{Dist: DistExponential, MeanMs: 10, MaxMs: 30},
+	}
+	for _, l := range cases {
+		lo := time.Duration(l.MinMs * float64(time.Millisecond))
+		hi := time.Duration(l.MaxMs * float64(time.Millisecond))
+		for range 1000 {
+			d := l.Sample()
+			if d < lo || (l.MaxMs > 0 && d > hi) || (l.Dist == "" && d != 5*time.Millisecond) {
+				t.Fatalf("%+v sampled %v", l, d)
+			}
+		}
+	}
+}
+
+func TestErrorRatesPick(t *testing.T) {
+	e := ErrorRates{TokenExpired: 0.2, Unavailable: 0.3}
+	got := map[string]int{}
+	const n = 20000
+	for range n {
+		got[e.pick()]++
+	}
+	for kind, want := range map[string]float64{errTokenExpired: 0.2, errUnavailable: 0.3, "": 0.5} {
+		if rate := float64(got[kind]) / n; rate < want-0.03 || rate > want+0.03 {
+			t.Fatalf("%q rate = %.3f, want ~%.2f", kind, rate, want)
+		}
+	}
+	if got[errLimit] != 0 || got[errInternal] != 0 {
+		t.Fatalf("unexpected kinds: %v", got)
+	}
+
+	bad := Config{Errors: map[string]ErrorRates{OpAny: {Limit: 0.8, InternalError: 0.5}}}
+	if bad.Validate() == nil {
+		t.Fatal("rates above 1 should be rejected")
+	}
+}
@@ -0,0 +1,497 @@
+// Package mockplatform 模拟游戏平台（launch / login / betorder / betbonus），
+// 用于本地演示与测试中端到端运行压测任务，支持延迟分布、错误注入与按游戏编排的响应脚本。
+// 除 HTTP 接口外，GET /ws/game 提供 WebSocket 接入（login / betorder / betbonus，帧格式见 serveWS）。
+package mockplatform
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"maps"
+	mrand "math/rand/v2"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"stress/pkg/ws"
+)
+
+// 业务错误码
+const (
+	CodeOK           = 0
+	CodeBadRequest   = 1000
+	CodeInvalidToken = 1001
+	CodeTokenExpired = 1002
+	CodeLimit        = 1003
+	CodeInternal     = 1004
+)
+
+// 注入的错误类型
+const (
+	errTokenExpired = "token_expired"
+	errLimit        = "limit"
+	errInternal     = "internal_error"
+	errUnavailable  = "unavailable"
+)
+
+const (
+	defaultHitRate = 0.25
+	maxBodySize    = 1 << 20
+	launchTokenTTL = 10 * time.Minute // 未配置 token_ttl 时未使用的 launch token 的有效期
+	pruneInterval  = time.Minute      // 过期 token 清理间隔
+)
+
+// Order 一次 betorder 产生的订单
+type Order struct {
+	Merchant string
+	Member   string
+	GameID   int64
+	Bet      float64
+	Win      float64
+	Time     time.Time
+}
+
+// Stats 请求与订单统计
+type Stats struct {
+	Requests map[string]int64 // op -> 请求数
+	Injected map[string]int64 // 错误类型 -> 注入次数
+	Orders   int64
+	TotalBet float64
+	TotalWin float64
+}
+
+// Server 模拟平台，实现 http.Handler
+type Server struct {
+	cfg      Config
+	rtp      float64
+	hitRate  float64
+	lines    int64
+	tokenTTL time.Duration
+	mux      *http.ServeMux
+	wsOps    map[string]handlerFunc // WebSocket 支持的 op
+
+	mu       sync.Mutex
+	launches map[string]session        // launch token -> 成员
+	sessions map[string]session        // 会话 token -> 成员
+	current  map[progressKey]string    // 成员在各游戏的当前会话 token（重新登录时旧 token 失效）
+	prunedAt time.Time                 // 上次清理过期 token 的时间
+	progress map[progressKey]*progress // 成员在各游戏脚本中的进度
+	stats    Stats
+	onOrder  func(Order)
+}
+
+type session struct {
+	merchant string
+	member   string
+	gameID   int64
+	issuedAt time.Time
+}
+
+type progressKey struct {
+	member string
+	gameID int64
+}
+
+type progress struct {
+	spin, bonus int
+}
+
+// New 创建模拟平台
+func New(cfg Config) (*Server, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		cfg:      cfg,
+		rtp:      cfg.RTP,
+		hitRate:  cfg.HitRate,
+		lines:    cfg.Lines,
+		mux:      http.NewServeMux(),
+		launches: make(map[string]session),
+		sessions: make(map[string]session),
+		current:  make(map[progressKey]string),
+		progress: make(map[progressKey]*progress),
+		stats:    Stats{Requests: make(map[string]int64), Injected: make(map[string]int64)},
+	}
+	if s.rtp <= 0 {
+		s.rtp = defaultRTP
+	}
+	if s.lines <= 0 {
+		s.lines = defaultLines
+	}
+	if s.hitRate <= 0 || s.hitRate > 1 {
+		s.hitRate = defaultHitRate
+	}
+	if cfg.TokenTTL != "" {
+		s.tokenTTL, _ = time.ParseDuration(cfg.TokenTTL)
+	}
+
+	s.wsOps = map[string]handlerFunc{
+		OpLogin:    s.login,
+		OpBetOrder: s.betOrder,
+		OpBetBonus: s.betBonus,
+	}
+	s.mux.HandleFunc("POST /v1/game/launch", s.handle(OpLaunch, s.launch))
+	s.mux.HandleFunc("POST /api/member/login", s.handle(OpLogin, s.login))
+	s.mux.HandleFunc("POST /api/game/betorder", s.handle(OpBetOrder, s.betOrder))
+	s.mux.HandleFunc("POST /api/game/betbonus", s.handle(OpBetBonus, s.betBonus))
+	s.mux.HandleFunc("GET /ws/game", s.serveWS)
+	return s, nil
+}
+
+// OnOrder 设置订单回调（同步调用），用于对接内存订单库等
+func (s *Server) OnOrder(fn func(Order)) {
+	s.mu.Lock()
+	s.onOrder = fn
+	s.mu.Unlock()
+}
+
+// Stats 返回统计快照
+func (s *Server) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.stats
+	st.Requests = maps.Clone(s.stats.Requests)
+	st.Injected = maps.Clone(s.stats.Injected)
+	return st
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// response 与平台响应格式一致
+type response struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data any    `json:"data,omitempty"`
+}
+
+// request 一次请求（HTTP 请求或 WebSocket 帧）
+type request struct {
+	host  string
+	token string // 会话 token（HTTP 为 x-token 请求头）
+	body  []byte
+}
+
+type handlerFunc func(req request) response
+
+// serve 统一处理：计数、延迟、错误注入；ok 为 false 表示请求取消或注入了服务不可用
+func (s *Server) serve(ctx context.Context, op string, h handlerFunc, req request) (res response, ok bool) {
+	s.mu.Lock()
+	s.stats.Requests[op]++
+	s.mu.Unlock()
+
+	if !sleep(ctx, s.cfg.latency(op).Sample()) {
+		return res, false
+	}
+
+	switch kind := s.cfg.errors(op).pick(); kind {
+	case errUnavailable:
+		s.countInjected(kind)
+		return res, false
+	case errTokenExpired:
+		s.countInjected(kind)
+		res = response{Code: CodeTokenExpired, Msg: "token expired"}
+	case errLimit:
+		s.countInjected(kind)
+		res = response{Code: CodeLimit, Msg: "request limit exceeded"}
+	case errInternal:
+		s.countInjected(kind)
+		res = response{Code: CodeInternal, Msg: "internal error"}
+	default:
+		res = h(req)
+	}
+	return res, true
+}
+
+// handle HTTP 接口
+func (s *Server) handle(op string, h handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+		if err != nil {
+			return
+		}
+		res, ok := s.serve(r.Context(), op, h, request{host: r.Host, token: r.Header.Get("x-token"), body: body})
+		if !ok {
+			if r.Context().Err() == nil {
+				http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+// wsRequest WebSocket 请求帧，data 与 HTTP 请求体一致
+type wsRequest struct {
+	ID    uint64          `json:"id"`
+	Op    string          `json:"op"`
+	Token string          `json:"token"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// wsResponse WebSocket 响应帧：HTTP 响应并回带请求 id
+type wsResponse struct {
+	ID uint64 `json:"id"`
+	response
+}
+
+// serveWS 每条连接上的请求并发处理，按 id 回复；注入服务不可用时断开连接
+func (s *Server) serveWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := ws.Upgrade(w, r)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req wsRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			continue
+		}
+		h, ok := s.wsOps[req.Op]
+		if !ok {
+			s.writeWS(conn, wsResponse{ID: req.ID, response: response{Code: CodeBadRequest, Msg: "unknown op"}})
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, ok := s.serve(ctx, req.Op, h, request{host: r.Host, token: req.Token, body: req.Data})
+			if !ok {
+				_ = conn.Close()
+				return
+			}
+			s.writeWS(conn, wsResponse{ID: req.ID, response: res})
+		}()
+	}
+}
+
+func (s *Server) writeWS(conn *ws.Conn, res wsResponse) {
+	if b, err := json.Marshal(res); err == nil {
+		_ = conn.WriteMessage(ws.TextMessage, b)
+	}
+}
+
+func (s *Server) countInjected(kind string) {
+	s.mu.Lock()
+	s.stats.Injected[kind]++
+	s.mu.Unlock()
+}
+
+func (s *Server) launch(r request) response {
+	var req struct {
+		GameID   int64  `json:"gameId"`
+		Merchant string `json:"merchant"`
+		Member   string `json:"member"`
+	}
+	if err := json.Unmarshal(r.body, &req); err != nil || req.Member == "" || req.GameID <= 0 {
+		return response{Code: CodeBadRequest, Msg: "bad request"}
+	}
+	tk := newToken()
+	now := time.Now()
+	s.mu.Lock()
+	s.pruneLocked(now)
+	s.launches[tk] = session{merchant: req.Merchant, member: req.Member, gameID: req.GameID, issuedAt: now}
+	s.mu.Unlock()
+
+	launchURL := "http://" + r.host + "/game/?token=" + tk
+	return response{Data: map[string]any{"launchUrl": url.QueryEscape(launchURL)}}
+}
+
+func (s *Server) login(r request) response {
+	var req struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(r.body, &req); err != nil {
+		return response{Code: CodeBadRequest, Msg: "bad request"}
+	}
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruneLocked(now)
+	sess, ok := s.launches[req.Token]
+	if !ok || now.Sub(sess.issuedAt) > s.launchTTL() {
+		delete(s.launches, req.Token)
+		return response{Code: CodeInvalidToken, Msg: "invalid token"}
+	}
+	delete(s.launches, req.Token)
+
+	// 同一成员在该游戏只保留最新会话
+	key := progressKey{member: sess.member, gameID: sess.gameID}
+	if old, ok := s.current[key]; ok {
+		delete(s.sessions, old)
+	}
+	tk := newToken()
+	sess.issuedAt = now
+	s.sessions[tk] = sess
+	s.current[key] = tk
+	var freeData map[string]any
+	if sc := s.cfg.Games[sess.gameID]; sc != nil {
+		freeData = sc.FreeData
+	}
+	return response{Data: map[string]any{"token": tk, "freeData": freeData}}
+}
+
+func (s *Server) betOrder(r request) response {
+	var req struct {
+		GameID    int64   `json:"gameId"`
+		BaseMoney float64 `json:"baseMoney"`
+		Multiple  int64   `json:"multiple"`
+	}
+	if err := json.Unmarshal(r.body, &req); err != nil {
+		return response{Code: CodeBadRequest, Msg: "bad request"}
+	}
+
+	s.mu.Lock()
+	sess, res, ok := s.session(r)
+	if !ok {
+		s.mu.Unlock()
+		return res
+	}
+	p := s.progressOf(sess)
+	var data map[string]any
+	if sc := s.cfg.Games[sess.gameID]; sc != nil && len(sc.Spins) > 0 {
+		data = maps.Clone(sc.Spins[p.spin%len(sc.Spins)])
+	}
+	p.spin++
+
+	order := Order{
+		Merchant: sess.merchant,
+		Member:   sess.member,
+		GameID:   sess.gameID,
+		Bet:      req.BaseMoney * float64(max(req.Multiple, 1)*s.lines),
+		Time:     time.Now(),
+	}
+	if mrand.Float64() < s.hitRate {
+		order.Win = order.Bet * s.rtp / s.hitRate
+	}
+	if data == nil {
+		data = map[string]any{"bet": order.Bet, "win": order.Win}
+	}
+	s.stats.Orders++
+	s.stats.TotalBet += order.Bet
+	s.stats.TotalWin += order.Win
+	onOrder := s.onOrder
+	s.mu.Unlock()
+
+	if onOrder != nil {
+		onOrder(order)
+	}
+	return response{Data: data}
+}
+
+func (s *Server) betBonus(r request) response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, res, ok := s.session(r)
+	if !ok {
+		return res
+	}
+	p := s.progressOf(sess)
+	data := map[string]any{}
+	if sc := s.cfg.Games[sess.gameID]; sc != nil && len(sc.Bonus) > 0 {
+		data = maps.Clone(sc.Bonus[p.bonus%len(sc.Bonus)])
+	}
+	p.bonus++
+	return response{Data: data}
+}
+
+// session 校验请求中的会话 token（调用方持有 s.mu）
+func (s *Server) session(r request) (session, response, bool) {
+	sess, ok := s.sessions[r.token]
+	if !ok {
+		return sess, response{Code: CodeInvalidToken, Msg: "invalid token"}, false
+	}
+	if s.expired(sess, time.Now()) {
+		s.dropSessionLocked(r.token, sess)
+		return sess, response{Code: CodeTokenExpired, Msg: "token expired"}, false
+	}
+	return sess, response{}, true
+}
+
+// expired 会话 token 是否超过 token_ttl
+func (s *Server) expired(sess session, now time.Time) bool {
+	return s.tokenTTL > 0 && now.Sub(sess.issuedAt) > s.tokenTTL
+}
+
+// launchTTL launch token 有效期：token_ttl，未配置时为 launchTokenTTL
+func (s *Server) launchTTL() time.Duration {
+	if s.tokenTTL > 0 {
+		return s.tokenTTL
+	}
+	return launchTokenTTL
+}
+
+// pruneLocked 每隔 pruneInterval 清理过期的 launch / 会话 token（调用方持有 s.mu）
+func (s *Server) pruneLocked(now time.Time) {
+	if now.Sub(s.prunedAt) < pruneInterval {
+		return
+	}
+	s.prunedAt = now
+	for tk, sess := range s.launches {
+		if now.Sub(sess.issuedAt) > s.launchTTL() {
+			delete(s.launches, tk)
+		}
+	}
+	for tk, sess := range s.sessions {
+		if s.expired(sess, now) {
+			s.dropSessionLocked(tk, sess)
+		}
+	}
+}
+
+// dropSessionLocked 删除会话 token（调用方持有 s.mu）
+func (s *Server) dropSessionLocked(tk string, sess session) {
+	delete(s.sessions, tk)
+	key := progressKey{member: sess.member, gameID: sess.gameID}
+	if s.current[key] == tk {
+		delete(s.current, key)
+	}
+}
+
+// progressOf 成员在该游戏脚本中的进度（调用方持有 s.mu）
+func (s *Server) progressOf(sess session) *progress {
+	key := progressKey{member: sess.member, gameID: sess.gameID}
+	p, ok := s.progress[key]
+	if !ok {
+		p = &progress{}
+		s.progress[key] = p
+	}
+	return p
+}
+
+func newToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// sleep 等待 d，请求取消时返回 false
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,93 @@
+package mockplatform
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// login 走 launch + login，返回会话 token
+func login(t *testing.T, s *Server, member string) string {
+	t.Helper()
+	res := s.launch(request{host: "mock", body: []byte(`{"gameId":1,"merchant":"m","member":"` + member + `"}`)})
+	raw, err := url.QueryUnescape(res.Data.(map[string]any)["launchUrl"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res = s.login(request{body: []byte(`{"token":"` + u.Query().Get("token") + `"}`)})
+	if res.Code != CodeOK {
+		t.Fatalf("login: %+v", res)
+	}
+	return res.Data.(map[string]any)["token"].(string)
+}
+
+func bet(s *Server, token string) int {
+	return s.betOrder(request{token: token, body: []byte(`{"gameId":1,"baseMoney":1,"multiple":1}`)}).Code
+}
+
+func TestReloginInvalidatesPreviousToken(t *testing.T) {
+	s, err := New(Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := login(t, s, "u1")
+	other := login(t, s, "u2")
+	cur := login(t, s, "u1")
+
+	if code := bet(s, old); code != CodeInvalidToken {
+		t.Fatalf("previous token code = %d, want %d", code, CodeInvalidToken)
+	}
+	if code := bet(s, cur); code != CodeOK {
+		t.Fatalf("current token code = %d", code)
+	}
+	if code := bet(s, other); code != CodeOK {
+		t.Fatalf("other member token code = %d", code)
+	}
+	if len(s.sessions) != 2 || len(s.current) != 2 || len(s.launches) != 0 {
+		t.Fatalf("sessions=%d current=%d launches=%d, want 2 / 2 / 0", len(s.sessions), len(s.current), len(s.launches))
+	}
+}
+
+func TestExpiredTokensPruned(t *testing.T) {
+	s, err := New(Config{TokenTTL: "1m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired := login(t, s, "u1")
+	stale := login(t, s, "u2")
+	s.launch(request{host: "mock", body: []byte(`{"gameId":1,"member":"u3"}`)}) // 未使用的 launch token
+
+	// 模拟时间流逝：全部 token 超过有效期
+	past := time.Now().Add(-2 * time.Minute)
+	for tk, sess := range s.sessions {
+		sess.issuedAt = past
+		s.sessions[tk] = sess
+	}
+	for tk, sess := range s.launches {
+		sess.issuedAt = past
+		s.launches[tk] = sess
+	}
+
+	if code := bet(s, expired); code != CodeTokenExpired {
+		t.Fatalf("expired token code = %d, want %d", code, CodeTokenExpired)
+	}
+	if code := bet(s, expired); code != CodeInvalidToken {
+		t.Fatalf("expired token should be removed, code = %d", code)
+	}
+
+	s.prunedAt = past // 到达清理间隔
+	cur := login(t, s, "u4")
+	if code := bet(s, stale); code != CodeInvalidToken {
+		t.Fatalf("pruned token code = %d, want %d", code, CodeInvalidToken)
+	}
+	if len(s.sessions) != 1 || len(s.current) != 1 || len(s.launches) != 0 {
+		t.Fatalf("sessions=%d current=%d launches=%d, want 1 / 1 / 0", len(s.sessions), len(s.current), len(s.launches))
+	}
+	if code := bet(s, cur); code != CodeOK {
+		t.Fatalf("fresh token code = %d", code)
+	}
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	nethttp "net/http"
+
 	v1 "stress/api/stress/v1"
 	"stress/internal/conf"
 	"stress/internal/service"
@@ -34,6 +36,17 @@ func NewHTTPServer(c *conf.Server, stress *service.StressService, logger log.Log
 	if c.Http.Timeout != nil {
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
+	// 任务进度订阅（SSE）：在路由之前分流，不受 server timeout 约束，
+	// 请求 ctx 随客户端断开或服务关闭取消
+	opts = append(opts, http.Filter(func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.URL.Path == "/stress/WatchTask" {
+				stress.WatchTaskSSE(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}))
 	srv := http.NewServer(opts...)
 	v1.RegisterStressServiceHTTPServer(srv, stress)
 
@@ -4,21 +4,23 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"sync"
+	"time"
 
 	v1 "stress/api/stress/v1"
 	"stress/internal/biz"
-	"stress/internal/biz/game/base"
+	"stress/internal/biz/game"
 	"stress/internal/biz/task"
 	"stress/pkg/xgo"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const (
 	Failed = 1
+
+	defaultPageSize     = 20  // ListTasks 默认每页条数
+	defaultSessionLimit = 100 // ListSessions 默认返回条数
 )
 
 // StressService is a stress test service.
@@ -50,25 +52,58 @@ func (s *StressService) ListGames(ctx context.Context, in *v1.ListGamesRequest)
 			GameId:   g.GameID(),
 			GameName: g.Name(),
 			BetSize:  g.BetSize(),
+			Source:   game.SourceOf(g),
 		}
 	}
 	return &v1.ListGamesResponse{Games: games, Total: int32(len(games))}, nil
 }
 
-// ListTasks 获取任务列表
+// ReloadGames 重新加载声明式游戏定义
+func (s *StressService) ReloadGames(ctx context.Context, in *v1.ReloadGamesRequest) (*v1.ReloadGamesResponse, error) {
+	n, err := s.uc.ReloadGames(ctx)
+	if err != nil {
+		return &v1.ReloadGamesResponse{Code: Failed, Message: err.Error()}, nil
+	}
+	return &v1.ReloadGamesResponse{Loaded: int32(n)}, nil
+}
+
+// ListTasks 获取任务列表（含历史任务，支持按状态/游戏/日期过滤与分页）
 func (s *StressService) ListTasks(ctx context.Context, in *v1.ListTasksRequest) (*v1.ListTasksResponse, error) {
-	all := s.uc.ListTasks()
-	tasks := make([]*v1.Task, 0, len(all))
+	f, err := parseTaskFilter(in)
+	if err != nil {
+		return nil, err
+	}
+	tasks, total := s.uc.ListTasks(ctx, f)
+	return &v1.ListTasksResponse{Tasks: tasks, Total: int32(total)}, nil
+}
 
-	for _, t := range all {
-		if t == nil {
-			continue
+// parseTaskFilter 请求转过滤条件：日期按本地时区解析，end_date 当天包含在内
+func parseTaskFilter(in *v1.ListTasksRequest) (task.Filter, error) {
+	f := task.Filter{Status: in.Status, GameID: in.GameId}
+	if in.StartDate != "" {
+		start, err := time.ParseInLocation(time.DateOnly, in.StartDate, time.Local)
+		if err != nil {
+			return f, fmt.Errorf("invalid start_date %q: %w", in.StartDate, err)
 		}
-		if protoTask := t.ToProto(); protoTask != nil {
-			tasks = append(tasks, protoTask)
+		f.Start = start
+	}
+	if in.EndDate != "" {
+		end, err := time.ParseInLocation(time.DateOnly, in.EndDate, time.Local)
+		if err != nil {
+			return f, fmt.Errorf("invalid end_date %q: %w", in.EndDate, err)
 		}
+		f.End = end.AddDate(0, 0, 1)
+	}
+
+	page, size := int(in.Page), int(in.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = defaultPageSize
 	}
-	return &v1.ListTasksResponse{Tasks: tasks, Total: int32(len(tasks))}, nil
+	f.Offset, f.Limit = (page-1)*size, size
+	return f, nil
 }
 
 // CreateTask 创建压测任务
@@ -77,26 +112,7 @@ func (s *StressService) CreateTask(ctx context.Context, in *v1.CreateTaskRequest
 		return &v1.CreateTaskResponse{Code: Failed, Message: "req.Config is nil"}, nil
 	}
 
-	g, ok := s.uc.GetGame(in.Config.GameId)
-	if !ok {
-		s.log.Warnf("CreateTask Faild. game not found: %d", in.Config.GameId)
-		return &v1.CreateTaskResponse{Code: Failed, Message: fmt.Sprintf("game not found: %d", in.Config.GameId)}, nil
-	}
-
-	// 确保 betsize 存在，如果没有则从数据库动态获取
-	if err := s.uc.EnsureBetSize(ctx, in.Config.GameId); err != nil {
-		s.log.Warnf("CreateTask EnsureBetSize failed: %v", err)
-		return &v1.CreateTaskResponse{Code: Failed, Message: err.Error()}, nil
-	}
-
-	if !g.ValidBetMoney(in.Config.BetOrder.BaseMoney) {
-		msg := fmt.Sprintf("CreateTask Faild. game_id=%d, invalid bet money: %.2f, betsize: %v",
-			in.Config.GameId, in.Config.BetOrder.BaseMoney, g.BetSize())
-		s.log.Warnf("%s", msg)
-		return &v1.CreateTaskResponse{Code: Failed, Message: msg}, nil
-	}
-
-	t, err := s.uc.CreateTask(ctx, g, in.Config)
+	t, err := s.uc.SubmitTask(ctx, in.Config)
 	if err != nil {
 		s.log.Warnf("CreateTask failed: %v", err)
 		return &v1.CreateTaskResponse{Code: Failed, Message: err.Error()}, nil
@@ -106,11 +122,11 @@ func (s *StressService) CreateTask(ctx context.Context, in *v1.CreateTaskRequest
 
 // TaskInfo 获取任务详情
 func (s *StressService) TaskInfo(ctx context.Context, in *v1.TaskInfoRequest) (*v1.TaskInfoResponse, error) {
-	t, err := s.getTask(in.TaskId)
+	t, err := s.getTaskInfo(ctx, in.TaskId)
 	if err != nil {
 		return &v1.TaskInfoResponse{Code: Failed, Message: err.Error()}, nil
 	}
-	return &v1.TaskInfoResponse{Task: t.ToProto()}, nil
+	return &v1.TaskInfoResponse{Task: t}, nil
 }
 
 // CancelTask 取消任务
@@ -121,9 +137,25 @@ func (s *StressService) CancelTask(ctx context.Context, in *v1.CancelTaskRequest
 	return &v1.CancelTaskResponse{}, nil
 }
 
+// PauseTask 暂停任务
+func (s *StressService) PauseTask(ctx context.Context, in *v1.PauseTaskRequest) (*v1.PauseTaskResponse, error) {
+	if err := s.uc.PauseTask(in.TaskId); err != nil {
+		return &v1.PauseTaskResponse{Code: Failed, Message: err.Error()}, nil
+	}
+	return &v1.PauseTaskResponse{}, nil
+}
+
+// ResumeTask 恢复任务
+func (s *StressService) ResumeTask(ctx context.Context, in *v1.ResumeTaskRequest) (*v1.ResumeTaskResponse, error) {
+	if err := s.uc.ResumeTask(in.TaskId); err != nil {
+		return &v1.ResumeTaskResponse{Code: Failed, Message: err.Error()}, nil
+	}
+	return &v1.ResumeTaskResponse{}, nil
+}
+
 // DeleteTask 删除任务
 func (s *StressService) DeleteTask(ctx context.Context, in *v1.DeleteTaskRequest) (*emptypb.Empty, error) {
-	if err := s.uc.DeleteTask(in.TaskId); err != nil {
+	if err := s.uc.DeleteTask(ctx, in.TaskId); err != nil {
 		s.log.Warnf("DeleteTask failed: %v", err)
 		return &emptypb.Empty{}, nil
 	}
@@ -132,21 +164,37 @@ func (s *StressService) DeleteTask(ctx context.Context, in *v1.DeleteTaskRequest
 
 // GetRecord 获取任务结果
 func (s *StressService) GetRecord(ctx context.Context, in *v1.RecordRequest) (*v1.RecordResponse, error) {
-	t, err := s.getTask(in.TaskId)
+	t, err := s.getTaskInfo(ctx, in.TaskId)
 	if err != nil {
 		return &v1.RecordResponse{Code: Failed, Message: err.Error()}, nil
 	}
 	return &v1.RecordResponse{Url: t.GetRecordUrl()}, nil
 }
 
-func (s *StressService) getTask(taskID string) (*task.Task, error) {
+// ListSessions 查看任务内各成员会话
+func (s *StressService) ListSessions(ctx context.Context, in *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultSessionLimit
+	}
+	list, total, err := s.uc.ListSessions(strings.TrimSpace(in.TaskId), task.SessionFilter{
+		State:    in.State,
+		MinState: time.Duration(in.MinStateSec) * time.Second,
+		MinIdle:  time.Duration(in.MinIdleSec) * time.Second,
+		Limit:    limit,
+	})
+	if err != nil {
+		return &v1.ListSessionsResponse{Code: Failed, Message: err.Error()}, nil
+	}
+	return &v1.ListSessionsResponse{Sessions: list, Total: int32(total)}, nil
+}
+
+// getTaskInfo 查询任务快照（内存优先，其次历史库）
+func (s *StressService) getTaskInfo(ctx context.Context, taskID string) (*v1.Task, error) {
 	if taskID = strings.TrimSpace(taskID); taskID == "" {
 		return nil, fmt.Errorf("task id is empty")
 	}
-	if t, ok := s.uc.GetTask(taskID); ok && t != nil {
-		return t, nil
-	}
-	return nil, fmt.Errorf("task not found")
+	return s.uc.GetTaskInfo(ctx, taskID)
 }
 
 // Cleanup 清理 Redis 和 MySQL 订单数据
@@ -174,86 +222,77 @@ func (s *StressService) Cleanup(ctx context.Context, in *v1.CleanupRequest) (*v1
 
 // Bench 批量压测启动
 func (s *StressService) Bench(ctx context.Context, in *v1.BenchRequest) (*v1.BenchResponse, error) {
-	games := s.uc.ListGames()
-	if len(games) == 0 {
-		return &v1.BenchResponse{Code: Failed, Message: "no games available"}, nil
+	taskIDs, fails, err := s.uc.Bench(ctx, in)
+	if err != nil {
+		return &v1.BenchResponse{Code: Failed, Message: err.Error()}, nil
 	}
+	return &v1.BenchResponse{TaskIds: xgo.ToJSON(taskIDs), Fails: fails}, nil
+}
 
-	// 过滤目标游戏
-	targets := games
-	if len(in.GameIds) > 0 {
-		gameMap := make(map[int64]base.IGame, len(games))
-		for _, g := range games {
-			gameMap[g.GameID()] = g
-		}
+// ListQueue 查看调度队列
+func (s *StressService) ListQueue(ctx context.Context, in *v1.ListQueueRequest) (*v1.ListQueueResponse, error) {
+	q := s.uc.ListQueue()
+	return &v1.ListQueueResponse{Paused: q.Paused, Running: int32(q.Running), Entries: q.Entries}, nil
+}
 
-		targets = make([]base.IGame, 0, len(in.GameIds))
-		for _, id := range in.GameIds {
-			if g, ok := gameMap[id]; ok {
-				targets = append(targets, g)
-			}
-		}
+// MoveTask 调整排队任务位置
+func (s *StressService) MoveTask(ctx context.Context, in *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error) {
+	front := in.Position == v1.MoveTaskRequest_FRONT
+	if err := s.uc.MoveTask(strings.TrimSpace(in.TaskId), front); err != nil {
+		return &v1.MoveTaskResponse{Code: Failed, Message: err.Error()}, nil
 	}
+	return &v1.MoveTaskResponse{}, nil
+}
 
-	if len(targets) == 0 {
-		return &v1.BenchResponse{Code: Failed, Message: "no matching games"}, nil
-	}
+// PauseQueue 暂停调度
+func (s *StressService) PauseQueue(ctx context.Context, in *v1.PauseQueueRequest) (*v1.QueueStateResponse, error) {
+	s.uc.PauseQueue()
+	return &v1.QueueStateResponse{Paused: true}, nil
+}
 
-	var (
-		mu      sync.Mutex
-		taskIDs = make([]string, 0, len(targets))
-		fails   = make([]string, 0)
-	)
-
-	eg, egCtx := errgroup.WithContext(ctx)
-
-	for _, g := range targets {
-		g := g
-
-		eg.Go(func() error {
-			if err := s.uc.EnsureBetSize(egCtx, g.GameID()); err != nil {
-				s.log.Warnf("Bench EnsureBetSize failed: game_id=%d, err=%v", g.GameID(), err)
-				mu.Lock()
-				fails = append(fails, fmt.Sprintf("%d:%s", g.GameID(), err.Error()))
-				mu.Unlock()
-				return nil
-			}
-
-			cfg := &v1.TaskConfig{
-				GameId:         g.GameID(),
-				Description:    "bench",
-				MemberCount:    in.MemberCount,
-				TimesPerMember: in.TimesPerMember,
-				BetOrder: &v1.BetOrderConfig{
-					BaseMoney: pickBaseMoney(g.BetSize()),
-					Multiple:  1,
-				},
-			}
-
-			t, err := s.uc.CreateTask(egCtx, g, cfg)
-
-			mu.Lock()
-			defer mu.Unlock()
-			if err != nil {
-				fails = append(fails, fmt.Sprintf("%d:%s", g.GameID(), err.Error()))
-				return nil
-			}
-			taskIDs = append(taskIDs, t.GetID())
-			return nil
-		})
+// ResumeQueue 恢复调度
+func (s *StressService) ResumeQueue(ctx context.Context, in *v1.ResumeQueueRequest) (*v1.QueueStateResponse, error) {
+	s.uc.ResumeQueue()
+	return &v1.QueueStateResponse{Paused: false}, nil
+}
+
+// CreateSchedule 创建定时任务
+func (s *StressService) CreateSchedule(ctx context.Context, in *v1.CreateScheduleRequest) (*v1.CreateScheduleResponse, error) {
+	sch := &v1.Schedule{Name: in.Name, Cron: in.Cron}
+	switch tmpl := in.Template.(type) {
+	case *v1.CreateScheduleRequest_Task:
+		sch.Template = &v1.Schedule_Task{Task: tmpl.Task}
+	case *v1.CreateScheduleRequest_Bench:
+		sch.Template = &v1.Schedule_Bench{Bench: tmpl.Bench}
 	}
 
-	_ = eg.Wait()
+	created, err := s.uc.CreateSchedule(ctx, sch)
+	if err != nil {
+		s.log.Warnf("CreateSchedule failed: %v", err)
+		return &v1.CreateScheduleResponse{Code: Failed, Message: err.Error()}, nil
+	}
+	return &v1.CreateScheduleResponse{Schedule: created}, nil
+}
 
-	return &v1.BenchResponse{TaskIds: xgo.ToJSON(taskIDs), Fails: fails}, nil
+// ListSchedules 获取定时任务列表
+func (s *StressService) ListSchedules(ctx context.Context, in *v1.ListSchedulesRequest) (*v1.ListSchedulesResponse, error) {
+	list := s.uc.ListSchedules()
+	return &v1.ListSchedulesResponse{Schedules: list, Total: int32(len(list))}, nil
 }
 
-func pickBaseMoney(sizes []float64) float64 {
-	if len(sizes) > 1 {
-		return sizes[1]
+// DeleteSchedule 删除定时任务
+func (s *StressService) DeleteSchedule(ctx context.Context, in *v1.DeleteScheduleRequest) (*v1.DeleteScheduleResponse, error) {
+	if err := s.uc.DeleteSchedule(ctx, in.ScheduleId); err != nil {
+		return &v1.DeleteScheduleResponse{Code: Failed, Message: err.Error()}, nil
 	}
-	if len(sizes) > 0 {
-		return sizes[0]
+	return &v1.DeleteScheduleResponse{}, nil
+}
+
+// SmokeTest 单成员冒烟测试，失败时同样返回已记录的步骤
+func (s *StressService) SmokeTest(ctx context.Context, in *v1.SmokeTestRequest) (*v1.SmokeTestResponse, error) {
+	member, steps, err := s.uc.SmokeTest(ctx, in)
+	if err != nil {
+		return &v1.SmokeTestResponse{Code: Failed, Message: err.Error(), Member: member, Steps: steps}, nil
 	}
-	return 0.1
+	return &v1.SmokeTestResponse{Passed: true, Member: member, Steps: steps}, nil
 }
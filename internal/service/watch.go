@@ -0,0 +1,83 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "stress/api/stress/v1"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	defaultWatchInterval = time.Second
+	minWatchInterval     = 200 * time.Millisecond
+	sseWriteTimeout      = 10 * time.Second
+)
+
+func watchInterval(ms int32) time.Duration {
+	if ms <= 0 {
+		return defaultWatchInterval
+	}
+	if d := time.Duration(ms) * time.Millisecond; d > minWatchInterval {
+		return d
+	}
+	return minWatchInterval
+}
+
+// WatchTask 订阅任务进度（gRPC 服务端流）
+func (s *StressService) WatchTask(in *v1.WatchTaskRequest, stream v1.StressService_WatchTaskServer) error {
+	t, ok := s.uc.GetTask(strings.TrimSpace(in.TaskId))
+	if !ok {
+		return errors.NotFound("TASK_NOT_FOUND", fmt.Sprintf("task %s not found", in.TaskId))
+	}
+	return t.Watch(stream.Context(), watchInterval(in.IntervalMs), stream.Send)
+}
+
+// WatchTaskSSE 订阅任务进度（HTTP Server-Sent Events）：GET /stress/WatchTask?task_id=xxx&interval_ms=1000
+// 事件名为 STATUS/SNAPSHOT/ERROR，data 为 WatchTaskEvent 的 JSON
+func (s *StressService) WatchTaskSSE(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	t, ok := s.uc.GetTask(strings.TrimSpace(q.Get("task_id")))
+	if !ok {
+		http.Error(w, "task not found", http.StatusNotFound)
+		return
+	}
+	ms, _ := strconv.Atoi(q.Get("interval_ms"))
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// 每次写入单独设置写超时，客户端停止读取时及时断开
+	rc := http.NewResponseController(w)
+	codec := encoding.GetCodec(json.Name)
+	emit := func(ev *v1.WatchTaskEvent) error {
+		data, err := codec.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		_ = rc.SetWriteDeadline(time.Now().Add(sseWriteTimeout))
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Kind, data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	if err := t.Watch(r.Context(), watchInterval(int32(ms)), emit); err != nil {
+		s.log.Debugf("WatchTaskSSE %s closed: %v", t.GetID(), err)
+	}
+}
@@ -0,0 +1,400 @@
+// Package ws is a minimal RFC 6455 WebSocket implementation (client dial and
+// server upgrade) covering what the stress client and mock platform need:
+// text/binary messages, fragmentation, ping/pong and close. Extensions and
+// subprotocols are not supported.
+package ws
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message types (frame opcodes).
+const (
+	TextMessage   = 1
+	BinaryMessage = 2
+	CloseMessage  = 8
+	PingMessage   = 9
+	PongMessage   = 10
+)
+
+const (
+	acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	// DefaultMaxMessageSize limits the size of a reassembled message.
+	DefaultMaxMessageSize = 16 << 20
+
+	closeNormal = 1000
+)
+
+var (
+	// ErrClosed is returned by ReadMessage after the peer sent a close frame
+	// and by WriteMessage after Close.
+	ErrClosed = errors.New("ws: connection closed")
+
+	errBadHandshake = errors.New("ws: bad handshake")
+)
+
+// Conn is a WebSocket connection. ReadMessage must be called from a single
+// goroutine; WriteMessage and Close are safe for concurrent use.
+type Conn struct {
+	conn   net.Conn
+	br     *bufio.Reader
+	client bool // client frames are masked
+
+	MaxMessageSize int64
+
+	wmu    sync.Mutex
+	closed bool
+}
+
+func newConn(c net.Conn, br *bufio.Reader, client bool) *Conn {
+	if br == nil {
+		br = bufio.NewReader(c)
+	}
+	return &Conn{conn: c, br: br, client: client, MaxMessageSize: DefaultMaxMessageSize}
+}
+
+// Dialer holds client dial options. The zero value verifies TLS certificates
+// against the system roots.
+type Dialer struct {
+	// TLSConfig is used for wss:// connections. ServerName defaults to the URL
+	// host. Set InsecureSkipVerify to accept self-signed test certificates.
+	TLSConfig *tls.Config
+}
+
+// Dial opens a client connection to a ws:// or wss:// URL using a zero Dialer.
+func Dial(ctx context.Context, rawURL string, header http.Header) (*Conn, error) {
+	var d Dialer
+	return d.Dial(ctx, rawURL, header)
+}
+
+// Dial opens a client connection to a ws:// or wss:// URL.
+func (d *Dialer) Dial(ctx context.Context, rawURL string, header http.Header) (*Conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	host := u.Host
+	switch u.Scheme {
+	case "ws":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	case "wss":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		}
+	default:
+		return nil, fmt.Errorf("ws: unsupported scheme %q", u.Scheme)
+	}
+
+	nd := net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}
+	nc, err := nd.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "wss" {
+		cfg := &tls.Config{}
+		if d.TLSConfig != nil {
+			cfg = d.TLSConfig.Clone()
+		}
+		if cfg.ServerName == "" {
+			cfg.ServerName = u.Hostname()
+		}
+		tc := tls.Client(nc, cfg)
+		if err := tc.HandshakeContext(ctx); err != nil {
+			nc.Close()
+			return nil, err
+		}
+		nc = tc
+	}
+
+	// 握手期间跟随 ctx 的截止时间与取消
+	if dl, ok := ctx.Deadline(); ok {
+		_ = nc.SetDeadline(dl)
+	}
+	stop := context.AfterFunc(ctx, func() { _ = nc.SetDeadline(time.Now()) })
+	c, err := handshake(nc, u, header)
+	if !stop() && err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	_ = nc.SetDeadline(time.Time{})
+	return c, nil
+}
+
+func handshake(nc net.Conn, u *url.URL, header http.Header) (*Conn, error) {
+	key := newKey()
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        &url.URL{Path: u.EscapedPath(), RawQuery: u.RawQuery},
+		Host:       u.Host,
+		Header:     make(http.Header),
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+	}
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if err := req.Write(nc); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(nc)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols ||
+		!strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") ||
+		resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		return nil, fmt.Errorf("%w: status %d", errBadHandshake, resp.StatusCode)
+	}
+	return newConn(nc, br, true), nil
+}
+
+// IsUpgrade reports whether r asks for a WebSocket upgrade.
+func IsUpgrade(r *http.Request) bool {
+	return headerContains(r.Header, "Connection", "upgrade") && headerContains(r.Header, "Upgrade", "websocket")
+}
+
+// Upgrade completes the server side of the handshake and hijacks the connection.
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if r.Method != http.MethodGet || !IsUpgrade(r) || key == "" || r.Header.Get("Sec-WebSocket-Version") != "13" {
+		http.Error(w, "bad websocket handshake", http.StatusBadRequest)
+		return nil, errBadHandshake
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket not supported", http.StatusInternalServerError)
+		return nil, errors.New("ws: response does not implement http.Hijacker")
+	}
+	nc, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+	if _, err := nc.Write([]byte(resp)); err != nil {
+		nc.Close()
+		return nil, err
+	}
+	return newConn(nc, rw.Reader, false), nil
+}
+
+// ReadMessage returns the next text or binary message. Ping frames are
+// answered automatically; a close frame is echoed and ErrClosed returned.
+func (c *Conn) ReadMessage() (int, []byte, error) {
+	var (
+		msgType int
+		msg     []byte
+	)
+	for {
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+		switch op {
+		case PingMessage:
+			if err := c.WriteMessage(PongMessage, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case PongMessage:
+			continue
+		case CloseMessage:
+			_ = c.writeClose(payload)
+			return 0, nil, ErrClosed
+		case 0: // continuation
+			if msgType == 0 {
+				return 0, nil, errors.New("ws: unexpected continuation frame")
+			}
+		case TextMessage, BinaryMessage:
+			if msgType != 0 {
+				return 0, nil, errors.New("ws: expected continuation frame")
+			}
+			msgType = op
+		default:
+			return 0, nil, fmt.Errorf("ws: unknown opcode %d", op)
+		}
+		if int64(len(msg)+len(payload)) > c.MaxMessageSize {
+			return 0, nil, fmt.Errorf("ws: message exceeds %d bytes", c.MaxMessageSize)
+		}
+		msg = append(msg, payload...)
+		if fin {
+			return msgType, msg, nil
+		}
+	}
+}
+
+func (c *Conn) readFrame() (fin bool, op int, payload []byte, err error) {
+	var h [2]byte
+	if _, err = io.ReadFull(c.br, h[:]); err != nil {
+		return
+	}
+	fin, op = h[0]&0x80 != 0, int(h[0]&0x0f)
+	if h[0]&0x70 != 0 {
+		return false, 0, nil, errors.New("ws: reserved bits set")
+	}
+	// clients must mask every frame, servers must not (RFC 6455 5.1)
+	masked := h[1]&0x80 != 0
+	if masked == c.client {
+		if c.client {
+			return false, 0, nil, errors.New("ws: masked frame from server")
+		}
+		return false, 0, nil, errors.New("ws: unmasked frame from client")
+	}
+	n := int64(h[1] & 0x7f)
+	switch n {
+	case 126:
+		var b [2]byte
+		if _, err = io.ReadFull(c.br, b[:]); err != nil {
+			return
+		}
+		n = int64(binary.BigEndian.Uint16(b[:]))
+	case 127:
+		var b [8]byte
+		if _, err = io.ReadFull(c.br, b[:]); err != nil {
+			return
+		}
+		n = int64(binary.BigEndian.Uint64(b[:]))
+	}
+	if op >= CloseMessage && (n > 125 || !fin) {
+		return false, 0, nil, errors.New("ws: invalid control frame")
+	}
+	if n < 0 || n > c.MaxMessageSize {
+		return false, 0, nil, fmt.Errorf("ws: frame exceeds %d bytes", c.MaxMessageSize)
+	}
+	var mask [4]byte
+	if masked {
+		if _, err = io.ReadFull(c.br, mask[:]); err != nil {
+			return
+		}
+	}
+	payload = make([]byte, n)
+	if _, err = io.ReadFull(c.br, payload); err != nil {
+		return
+	}
+	if masked {
+		maskBytes(mask, payload)
+	}
+	return fin, op, payload, nil
+}
+
+// WriteMessage sends data as a single frame.
+func (c *Conn) WriteMessage(msgType int, data []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closed {
+		return ErrClosed
+	}
+	return c.writeFrame(msgType, data)
+}
+
+func (c *Conn) writeFrame(op int, data []byte) error {
+	buf := make([]byte, 0, len(data)+14)
+	buf = append(buf, 0x80|byte(op))
+	var maskBit byte
+	if c.client {
+		maskBit = 0x80
+	}
+	switch n := len(data); {
+	case n <= 125:
+		buf = append(buf, maskBit|byte(n))
+	case n <= 0xffff:
+		buf = append(buf, maskBit|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, maskBit|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+	if c.client {
+		var mask [4]byte
+		_, _ = rand.Read(mask[:])
+		buf = append(buf, mask[:]...)
+		start := len(buf)
+		buf = append(buf, data...)
+		maskBytes(mask, buf[start:])
+	} else {
+		buf = append(buf, data...)
+	}
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+// writeClose replies to (or initiates) the closing handshake once.
+func (c *Conn) writeClose(payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	_ = c.conn.SetWriteDeadline(time.Now().Add(time.Second))
+	return c.writeFrame(CloseMessage, payload)
+}
+
+// Close sends a normal close frame and closes the underlying connection
+// without waiting for the peer's reply.
+func (c *Conn) Close() error {
+	_ = c.writeClose(binary.BigEndian.AppendUint16(nil, closeNormal))
+	return c.conn.Close()
+}
+
+func maskBytes(mask [4]byte, b []byte) {
+	for i := range b {
+		b[i] ^= mask[i&3]
+	}
+}
+
+func newKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return base64.StdEncoding.EncodeToString(b[:])
+}
+
+func acceptKey(key string) string {
+	h := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+func headerContains(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, s := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(s), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
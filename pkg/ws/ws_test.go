@@ -0,0 +1,289 @@
+package ws
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordConn captures writes (pong / close replies) of a Conn under test.
+type recordConn struct {
+	net.Conn
+	out bytes.Buffer
+}
+
+func (c *recordConn) Write(b []byte) (int, error)      { return c.out.Write(b) }
+func (c *recordConn) Close() error                     { return nil }
+func (c *recordConn) SetWriteDeadline(time.Time) error { return nil }
+
+// frame encodes a single frame, masking the payload when mask is set.
+func frame(fin bool, op int, payload []byte, mask bool) []byte {
+	b0 := byte(op)
+	if fin {
+		b0 |= 0x80
+	}
+	var maskBit byte
+	if mask {
+		maskBit = 0x80
+	}
+	buf := []byte{b0}
+	switch n := len(payload); {
+	case n <= 125:
+		buf = append(buf, maskBit|byte(n))
+	case n <= 0xffff:
+		buf = append(buf, maskBit|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, maskBit|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+	if !mask {
+		return append(buf, payload...)
+	}
+	key := [4]byte{0x12, 0x34, 0x56, 0x78}
+	buf = append(buf, key[:]...)
+	start := len(buf)
+	buf = append(buf, payload...)
+	maskBytes(key, buf[start:])
+	return buf
+}
+
+func concat(frames ...[]byte) []byte {
+	return bytes.Join(frames, nil)
+}
+
+// newTestConn returns a Conn reading input; client selects which side it plays.
+func newTestConn(input []byte, client bool) (*Conn, *recordConn) {
+	rc := &recordConn{}
+	return newConn(rc, bufio.NewReader(bytes.NewReader(input)), client), rc
+}
+
+func TestReadMessage(t *testing.T) {
+	long16 := bytes.Repeat([]byte("a"), 300)
+	long64 := bytes.Repeat([]byte("b"), 70000)
+
+	tests := []struct {
+		name    string
+		client  bool // the Conn under test is a client (expects unmasked frames)
+		input   []byte
+		maxSize int64
+		msgType int
+		want    string
+		wantErr string
+	}{
+		{name: "masked text to server", input: frame(true, TextMessage, []byte("hello"), true), msgType: TextMessage, want: "hello"},
+		{name: "unmasked binary to client", client: true, input: frame(true, BinaryMessage, []byte{1, 2}, false), msgType: BinaryMessage, want: "\x01\x02"},
+		{name: "empty payload", input: frame(true, TextMessage, nil, true), msgType: TextMessage, want: ""},
+		{name: "16-bit length", input: frame(true, TextMessage, long16, true), msgType: TextMessage, want: string(long16)},
+		{name: "64-bit length", input: frame(true, TextMessage, long64, true), msgType: TextMessage, want: string(long64)},
+		{
+			name: "fragmented message",
+			input: concat(
+				frame(false, TextMessage, []byte("he"), true),
+				frame(false, 0, []byte("ll"), true),
+				frame(true, 0, []byte("o"), true),
+			),
+			msgType: TextMessage, want: "hello",
+		},
+		{
+			name: "control frames between fragments",
+			input: concat(
+				frame(false, TextMessage, []byte("he"), true),
+				frame(true, PingMessage, []byte("p"), true),
+				frame(true, PongMessage, nil, true),
+				frame(true, 0, []byte("llo"), true),
+			),
+			msgType: TextMessage, want: "hello",
+		},
+		{name: "unexpected continuation", input: frame(true, 0, []byte("x"), true), wantErr: "unexpected continuation"},
+		{
+			name:    "missing continuation",
+			input:   concat(frame(false, TextMessage, []byte("a"), true), frame(true, TextMessage, []byte("b"), true)),
+			wantErr: "expected continuation",
+		},
+		{name: "unknown opcode", input: frame(true, 3, nil, true), wantErr: "unknown opcode"},
+		{name: "reserved bits", input: append([]byte{0x80 | 0x40 | TextMessage}, frame(true, TextMessage, nil, true)[1:]...), wantErr: "reserved bits"},
+		{name: "control frame too long", input: frame(true, PingMessage, long16, true), wantErr: "invalid control frame"},
+		{name: "fragmented control frame", input: frame(false, PingMessage, []byte("p"), true), wantErr: "invalid control frame"},
+		{name: "oversize frame", maxSize: 10, input: frame(true, TextMessage, long16, true), wantErr: "frame exceeds 10 bytes"},
+		{
+			name:    "oversize message",
+			maxSize: 4,
+			input:   concat(frame(false, TextMessage, []byte("abc"), true), frame(true, 0, []byte("de"), true)),
+			wantErr: "message exceeds 4 bytes",
+		},
+		{
+			name:    "negative 64-bit length",
+			input:   append([]byte{0x80 | TextMessage, 0x80 | 127}, 0xff, 0, 0, 0, 0, 0, 0, 0),
+			wantErr: "frame exceeds",
+		},
+		{name: "unmasked frame from client", input: frame(true, TextMessage, []byte("x"), false), wantErr: "unmasked frame from client"},
+		{name: "masked frame from server", client: true, input: frame(true, TextMessage, []byte("x"), true), wantErr: "masked frame from server"},
+		{name: "truncated frame", input: frame(true, TextMessage, []byte("hello"), true)[:5], wantErr: "EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestConn(tt.input, tt.client)
+			if tt.maxSize > 0 {
+				c.MaxMessageSize = tt.maxSize
+			}
+			msgType, msg, err := c.ReadMessage()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadMessage: %v", err)
+			}
+			if msgType != tt.msgType || string(msg) != tt.want {
+				t.Fatalf("got %d/%d bytes, want %d/%d bytes", msgType, len(msg), tt.msgType, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestPingAnsweredWithPong(t *testing.T) {
+	c, rc := newTestConn(concat(
+		frame(true, PingMessage, []byte("beat"), true),
+		frame(true, TextMessage, []byte("x"), true),
+	), false)
+	if _, _, err := c.ReadMessage(); err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	if want := frame(true, PongMessage, []byte("beat"), false); !bytes.Equal(rc.out.Bytes(), want) {
+		t.Fatalf("reply = %x, want pong %x", rc.out.Bytes(), want)
+	}
+}
+
+func TestCloseFrame(t *testing.T) {
+	payload := binary.BigEndian.AppendUint16(nil, closeNormal)
+	c, rc := newTestConn(frame(true, CloseMessage, payload, true), false)
+	if _, _, err := c.ReadMessage(); !errors.Is(err, ErrClosed) {
+		t.Fatalf("err = %v, want ErrClosed", err)
+	}
+	if want := frame(true, CloseMessage, payload, false); !bytes.Equal(rc.out.Bytes(), want) {
+		t.Fatalf("reply = %x, want close echo %x", rc.out.Bytes(), want)
+	}
+	if err := c.WriteMessage(TextMessage, []byte("x")); !errors.Is(err, ErrClosed) {
+		t.Fatalf("write after close = %v, want ErrClosed", err)
+	}
+}
+
+func TestWriteFrameEncoding(t *testing.T) {
+	for _, n := range []int{0, 125, 126, 0xffff, 0x10000} {
+		data := bytes.Repeat([]byte("z"), n)
+
+		srv, rc := newTestConn(nil, false)
+		if err := srv.WriteMessage(BinaryMessage, data); err != nil {
+			t.Fatal(err)
+		}
+		if want := frame(true, BinaryMessage, data, false); !bytes.Equal(rc.out.Bytes(), want) {
+			t.Fatalf("server frame for %d bytes differs", n)
+		}
+
+		// client frames are masked with a random key: decode them as a server would
+		cli, rc := newTestConn(nil, true)
+		if err := cli.WriteMessage(BinaryMessage, data); err != nil {
+			t.Fatal(err)
+		}
+		if rc.out.Bytes()[1]&0x80 == 0 {
+			t.Fatalf("client frame for %d bytes is not masked", n)
+		}
+		peer, _ := newTestConn(rc.out.Bytes(), false)
+		if _, got, err := peer.ReadMessage(); err != nil || !bytes.Equal(got, data) {
+			t.Fatalf("client frame for %d bytes: %v", n, err)
+		}
+	}
+}
+
+func TestDialUpgradeEcho(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := Upgrade(w, r)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			op, msg, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := c.WriteMessage(op, msg); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := Dial(ctx, "ws"+strings.TrimPrefix(srv.URL, "http")+"/echo", nil)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer c.Close()
+	for _, n := range []int{5, 300, 70000} {
+		data := bytes.Repeat([]byte("e"), n)
+		if err := c.WriteMessage(TextMessage, data); err != nil {
+			t.Fatal(err)
+		}
+		op, got, err := c.ReadMessage()
+		if err != nil || op != TextMessage || !bytes.Equal(got, data) {
+			t.Fatalf("echo %d bytes: op=%d len=%d err=%v", n, op, len(got), err)
+		}
+	}
+
+	// plain HTTP requests are rejected
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("non-upgrade status = %d", resp.StatusCode)
+	}
+}
+
+func TestDialTLSVerify(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c, err := Upgrade(w, r); err == nil {
+			c.Close()
+		}
+	}))
+	defer srv.Close()
+	url := "wss" + strings.TrimPrefix(srv.URL, "https")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// self-signed certificate is rejected by default
+	if c, err := Dial(ctx, url, nil); err == nil {
+		c.Close()
+		t.Fatal("Dial accepted an untrusted certificate")
+	}
+
+	trusted := Dialer{TLSConfig: srv.Client().Transport.(*http.Transport).TLSClientConfig}
+	c, err := trusted.Dial(ctx, url, nil)
+	if err != nil {
+		t.Fatalf("Dial with trusted roots: %v", err)
+	}
+	c.Close()
+
+	insecure := Dialer{TLSConfig: &tls.Config{InsecureSkipVerify: true}}
+	c, err = insecure.Dial(ctx, url, nil)
+	if err != nil {
+		t.Fatalf("Dial with InsecureSkipVerify: %v", err)
+	}
+	c.Close()
+}